@@ -9,6 +9,7 @@ import (
 	io "io"
 	http "net/http"
 	reflect "reflect"
+	time "time"
 
 	alien4cloud "github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud"
 	gomock "github.com/golang/mock/gomock"
@@ -113,6 +114,35 @@ func (mr *MockClientMockRecorder) EventService() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventService", reflect.TypeOf((*MockClient)(nil).EventService))
 }
 
+// HasRole mocks base method.
+func (m *MockClient) HasRole(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasRole", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasRole indicates an expected call of HasRole.
+func (mr *MockClientMockRecorder) HasRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasRole", reflect.TypeOf((*MockClient)(nil).HasRole), arg0, arg1)
+}
+
+// LocationService mocks base method.
+func (m *MockClient) LocationService() alien4cloud.LocationService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LocationService")
+	ret0, _ := ret[0].(alien4cloud.LocationService)
+	return ret0
+}
+
+// LocationService indicates an expected call of LocationService.
+func (mr *MockClientMockRecorder) LocationService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LocationService", reflect.TypeOf((*MockClient)(nil).LocationService))
+}
+
 // LogService mocks base method.
 func (m *MockClient) LogService() alien4cloud.LogService {
 	m.ctrl.T.Helper()
@@ -184,6 +214,68 @@ func (mr *MockClientMockRecorder) OrchestratorService() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrchestratorService", reflect.TypeOf((*MockClient)(nil).OrchestratorService))
 }
 
+// Preflight mocks base method.
+func (m *MockClient) Preflight(arg0 context.Context, arg1 alien4cloud.PreflightSpec) (alien4cloud.PreflightReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Preflight", arg0, arg1)
+	ret0, _ := ret[0].(alien4cloud.PreflightReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Preflight indicates an expected call of Preflight.
+func (mr *MockClientMockRecorder) Preflight(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Preflight", reflect.TypeOf((*MockClient)(nil).Preflight), arg0, arg1)
+}
+
+// RegisterDeploymentStatusCallback mocks base method.
+func (m *MockClient) RegisterDeploymentStatusCallback(arg0, arg1 string, arg2 alien4cloud.DeploymentStatusCallback) func() {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterDeploymentStatusCallback", arg0, arg1, arg2)
+	ret0, _ := ret[0].(func())
+	return ret0
+}
+
+// RegisterDeploymentStatusCallback indicates an expected call of RegisterDeploymentStatusCallback.
+func (mr *MockClientMockRecorder) RegisterDeploymentStatusCallback(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDeploymentStatusCallback", reflect.TypeOf((*MockClient)(nil).RegisterDeploymentStatusCallback), arg0, arg1, arg2)
+}
+
+// RequireRoles mocks base method.
+func (m *MockClient) RequireRoles(arg0 context.Context, arg1 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RequireRoles", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequireRoles indicates an expected call of RequireRoles.
+func (mr *MockClientMockRecorder) RequireRoles(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequireRoles", reflect.TypeOf((*MockClient)(nil).RequireRoles), varargs...)
+}
+
+// StartSessionKeeper mocks base method.
+func (m *MockClient) StartSessionKeeper(arg0 context.Context, arg1 time.Duration) <-chan error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSessionKeeper", arg0, arg1)
+	ret0, _ := ret[0].(<-chan error)
+	return ret0
+}
+
+// StartSessionKeeper indicates an expected call of StartSessionKeeper.
+func (mr *MockClientMockRecorder) StartSessionKeeper(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSessionKeeper", reflect.TypeOf((*MockClient)(nil).StartSessionKeeper), arg0, arg1)
+}
+
 // TopologyService mocks base method.
 func (m *MockClient) TopologyService() alien4cloud.TopologyService {
 	m.ctrl.T.Helper()
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	alien4cloud "github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud"
+	types "github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -35,6 +36,77 @@ func (m *MockApplicationService) EXPECT() *MockApplicationServiceMockRecorder {
 	return m.recorder
 }
 
+// AddApplicationGroupRole mocks base method.
+func (m *MockApplicationService) AddApplicationGroupRole(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddApplicationGroupRole", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddApplicationGroupRole indicates an expected call of AddApplicationGroupRole.
+func (mr *MockApplicationServiceMockRecorder) AddApplicationGroupRole(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddApplicationGroupRole", reflect.TypeOf((*MockApplicationService)(nil).AddApplicationGroupRole), arg0, arg1, arg2, arg3)
+}
+
+// AddApplicationUserRole mocks base method.
+func (m *MockApplicationService) AddApplicationUserRole(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddApplicationUserRole", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddApplicationUserRole indicates an expected call of AddApplicationUserRole.
+func (mr *MockApplicationServiceMockRecorder) AddApplicationUserRole(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddApplicationUserRole", reflect.TypeOf((*MockApplicationService)(nil).AddApplicationUserRole), arg0, arg1, arg2, arg3)
+}
+
+// BulkDeleteTag mocks base method.
+func (m *MockApplicationService) BulkDeleteTag(arg0 context.Context, arg1 []string, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkDeleteTag", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkDeleteTag indicates an expected call of BulkDeleteTag.
+func (mr *MockApplicationServiceMockRecorder) BulkDeleteTag(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkDeleteTag", reflect.TypeOf((*MockApplicationService)(nil).BulkDeleteTag), arg0, arg1, arg2)
+}
+
+// BulkSetTag mocks base method.
+func (m *MockApplicationService) BulkSetTag(arg0 context.Context, arg1 []string, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkSetTag", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkSetTag indicates an expected call of BulkSetTag.
+func (mr *MockApplicationServiceMockRecorder) BulkSetTag(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkSetTag", reflect.TypeOf((*MockApplicationService)(nil).BulkSetTag), arg0, arg1, arg2, arg3)
+}
+
+// CloneApplication mocks base method.
+func (m *MockApplicationService) CloneApplication(arg0 context.Context, arg1, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneApplication", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneApplication indicates an expected call of CloneApplication.
+func (mr *MockApplicationServiceMockRecorder) CloneApplication(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneApplication", reflect.TypeOf((*MockApplicationService)(nil).CloneApplication), arg0, arg1, arg2)
+}
+
 // CreateAppli mocks base method.
 func (m *MockApplicationService) CreateAppli(arg0 context.Context, arg1, arg2 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +122,49 @@ func (mr *MockApplicationServiceMockRecorder) CreateAppli(arg0, arg1, arg2 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAppli", reflect.TypeOf((*MockApplicationService)(nil).CreateAppli), arg0, arg1, arg2)
 }
 
+// CreateApplicationVersion mocks base method.
+func (m *MockApplicationService) CreateApplicationVersion(arg0 context.Context, arg1, arg2, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApplicationVersion", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateApplicationVersion indicates an expected call of CreateApplicationVersion.
+func (mr *MockApplicationServiceMockRecorder) CreateApplicationVersion(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApplicationVersion", reflect.TypeOf((*MockApplicationService)(nil).CreateApplicationVersion), arg0, arg1, arg2, arg3, arg4)
+}
+
+// CreateEnvironment mocks base method.
+func (m *MockApplicationService) CreateEnvironment(arg0 context.Context, arg1 string, arg2 types.EnvironmentCreateRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEnvironment", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEnvironment indicates an expected call of CreateEnvironment.
+func (mr *MockApplicationServiceMockRecorder) CreateEnvironment(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEnvironment", reflect.TypeOf((*MockApplicationService)(nil).CreateEnvironment), arg0, arg1, arg2)
+}
+
+// CreateTopologyVersion mocks base method.
+func (m *MockApplicationService) CreateTopologyVersion(arg0 context.Context, arg1, arg2, arg3, arg4, arg5 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTopologyVersion", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTopologyVersion indicates an expected call of CreateTopologyVersion.
+func (mr *MockApplicationServiceMockRecorder) CreateTopologyVersion(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTopologyVersion", reflect.TypeOf((*MockApplicationService)(nil).CreateTopologyVersion), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // DeleteApplication mocks base method.
 func (m *MockApplicationService) DeleteApplication(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -64,11 +179,81 @@ func (mr *MockApplicationServiceMockRecorder) DeleteApplication(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApplication", reflect.TypeOf((*MockApplicationService)(nil).DeleteApplication), arg0, arg1)
 }
 
+// DeleteApplicationVersion mocks base method.
+func (m *MockApplicationService) DeleteApplicationVersion(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApplicationVersion", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteApplicationVersion indicates an expected call of DeleteApplicationVersion.
+func (mr *MockApplicationServiceMockRecorder) DeleteApplicationVersion(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApplicationVersion", reflect.TypeOf((*MockApplicationService)(nil).DeleteApplicationVersion), arg0, arg1, arg2)
+}
+
+// DeleteApplicationVersionGitLocation mocks base method.
+func (m *MockApplicationService) DeleteApplicationVersionGitLocation(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApplicationVersionGitLocation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteApplicationVersionGitLocation indicates an expected call of DeleteApplicationVersionGitLocation.
+func (mr *MockApplicationServiceMockRecorder) DeleteApplicationVersionGitLocation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApplicationVersionGitLocation", reflect.TypeOf((*MockApplicationService)(nil).DeleteApplicationVersionGitLocation), arg0, arg1, arg2)
+}
+
+// DeleteEnvironment mocks base method.
+func (m *MockApplicationService) DeleteEnvironment(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEnvironment", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEnvironment indicates an expected call of DeleteEnvironment.
+func (mr *MockApplicationServiceMockRecorder) DeleteEnvironment(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvironment", reflect.TypeOf((*MockApplicationService)(nil).DeleteEnvironment), arg0, arg1, arg2)
+}
+
+// DeleteTagFromApplication mocks base method.
+func (m *MockApplicationService) DeleteTagFromApplication(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTagFromApplication", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTagFromApplication indicates an expected call of DeleteTagFromApplication.
+func (mr *MockApplicationServiceMockRecorder) DeleteTagFromApplication(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagFromApplication", reflect.TypeOf((*MockApplicationService)(nil).DeleteTagFromApplication), arg0, arg1, arg2)
+}
+
+// DeleteTopologyVersion mocks base method.
+func (m *MockApplicationService) DeleteTopologyVersion(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTopologyVersion", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTopologyVersion indicates an expected call of DeleteTopologyVersion.
+func (mr *MockApplicationServiceMockRecorder) DeleteTopologyVersion(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTopologyVersion", reflect.TypeOf((*MockApplicationService)(nil).DeleteTopologyVersion), arg0, arg1, arg2, arg3)
+}
+
 // GetApplicationByID mocks base method.
-func (m *MockApplicationService) GetApplicationByID(arg0 context.Context, arg1 string) (*alien4cloud.Application, error) {
+func (m *MockApplicationService) GetApplicationByID(arg0 context.Context, arg1 string) (*types.Application, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetApplicationByID", arg0, arg1)
-	ret0, _ := ret[0].(*alien4cloud.Application)
+	ret0, _ := ret[0].(*types.Application)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -94,6 +279,21 @@ func (mr *MockApplicationServiceMockRecorder) GetApplicationTag(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationTag", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationTag), arg0, arg1, arg2)
 }
 
+// GetApplicationVersionGitLocation mocks base method.
+func (m *MockApplicationService) GetApplicationVersionGitLocation(arg0 context.Context, arg1, arg2 string) (types.GitLocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationVersionGitLocation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(types.GitLocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationVersionGitLocation indicates an expected call of GetApplicationVersionGitLocation.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationVersionGitLocation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationVersionGitLocation", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationVersionGitLocation), arg0, arg1, arg2)
+}
+
 // GetApplicationsID mocks base method.
 func (m *MockApplicationService) GetApplicationsID(arg0 context.Context, arg1 string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -110,18 +310,53 @@ func (mr *MockApplicationServiceMockRecorder) GetApplicationsID(arg0, arg1 inter
 }
 
 // GetDeploymentTopology mocks base method.
-func (m *MockApplicationService) GetDeploymentTopology(arg0 context.Context, arg1, arg2 string) (*alien4cloud.Topology, error) {
+func (m *MockApplicationService) GetDeploymentTopology(arg0 context.Context, arg1, arg2 string, arg3 ...alien4cloud.TopologyOption) (*types.Topology, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDeploymentTopology", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*alien4cloud.Topology)
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDeploymentTopology", varargs...)
+	ret0, _ := ret[0].(*types.Topology)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetDeploymentTopology indicates an expected call of GetDeploymentTopology.
-func (mr *MockApplicationServiceMockRecorder) GetDeploymentTopology(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockApplicationServiceMockRecorder) GetDeploymentTopology(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeploymentTopology", reflect.TypeOf((*MockApplicationService)(nil).GetDeploymentTopology), varargs...)
+}
+
+// GetDeploymentTopologyDTO mocks base method.
+func (m *MockApplicationService) GetDeploymentTopologyDTO(arg0 context.Context, arg1, arg2 string) (*types.DeploymentTopologyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeploymentTopologyDTO", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.DeploymentTopologyDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeploymentTopologyDTO indicates an expected call of GetDeploymentTopologyDTO.
+func (mr *MockApplicationServiceMockRecorder) GetDeploymentTopologyDTO(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeploymentTopology", reflect.TypeOf((*MockApplicationService)(nil).GetDeploymentTopology), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeploymentTopologyDTO", reflect.TypeOf((*MockApplicationService)(nil).GetDeploymentTopologyDTO), arg0, arg1, arg2)
+}
+
+// GetEnvironment mocks base method.
+func (m *MockApplicationService) GetEnvironment(arg0 context.Context, arg1, arg2 string) (types.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnvironment", arg0, arg1, arg2)
+	ret0, _ := ret[0].(types.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnvironment indicates an expected call of GetEnvironment.
+func (mr *MockApplicationServiceMockRecorder) GetEnvironment(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironment", reflect.TypeOf((*MockApplicationService)(nil).GetEnvironment), arg0, arg1, arg2)
 }
 
 // GetEnvironmentIDbyName mocks base method.
@@ -154,11 +389,98 @@ func (mr *MockApplicationServiceMockRecorder) IsApplicationExist(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsApplicationExist", reflect.TypeOf((*MockApplicationService)(nil).IsApplicationExist), arg0, arg1)
 }
 
+// PromoteApplicationVersion mocks base method.
+func (m *MockApplicationService) PromoteApplicationVersion(arg0 context.Context, arg1, arg2, arg3 string) (*types.PromotionRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteApplicationVersion", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*types.PromotionRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoteApplicationVersion indicates an expected call of PromoteApplicationVersion.
+func (mr *MockApplicationServiceMockRecorder) PromoteApplicationVersion(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteApplicationVersion", reflect.TypeOf((*MockApplicationService)(nil).PromoteApplicationVersion), arg0, arg1, arg2, arg3)
+}
+
+// PullApplicationVersionFromGit mocks base method.
+func (m *MockApplicationService) PullApplicationVersionFromGit(arg0 context.Context, arg1, arg2 string, arg3 *types.GitCredentials) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PullApplicationVersionFromGit", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PullApplicationVersionFromGit indicates an expected call of PullApplicationVersionFromGit.
+func (mr *MockApplicationServiceMockRecorder) PullApplicationVersionFromGit(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullApplicationVersionFromGit", reflect.TypeOf((*MockApplicationService)(nil).PullApplicationVersionFromGit), arg0, arg1, arg2, arg3)
+}
+
+// PushApplicationVersionToGit mocks base method.
+func (m *MockApplicationService) PushApplicationVersionToGit(arg0 context.Context, arg1, arg2 string, arg3 *types.GitCredentials) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PushApplicationVersionToGit", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PushApplicationVersionToGit indicates an expected call of PushApplicationVersionToGit.
+func (mr *MockApplicationServiceMockRecorder) PushApplicationVersionToGit(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushApplicationVersionToGit", reflect.TypeOf((*MockApplicationService)(nil).PushApplicationVersionToGit), arg0, arg1, arg2, arg3)
+}
+
+// RemoveApplicationGroupRole mocks base method.
+func (m *MockApplicationService) RemoveApplicationGroupRole(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveApplicationGroupRole", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveApplicationGroupRole indicates an expected call of RemoveApplicationGroupRole.
+func (mr *MockApplicationServiceMockRecorder) RemoveApplicationGroupRole(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveApplicationGroupRole", reflect.TypeOf((*MockApplicationService)(nil).RemoveApplicationGroupRole), arg0, arg1, arg2, arg3)
+}
+
+// RemoveApplicationUserRole mocks base method.
+func (m *MockApplicationService) RemoveApplicationUserRole(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveApplicationUserRole", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveApplicationUserRole indicates an expected call of RemoveApplicationUserRole.
+func (mr *MockApplicationServiceMockRecorder) RemoveApplicationUserRole(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveApplicationUserRole", reflect.TypeOf((*MockApplicationService)(nil).RemoveApplicationUserRole), arg0, arg1, arg2, arg3)
+}
+
+// SearchApplicationVersions mocks base method.
+func (m *MockApplicationService) SearchApplicationVersions(arg0 context.Context, arg1 string, arg2 types.SearchRequest) ([]types.ApplicationVersion, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchApplicationVersions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]types.ApplicationVersion)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchApplicationVersions indicates an expected call of SearchApplicationVersions.
+func (mr *MockApplicationServiceMockRecorder) SearchApplicationVersions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchApplicationVersions", reflect.TypeOf((*MockApplicationService)(nil).SearchApplicationVersions), arg0, arg1, arg2)
+}
+
 // SearchApplications mocks base method.
-func (m *MockApplicationService) SearchApplications(arg0 context.Context, arg1 alien4cloud.SearchRequest) ([]alien4cloud.Application, int, error) {
+func (m *MockApplicationService) SearchApplications(arg0 context.Context, arg1 types.SearchRequest) ([]types.Application, int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SearchApplications", arg0, arg1)
-	ret0, _ := ret[0].([]alien4cloud.Application)
+	ret0, _ := ret[0].([]types.Application)
 	ret1, _ := ret[1].(int)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
@@ -171,10 +493,10 @@ func (mr *MockApplicationServiceMockRecorder) SearchApplications(arg0, arg1 inte
 }
 
 // SearchEnvironments mocks base method.
-func (m *MockApplicationService) SearchEnvironments(arg0 context.Context, arg1 string, arg2 alien4cloud.SearchRequest) ([]alien4cloud.Environment, int, error) {
+func (m *MockApplicationService) SearchEnvironments(arg0 context.Context, arg1 string, arg2 types.SearchRequest) ([]types.Environment, int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SearchEnvironments", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]alien4cloud.Environment)
+	ret0, _ := ret[0].([]types.Environment)
 	ret1, _ := ret[1].(int)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
@@ -186,6 +508,20 @@ func (mr *MockApplicationServiceMockRecorder) SearchEnvironments(arg0, arg1, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEnvironments", reflect.TypeOf((*MockApplicationService)(nil).SearchEnvironments), arg0, arg1, arg2)
 }
 
+// SetEnvironmentTopologyVersion mocks base method.
+func (m *MockApplicationService) SetEnvironmentTopologyVersion(arg0 context.Context, arg1, arg2, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEnvironmentTopologyVersion", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEnvironmentTopologyVersion indicates an expected call of SetEnvironmentTopologyVersion.
+func (mr *MockApplicationServiceMockRecorder) SetEnvironmentTopologyVersion(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEnvironmentTopologyVersion", reflect.TypeOf((*MockApplicationService)(nil).SetEnvironmentTopologyVersion), arg0, arg1, arg2, arg3, arg4)
+}
+
 // SetTagToApplication mocks base method.
 func (m *MockApplicationService) SetTagToApplication(arg0 context.Context, arg1, arg2, arg3 string) error {
 	m.ctrl.T.Helper()
@@ -199,3 +535,31 @@ func (mr *MockApplicationServiceMockRecorder) SetTagToApplication(arg0, arg1, ar
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTagToApplication", reflect.TypeOf((*MockApplicationService)(nil).SetTagToApplication), arg0, arg1, arg2, arg3)
 }
+
+// UpdateApplicationVersionGitLocation mocks base method.
+func (m *MockApplicationService) UpdateApplicationVersionGitLocation(arg0 context.Context, arg1, arg2 string, arg3 types.GitLocationUpdateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApplicationVersionGitLocation", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateApplicationVersionGitLocation indicates an expected call of UpdateApplicationVersionGitLocation.
+func (mr *MockApplicationServiceMockRecorder) UpdateApplicationVersionGitLocation(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplicationVersionGitLocation", reflect.TypeOf((*MockApplicationService)(nil).UpdateApplicationVersionGitLocation), arg0, arg1, arg2, arg3)
+}
+
+// UpdateEnvironment mocks base method.
+func (m *MockApplicationService) UpdateEnvironment(arg0 context.Context, arg1, arg2 string, arg3 types.EnvironmentUpdateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment.
+func (mr *MockApplicationServiceMockRecorder) UpdateEnvironment(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockApplicationService)(nil).UpdateEnvironment), arg0, arg1, arg2, arg3)
+}
@@ -10,6 +10,7 @@ import (
 	reflect "reflect"
 
 	alien4cloud "github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud"
+	types "github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -36,11 +37,221 @@ func (m *MockCatalogService) EXPECT() *MockCatalogServiceMockRecorder {
 	return m.recorder
 }
 
+// CreateCSARGitRepository mocks base method.
+func (m *MockCatalogService) CreateCSARGitRepository(arg0 context.Context, arg1 types.CSARGitRepositoryCreateRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCSARGitRepository", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCSARGitRepository indicates an expected call of CreateCSARGitRepository.
+func (mr *MockCatalogServiceMockRecorder) CreateCSARGitRepository(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCSARGitRepository", reflect.TypeOf((*MockCatalogService)(nil).CreateCSARGitRepository), arg0, arg1)
+}
+
+// DeleteCSAR mocks base method.
+func (m *MockCatalogService) DeleteCSAR(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCSAR", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCSAR indicates an expected call of DeleteCSAR.
+func (mr *MockCatalogServiceMockRecorder) DeleteCSAR(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCSAR", reflect.TypeOf((*MockCatalogService)(nil).DeleteCSAR), arg0, arg1, arg2)
+}
+
+// DeleteCSARGitRepository mocks base method.
+func (m *MockCatalogService) DeleteCSARGitRepository(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCSARGitRepository", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCSARGitRepository indicates an expected call of DeleteCSARGitRepository.
+func (mr *MockCatalogServiceMockRecorder) DeleteCSARGitRepository(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCSARGitRepository", reflect.TypeOf((*MockCatalogService)(nil).DeleteCSARGitRepository), arg0, arg1)
+}
+
+// GetCSAR mocks base method.
+func (m *MockCatalogService) GetCSAR(arg0 context.Context, arg1, arg2 string) (types.CSAR, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCSAR", arg0, arg1, arg2)
+	ret0, _ := ret[0].(types.CSAR)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCSAR indicates an expected call of GetCSAR.
+func (mr *MockCatalogServiceMockRecorder) GetCSAR(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCSAR", reflect.TypeOf((*MockCatalogService)(nil).GetCSAR), arg0, arg1, arg2)
+}
+
+// GetCSARDependents mocks base method.
+func (m *MockCatalogService) GetCSARDependents(arg0 context.Context, arg1, arg2 string) ([]types.ComponentUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCSARDependents", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]types.ComponentUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCSARDependents indicates an expected call of GetCSARDependents.
+func (mr *MockCatalogServiceMockRecorder) GetCSARDependents(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCSARDependents", reflect.TypeOf((*MockCatalogService)(nil).GetCSARDependents), arg0, arg1, arg2)
+}
+
+// GetComplexTOSCAType mocks base method.
+func (m *MockCatalogService) GetComplexTOSCAType(arg0 context.Context, arg1 []types.CSARDependency, arg2 string) (types.ToscaTypeDescriptor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComplexTOSCAType", arg0, arg1, arg2)
+	ret0, _ := ret[0].(types.ToscaTypeDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComplexTOSCAType indicates an expected call of GetComplexTOSCAType.
+func (mr *MockCatalogServiceMockRecorder) GetComplexTOSCAType(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplexTOSCAType", reflect.TypeOf((*MockCatalogService)(nil).GetComplexTOSCAType), arg0, arg1, arg2)
+}
+
+// GetComponentUsage mocks base method.
+func (m *MockCatalogService) GetComponentUsage(arg0 context.Context, arg1, arg2 string) ([]types.ComponentUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComponentUsage", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]types.ComponentUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComponentUsage indicates an expected call of GetComponentUsage.
+func (mr *MockCatalogServiceMockRecorder) GetComponentUsage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComponentUsage", reflect.TypeOf((*MockCatalogService)(nil).GetComponentUsage), arg0, arg1, arg2)
+}
+
+// GetDependencyGraph mocks base method.
+func (m *MockCatalogService) GetDependencyGraph(arg0 context.Context, arg1, arg2 string) (*alien4cloud.CSARDependencyGraph, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDependencyGraph", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*alien4cloud.CSARDependencyGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDependencyGraph indicates an expected call of GetDependencyGraph.
+func (mr *MockCatalogServiceMockRecorder) GetDependencyGraph(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDependencyGraph", reflect.TypeOf((*MockCatalogService)(nil).GetDependencyGraph), arg0, arg1, arg2)
+}
+
+// GetWorkspaces mocks base method.
+func (m *MockCatalogService) GetWorkspaces(arg0 context.Context) ([]types.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaces", arg0)
+	ret0, _ := ret[0].([]types.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaces indicates an expected call of GetWorkspaces.
+func (mr *MockCatalogServiceMockRecorder) GetWorkspaces(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaces", reflect.TypeOf((*MockCatalogService)(nil).GetWorkspaces), arg0)
+}
+
+// ImportCSARGitRepository mocks base method.
+func (m *MockCatalogService) ImportCSARGitRepository(arg0 context.Context, arg1 string) ([]types.CSAR, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportCSARGitRepository", arg0, arg1)
+	ret0, _ := ret[0].([]types.CSAR)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportCSARGitRepository indicates an expected call of ImportCSARGitRepository.
+func (mr *MockCatalogServiceMockRecorder) ImportCSARGitRepository(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportCSARGitRepository", reflect.TypeOf((*MockCatalogService)(nil).ImportCSARGitRepository), arg0, arg1)
+}
+
+// PromoteCSAR mocks base method.
+func (m *MockCatalogService) PromoteCSAR(arg0 context.Context, arg1, arg2, arg3 string) (*types.PromotionRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteCSAR", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*types.PromotionRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoteCSAR indicates an expected call of PromoteCSAR.
+func (mr *MockCatalogServiceMockRecorder) PromoteCSAR(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteCSAR", reflect.TypeOf((*MockCatalogService)(nil).PromoteCSAR), arg0, arg1, arg2, arg3)
+}
+
+// SearchCSARGitRepositories mocks base method.
+func (m *MockCatalogService) SearchCSARGitRepositories(arg0 context.Context, arg1 types.SearchRequest) ([]types.CSARGitRepository, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchCSARGitRepositories", arg0, arg1)
+	ret0, _ := ret[0].([]types.CSARGitRepository)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchCSARGitRepositories indicates an expected call of SearchCSARGitRepositories.
+func (mr *MockCatalogServiceMockRecorder) SearchCSARGitRepositories(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCSARGitRepositories", reflect.TypeOf((*MockCatalogService)(nil).SearchCSARGitRepositories), arg0, arg1)
+}
+
+// SearchCSARs mocks base method.
+func (m *MockCatalogService) SearchCSARs(arg0 context.Context, arg1 types.SearchRequest) ([]types.CSAR, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchCSARs", arg0, arg1)
+	ret0, _ := ret[0].([]types.CSAR)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchCSARs indicates an expected call of SearchCSARs.
+func (mr *MockCatalogServiceMockRecorder) SearchCSARs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCSARs", reflect.TypeOf((*MockCatalogService)(nil).SearchCSARs), arg0, arg1)
+}
+
+// SearchComponents mocks base method.
+func (m *MockCatalogService) SearchComponents(arg0 context.Context, arg1 types.SearchRequest, arg2 string) (types.ComponentSearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchComponents", arg0, arg1, arg2)
+	ret0, _ := ret[0].(types.ComponentSearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchComponents indicates an expected call of SearchComponents.
+func (mr *MockCatalogServiceMockRecorder) SearchComponents(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchComponents", reflect.TypeOf((*MockCatalogService)(nil).SearchComponents), arg0, arg1, arg2)
+}
+
 // UploadCSAR mocks base method.
-func (m *MockCatalogService) UploadCSAR(arg0 context.Context, arg1 io.Reader, arg2 string) (alien4cloud.CSAR, error) {
+func (m *MockCatalogService) UploadCSAR(arg0 context.Context, arg1 io.Reader, arg2 string) (types.CSAR, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UploadCSAR", arg0, arg1, arg2)
-	ret0, _ := ret[0].(alien4cloud.CSAR)
+	ret0, _ := ret[0].(types.CSAR)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -50,3 +261,18 @@ func (mr *MockCatalogServiceMockRecorder) UploadCSAR(arg0, arg1, arg2 interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadCSAR", reflect.TypeOf((*MockCatalogService)(nil).UploadCSAR), arg0, arg1, arg2)
 }
+
+// UploadCSARWithProgress mocks base method.
+func (m *MockCatalogService) UploadCSARWithProgress(arg0 context.Context, arg1 io.Reader, arg2 string, arg3 func(int64, int64)) (types.CSAR, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadCSARWithProgress", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(types.CSAR)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadCSARWithProgress indicates an expected call of UploadCSARWithProgress.
+func (mr *MockCatalogServiceMockRecorder) UploadCSARWithProgress(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadCSARWithProgress", reflect.TypeOf((*MockCatalogService)(nil).UploadCSARWithProgress), arg0, arg1, arg2, arg3)
+}
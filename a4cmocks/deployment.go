@@ -6,10 +6,12 @@ package a4cmocks
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 	time "time"
 
 	alien4cloud "github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud"
+	types "github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -36,6 +38,35 @@ func (m *MockDeploymentService) EXPECT() *MockDeploymentServiceMockRecorder {
 	return m.recorder
 }
 
+// ApplyInputsDefaults mocks base method.
+func (m *MockDeploymentService) ApplyInputsDefaults(arg0 context.Context, arg1, arg2 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyInputsDefaults", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyInputsDefaults indicates an expected call of ApplyInputsDefaults.
+func (mr *MockDeploymentServiceMockRecorder) ApplyInputsDefaults(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyInputsDefaults", reflect.TypeOf((*MockDeploymentService)(nil).ApplyInputsDefaults), arg0, arg1, arg2)
+}
+
+// BindNodeToService mocks base method.
+func (m *MockDeploymentService) BindNodeToService(arg0 context.Context, arg1, arg2, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BindNodeToService", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BindNodeToService indicates an expected call of BindNodeToService.
+func (mr *MockDeploymentServiceMockRecorder) BindNodeToService(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindNodeToService", reflect.TypeOf((*MockDeploymentService)(nil).BindNodeToService), arg0, arg1, arg2, arg3, arg4)
+}
+
 // CancelExecution mocks base method.
 func (m *MockDeploymentService) CancelExecution(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -50,6 +81,55 @@ func (mr *MockDeploymentServiceMockRecorder) CancelExecution(arg0, arg1, arg2 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelExecution", reflect.TypeOf((*MockDeploymentService)(nil).CancelExecution), arg0, arg1, arg2)
 }
 
+// CheckResourceQuotas mocks base method.
+func (m *MockDeploymentService) CheckResourceQuotas(arg0 context.Context, arg1, arg2 string, arg3 map[string]int) (alien4cloud.QuotaReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckResourceQuotas", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(alien4cloud.QuotaReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckResourceQuotas indicates an expected call of CheckResourceQuotas.
+func (mr *MockDeploymentServiceMockRecorder) CheckResourceQuotas(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckResourceQuotas", reflect.TypeOf((*MockDeploymentService)(nil).CheckResourceQuotas), arg0, arg1, arg2, arg3)
+}
+
+// ComputeInputsDiff mocks base method.
+func (m *MockDeploymentService) ComputeInputsDiff(arg0 context.Context, arg1, arg2 string, arg3 map[string]interface{}) ([]types.InputChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ComputeInputsDiff", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]types.InputChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ComputeInputsDiff indicates an expected call of ComputeInputsDiff.
+func (mr *MockDeploymentServiceMockRecorder) ComputeInputsDiff(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComputeInputsDiff", reflect.TypeOf((*MockDeploymentService)(nil).ComputeInputsDiff), arg0, arg1, arg2, arg3)
+}
+
+// DeleteDeploymentAnnotations mocks base method.
+func (m *MockDeploymentService) DeleteDeploymentAnnotations(arg0 context.Context, arg1 string, arg2 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteDeploymentAnnotations", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDeploymentAnnotations indicates an expected call of DeleteDeploymentAnnotations.
+func (mr *MockDeploymentServiceMockRecorder) DeleteDeploymentAnnotations(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeploymentAnnotations", reflect.TypeOf((*MockDeploymentService)(nil).DeleteDeploymentAnnotations), varargs...)
+}
+
 // DeployApplication mocks base method.
 func (m *MockDeploymentService) DeployApplication(arg0 context.Context, arg1, arg2, arg3 string) error {
 	m.ctrl.T.Helper()
@@ -64,6 +144,64 @@ func (mr *MockDeploymentServiceMockRecorder) DeployApplication(arg0, arg1, arg2,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployApplication", reflect.TypeOf((*MockDeploymentService)(nil).DeployApplication), arg0, arg1, arg2, arg3)
 }
 
+// DeployApplicationAndWait mocks base method.
+func (m *MockDeploymentService) DeployApplicationAndWait(arg0 context.Context, arg1, arg2, arg3 string, arg4 alien4cloud.DeployAndWaitOptions) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployApplicationAndWait", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployApplicationAndWait indicates an expected call of DeployApplicationAndWait.
+func (mr *MockDeploymentServiceMockRecorder) DeployApplicationAndWait(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployApplicationAndWait", reflect.TypeOf((*MockDeploymentService)(nil).DeployApplicationAndWait), arg0, arg1, arg2, arg3, arg4)
+}
+
+// DeploymentsIterator mocks base method.
+func (m *MockDeploymentService) DeploymentsIterator(arg0 context.Context, arg1 types.DeploymentSearchRequest) *alien4cloud.DeploymentsIterator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeploymentsIterator", arg0, arg1)
+	ret0, _ := ret[0].(*alien4cloud.DeploymentsIterator)
+	return ret0
+}
+
+// DeploymentsIterator indicates an expected call of DeploymentsIterator.
+func (mr *MockDeploymentServiceMockRecorder) DeploymentsIterator(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeploymentsIterator", reflect.TypeOf((*MockDeploymentService)(nil).DeploymentsIterator), arg0, arg1)
+}
+
+// ExecutionsIterator mocks base method.
+func (m *MockDeploymentService) ExecutionsIterator(arg0 context.Context, arg1 string, arg2 alien4cloud.ExecutionsIteratorOptions) (*alien4cloud.ExecutionsIterator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecutionsIterator", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*alien4cloud.ExecutionsIterator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecutionsIterator indicates an expected call of ExecutionsIterator.
+func (mr *MockDeploymentServiceMockRecorder) ExecutionsIterator(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecutionsIterator", reflect.TypeOf((*MockDeploymentService)(nil).ExecutionsIterator), arg0, arg1, arg2)
+}
+
+// ExportExecutionDiagnostics mocks base method.
+func (m *MockDeploymentService) ExportExecutionDiagnostics(arg0 context.Context, arg1, arg2, arg3 string, arg4 io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportExecutionDiagnostics", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportExecutionDiagnostics indicates an expected call of ExportExecutionDiagnostics.
+func (mr *MockDeploymentServiceMockRecorder) ExportExecutionDiagnostics(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportExecutionDiagnostics", reflect.TypeOf((*MockDeploymentService)(nil).ExportExecutionDiagnostics), arg0, arg1, arg2, arg3, arg4)
+}
+
 // GetAttributesValue mocks base method.
 func (m *MockDeploymentService) GetAttributesValue(arg0 context.Context, arg1, arg2, arg3 string, arg4 []string) (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -94,11 +232,26 @@ func (mr *MockDeploymentServiceMockRecorder) GetCurrentDeploymentID(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentDeploymentID", reflect.TypeOf((*MockDeploymentService)(nil).GetCurrentDeploymentID), arg0, arg1, arg2)
 }
 
+// GetCustomCommands mocks base method.
+func (m *MockDeploymentService) GetCustomCommands(arg0 context.Context, arg1, arg2 string) ([]types.CustomCommand, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomCommands", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]types.CustomCommand)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomCommands indicates an expected call of GetCustomCommands.
+func (mr *MockDeploymentServiceMockRecorder) GetCustomCommands(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomCommands", reflect.TypeOf((*MockDeploymentService)(nil).GetCustomCommands), arg0, arg1, arg2)
+}
+
 // GetDeployment mocks base method.
-func (m *MockDeploymentService) GetDeployment(arg0 context.Context, arg1 string) (alien4cloud.Deployment, error) {
+func (m *MockDeploymentService) GetDeployment(arg0 context.Context, arg1 string) (types.Deployment, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetDeployment", arg0, arg1)
-	ret0, _ := ret[0].(alien4cloud.Deployment)
+	ret0, _ := ret[0].(types.Deployment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -109,11 +262,41 @@ func (mr *MockDeploymentServiceMockRecorder) GetDeployment(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeployment", reflect.TypeOf((*MockDeploymentService)(nil).GetDeployment), arg0, arg1)
 }
 
+// GetDeploymentAnnotations mocks base method.
+func (m *MockDeploymentService) GetDeploymentAnnotations(arg0 context.Context, arg1 string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeploymentAnnotations", arg0, arg1)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeploymentAnnotations indicates an expected call of GetDeploymentAnnotations.
+func (mr *MockDeploymentServiceMockRecorder) GetDeploymentAnnotations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeploymentAnnotations", reflect.TypeOf((*MockDeploymentService)(nil).GetDeploymentAnnotations), arg0, arg1)
+}
+
+// GetDeploymentInputArtifacts mocks base method.
+func (m *MockDeploymentService) GetDeploymentInputArtifacts(arg0 context.Context, arg1, arg2 string) (map[string]types.InputArtifactBinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeploymentInputArtifacts", arg0, arg1, arg2)
+	ret0, _ := ret[0].(map[string]types.InputArtifactBinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeploymentInputArtifacts indicates an expected call of GetDeploymentInputArtifacts.
+func (mr *MockDeploymentServiceMockRecorder) GetDeploymentInputArtifacts(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeploymentInputArtifacts", reflect.TypeOf((*MockDeploymentService)(nil).GetDeploymentInputArtifacts), arg0, arg1, arg2)
+}
+
 // GetDeploymentList mocks base method.
-func (m *MockDeploymentService) GetDeploymentList(arg0 context.Context, arg1, arg2 string) ([]alien4cloud.Deployment, error) {
+func (m *MockDeploymentService) GetDeploymentList(arg0 context.Context, arg1, arg2 string) ([]types.Deployment, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetDeploymentList", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]alien4cloud.Deployment)
+	ret0, _ := ret[0].([]types.Deployment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -139,11 +322,57 @@ func (mr *MockDeploymentServiceMockRecorder) GetDeploymentStatus(arg0, arg1, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeploymentStatus", reflect.TypeOf((*MockDeploymentService)(nil).GetDeploymentStatus), arg0, arg1, arg2)
 }
 
+// GetDeploymentStatusByID mocks base method.
+func (m *MockDeploymentService) GetDeploymentStatusByID(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeploymentStatusByID", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeploymentStatusByID indicates an expected call of GetDeploymentStatusByID.
+func (mr *MockDeploymentServiceMockRecorder) GetDeploymentStatusByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeploymentStatusByID", reflect.TypeOf((*MockDeploymentService)(nil).GetDeploymentStatusByID), arg0, arg1)
+}
+
+// GetDeployments mocks base method.
+func (m *MockDeploymentService) GetDeployments(arg0 context.Context, arg1 types.DeploymentSearchRequest) ([]types.Deployment, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeployments", arg0, arg1)
+	ret0, _ := ret[0].([]types.Deployment)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDeployments indicates an expected call of GetDeployments.
+func (mr *MockDeploymentServiceMockRecorder) GetDeployments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeployments", reflect.TypeOf((*MockDeploymentService)(nil).GetDeployments), arg0, arg1)
+}
+
+// GetEnvironmentsDeployedOn mocks base method.
+func (m *MockDeploymentService) GetEnvironmentsDeployedOn(arg0 context.Context, arg1, arg2 string) ([]alien4cloud.EnvironmentRef, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnvironmentsDeployedOn", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]alien4cloud.EnvironmentRef)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnvironmentsDeployedOn indicates an expected call of GetEnvironmentsDeployedOn.
+func (mr *MockDeploymentServiceMockRecorder) GetEnvironmentsDeployedOn(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironmentsDeployedOn", reflect.TypeOf((*MockDeploymentService)(nil).GetEnvironmentsDeployedOn), arg0, arg1, arg2)
+}
+
 // GetExecution mocks base method.
-func (m *MockDeploymentService) GetExecution(arg0 context.Context, arg1, arg2, arg3 string) (alien4cloud.Execution, error) {
+func (m *MockDeploymentService) GetExecution(arg0 context.Context, arg1, arg2, arg3 string) (types.Execution, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetExecution", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].(alien4cloud.Execution)
+	ret0, _ := ret[0].(types.Execution)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -155,10 +384,10 @@ func (mr *MockDeploymentServiceMockRecorder) GetExecution(arg0, arg1, arg2, arg3
 }
 
 // GetExecutionByID mocks base method.
-func (m *MockDeploymentService) GetExecutionByID(arg0 context.Context, arg1 string) (alien4cloud.Execution, error) {
+func (m *MockDeploymentService) GetExecutionByID(arg0 context.Context, arg1 string) (types.Execution, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetExecutionByID", arg0, arg1)
-	ret0, _ := ret[0].(alien4cloud.Execution)
+	ret0, _ := ret[0].(types.Execution)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -169,12 +398,57 @@ func (mr *MockDeploymentServiceMockRecorder) GetExecutionByID(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExecutionByID", reflect.TypeOf((*MockDeploymentService)(nil).GetExecutionByID), arg0, arg1)
 }
 
+// GetExecutionFailureReport mocks base method.
+func (m *MockDeploymentService) GetExecutionFailureReport(arg0 context.Context, arg1, arg2, arg3 string) (types.FailureReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExecutionFailureReport", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(types.FailureReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExecutionFailureReport indicates an expected call of GetExecutionFailureReport.
+func (mr *MockDeploymentServiceMockRecorder) GetExecutionFailureReport(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExecutionFailureReport", reflect.TypeOf((*MockDeploymentService)(nil).GetExecutionFailureReport), arg0, arg1, arg2, arg3)
+}
+
+// GetExecutionStepStatuses mocks base method.
+func (m *MockDeploymentService) GetExecutionStepStatuses(arg0 context.Context, arg1 string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExecutionStepStatuses", arg0, arg1)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExecutionStepStatuses indicates an expected call of GetExecutionStepStatuses.
+func (mr *MockDeploymentServiceMockRecorder) GetExecutionStepStatuses(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExecutionStepStatuses", reflect.TypeOf((*MockDeploymentService)(nil).GetExecutionStepStatuses), arg0, arg1)
+}
+
+// GetExecutionTasks mocks base method.
+func (m *MockDeploymentService) GetExecutionTasks(arg0 context.Context, arg1 string) ([]types.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExecutionTasks", arg0, arg1)
+	ret0, _ := ret[0].([]types.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExecutionTasks indicates an expected call of GetExecutionTasks.
+func (mr *MockDeploymentServiceMockRecorder) GetExecutionTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExecutionTasks", reflect.TypeOf((*MockDeploymentService)(nil).GetExecutionTasks), arg0, arg1)
+}
+
 // GetExecutions mocks base method.
-func (m *MockDeploymentService) GetExecutions(arg0 context.Context, arg1, arg2 string, arg3, arg4 int) ([]alien4cloud.Execution, alien4cloud.FacetedSearchResult, error) {
+func (m *MockDeploymentService) GetExecutions(arg0 context.Context, arg1, arg2 string, arg3, arg4 int) ([]types.Execution, types.FacetedSearchResult, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetExecutions", arg0, arg1, arg2, arg3, arg4)
-	ret0, _ := ret[0].([]alien4cloud.Execution)
-	ret1, _ := ret[1].(alien4cloud.FacetedSearchResult)
+	ret0, _ := ret[0].([]types.Execution)
+	ret1, _ := ret[1].(types.FacetedSearchResult)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
@@ -201,10 +475,10 @@ func (mr *MockDeploymentServiceMockRecorder) GetInstanceAttributesValue(arg0, ar
 }
 
 // GetLastWorkflowExecution mocks base method.
-func (m *MockDeploymentService) GetLastWorkflowExecution(arg0 context.Context, arg1, arg2 string) (*alien4cloud.WorkflowExecution, error) {
+func (m *MockDeploymentService) GetLastWorkflowExecution(arg0 context.Context, arg1, arg2 string) (*types.WorkflowExecution, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetLastWorkflowExecution", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*alien4cloud.WorkflowExecution)
+	ret0, _ := ret[0].(*types.WorkflowExecution)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -216,10 +490,10 @@ func (mr *MockDeploymentServiceMockRecorder) GetLastWorkflowExecution(arg0, arg1
 }
 
 // GetLocationsMatching mocks base method.
-func (m *MockDeploymentService) GetLocationsMatching(arg0 context.Context, arg1, arg2 string) ([]alien4cloud.LocationMatch, error) {
+func (m *MockDeploymentService) GetLocationsMatching(arg0 context.Context, arg1, arg2 string) ([]types.LocationMatch, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetLocationsMatching", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]alien4cloud.LocationMatch)
+	ret0, _ := ret[0].([]types.LocationMatch)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -230,6 +504,36 @@ func (mr *MockDeploymentServiceMockRecorder) GetLocationsMatching(arg0, arg1, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocationsMatching", reflect.TypeOf((*MockDeploymentService)(nil).GetLocationsMatching), arg0, arg1, arg2)
 }
 
+// GetMatchedResources mocks base method.
+func (m *MockDeploymentService) GetMatchedResources(arg0 context.Context, arg1, arg2 string) (map[string]types.LocationResourceTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMatchedResources", arg0, arg1, arg2)
+	ret0, _ := ret[0].(map[string]types.LocationResourceTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMatchedResources indicates an expected call of GetMatchedResources.
+func (mr *MockDeploymentServiceMockRecorder) GetMatchedResources(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatchedResources", reflect.TypeOf((*MockDeploymentService)(nil).GetMatchedResources), arg0, arg1, arg2)
+}
+
+// GetMatchingServices mocks base method.
+func (m *MockDeploymentService) GetMatchingServices(arg0 context.Context, arg1, arg2, arg3 string) ([]types.LocationResourceTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMatchingServices", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]types.LocationResourceTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMatchingServices indicates an expected call of GetMatchingServices.
+func (mr *MockDeploymentServiceMockRecorder) GetMatchingServices(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatchingServices", reflect.TypeOf((*MockDeploymentService)(nil).GetMatchingServices), arg0, arg1, arg2, arg3)
+}
+
 // GetNodeStatus mocks base method.
 func (m *MockDeploymentService) GetNodeStatus(arg0 context.Context, arg1, arg2, arg3 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -245,6 +549,21 @@ func (mr *MockDeploymentServiceMockRecorder) GetNodeStatus(arg0, arg1, arg2, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeStatus", reflect.TypeOf((*MockDeploymentService)(nil).GetNodeStatus), arg0, arg1, arg2, arg3)
 }
 
+// GetOrchestratorDeploymentInfo mocks base method.
+func (m *MockDeploymentService) GetOrchestratorDeploymentInfo(arg0 context.Context, arg1, arg2 string) (types.OrchestratorDeploymentInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrchestratorDeploymentInfo", arg0, arg1, arg2)
+	ret0, _ := ret[0].(types.OrchestratorDeploymentInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrchestratorDeploymentInfo indicates an expected call of GetOrchestratorDeploymentInfo.
+func (mr *MockDeploymentServiceMockRecorder) GetOrchestratorDeploymentInfo(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrchestratorDeploymentInfo", reflect.TypeOf((*MockDeploymentService)(nil).GetOrchestratorDeploymentInfo), arg0, arg1, arg2)
+}
+
 // GetOutputAttributes mocks base method.
 func (m *MockDeploymentService) GetOutputAttributes(arg0 context.Context, arg1, arg2 string) (map[string][]string, error) {
 	m.ctrl.T.Helper()
@@ -260,11 +579,99 @@ func (mr *MockDeploymentServiceMockRecorder) GetOutputAttributes(arg0, arg1, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutputAttributes", reflect.TypeOf((*MockDeploymentService)(nil).GetOutputAttributes), arg0, arg1, arg2)
 }
 
+// GetRuntimeWorkflows mocks base method.
+func (m *MockDeploymentService) GetRuntimeWorkflows(arg0 context.Context, arg1, arg2 string) ([]types.RuntimeWorkflow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRuntimeWorkflows", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]types.RuntimeWorkflow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRuntimeWorkflows indicates an expected call of GetRuntimeWorkflows.
+func (mr *MockDeploymentServiceMockRecorder) GetRuntimeWorkflows(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuntimeWorkflows", reflect.TypeOf((*MockDeploymentService)(nil).GetRuntimeWorkflows), arg0, arg1, arg2)
+}
+
+// GetSubstitutionCandidates mocks base method.
+func (m *MockDeploymentService) GetSubstitutionCandidates(arg0 context.Context, arg1, arg2 string) (map[string][]types.LocationResourceTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubstitutionCandidates", arg0, arg1, arg2)
+	ret0, _ := ret[0].(map[string][]types.LocationResourceTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubstitutionCandidates indicates an expected call of GetSubstitutionCandidates.
+func (mr *MockDeploymentServiceMockRecorder) GetSubstitutionCandidates(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubstitutionCandidates", reflect.TypeOf((*MockDeploymentService)(nil).GetSubstitutionCandidates), arg0, arg1, arg2)
+}
+
+// RelaunchExecution mocks base method.
+func (m *MockDeploymentService) RelaunchExecution(arg0 context.Context, arg1, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelaunchExecution", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelaunchExecution indicates an expected call of RelaunchExecution.
+func (mr *MockDeploymentServiceMockRecorder) RelaunchExecution(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelaunchExecution", reflect.TypeOf((*MockDeploymentService)(nil).RelaunchExecution), arg0, arg1, arg2)
+}
+
+// ResetDeploymentInputArtifact mocks base method.
+func (m *MockDeploymentService) ResetDeploymentInputArtifact(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetDeploymentInputArtifact", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetDeploymentInputArtifact indicates an expected call of ResetDeploymentInputArtifact.
+func (mr *MockDeploymentServiceMockRecorder) ResetDeploymentInputArtifact(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetDeploymentInputArtifact", reflect.TypeOf((*MockDeploymentService)(nil).ResetDeploymentInputArtifact), arg0, arg1, arg2, arg3)
+}
+
+// ResumeExecution mocks base method.
+func (m *MockDeploymentService) ResumeExecution(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeExecution", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeExecution indicates an expected call of ResumeExecution.
+func (mr *MockDeploymentServiceMockRecorder) ResumeExecution(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeExecution", reflect.TypeOf((*MockDeploymentService)(nil).ResumeExecution), arg0, arg1, arg2)
+}
+
+// RunCustomCommand mocks base method.
+func (m *MockDeploymentService) RunCustomCommand(arg0 context.Context, arg1, arg2 string, arg3 types.CustomCommandRequest) (map[string]interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunCustomCommand", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(map[string]interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunCustomCommand indicates an expected call of RunCustomCommand.
+func (mr *MockDeploymentServiceMockRecorder) RunCustomCommand(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunCustomCommand", reflect.TypeOf((*MockDeploymentService)(nil).RunCustomCommand), arg0, arg1, arg2, arg3)
+}
+
 // RunWorkflow mocks base method.
-func (m *MockDeploymentService) RunWorkflow(arg0 context.Context, arg1, arg2, arg3 string, arg4 time.Duration) (*alien4cloud.Execution, error) {
+func (m *MockDeploymentService) RunWorkflow(arg0 context.Context, arg1, arg2, arg3 string, arg4 time.Duration) (*types.Execution, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RunWorkflow", arg0, arg1, arg2, arg3, arg4)
-	ret0, _ := ret[0].(*alien4cloud.Execution)
+	ret0, _ := ret[0].(*types.Execution)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -305,11 +712,26 @@ func (mr *MockDeploymentServiceMockRecorder) RunWorkflowAsyncWithParameters(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunWorkflowAsyncWithParameters", reflect.TypeOf((*MockDeploymentService)(nil).RunWorkflowAsyncWithParameters), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// RunWorkflowWatch mocks base method.
+func (m *MockDeploymentService) RunWorkflowWatch(arg0 context.Context, arg1, arg2, arg3 string, arg4 map[string]interface{}, arg5 alien4cloud.WorkflowStepCallback, arg6 alien4cloud.ExecutionCallback) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunWorkflowWatch", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunWorkflowWatch indicates an expected call of RunWorkflowWatch.
+func (mr *MockDeploymentServiceMockRecorder) RunWorkflowWatch(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunWorkflowWatch", reflect.TypeOf((*MockDeploymentService)(nil).RunWorkflowWatch), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
 // RunWorkflowWithParameters mocks base method.
-func (m *MockDeploymentService) RunWorkflowWithParameters(arg0 context.Context, arg1, arg2, arg3 string, arg4 map[string]interface{}, arg5 time.Duration) (*alien4cloud.Execution, error) {
+func (m *MockDeploymentService) RunWorkflowWithParameters(arg0 context.Context, arg1, arg2, arg3 string, arg4 map[string]interface{}, arg5 time.Duration) (*types.Execution, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RunWorkflowWithParameters", arg0, arg1, arg2, arg3, arg4, arg5)
-	ret0, _ := ret[0].(*alien4cloud.Execution)
+	ret0, _ := ret[0].(*types.Execution)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -320,6 +742,91 @@ func (mr *MockDeploymentServiceMockRecorder) RunWorkflowWithParameters(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunWorkflowWithParameters", reflect.TypeOf((*MockDeploymentService)(nil).RunWorkflowWithParameters), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// SetDeploymentAnnotations mocks base method.
+func (m *MockDeploymentService) SetDeploymentAnnotations(arg0 context.Context, arg1 string, arg2 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDeploymentAnnotations", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDeploymentAnnotations indicates an expected call of SetDeploymentAnnotations.
+func (mr *MockDeploymentServiceMockRecorder) SetDeploymentAnnotations(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDeploymentAnnotations", reflect.TypeOf((*MockDeploymentService)(nil).SetDeploymentAnnotations), arg0, arg1, arg2)
+}
+
+// SetInstanceMaintenanceMode mocks base method.
+func (m *MockDeploymentService) SetInstanceMaintenanceMode(arg0 context.Context, arg1, arg2, arg3, arg4 string, arg5 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetInstanceMaintenanceMode", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetInstanceMaintenanceMode indicates an expected call of SetInstanceMaintenanceMode.
+func (mr *MockDeploymentServiceMockRecorder) SetInstanceMaintenanceMode(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInstanceMaintenanceMode", reflect.TypeOf((*MockDeploymentService)(nil).SetInstanceMaintenanceMode), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SetMaintenanceMode mocks base method.
+func (m *MockDeploymentService) SetMaintenanceMode(arg0 context.Context, arg1, arg2 string, arg3 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMaintenanceMode", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMaintenanceMode indicates an expected call of SetMaintenanceMode.
+func (mr *MockDeploymentServiceMockRecorder) SetMaintenanceMode(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaintenanceMode", reflect.TypeOf((*MockDeploymentService)(nil).SetMaintenanceMode), arg0, arg1, arg2, arg3)
+}
+
+// SetNodeSubstitution mocks base method.
+func (m *MockDeploymentService) SetNodeSubstitution(arg0 context.Context, arg1, arg2, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNodeSubstitution", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNodeSubstitution indicates an expected call of SetNodeSubstitution.
+func (mr *MockDeploymentServiceMockRecorder) SetNodeSubstitution(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNodeSubstitution", reflect.TypeOf((*MockDeploymentService)(nil).SetNodeSubstitution), arg0, arg1, arg2, arg3, arg4)
+}
+
+// UnbindNodeFromService mocks base method.
+func (m *MockDeploymentService) UnbindNodeFromService(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbindNodeFromService", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnbindNodeFromService indicates an expected call of UnbindNodeFromService.
+func (mr *MockDeploymentServiceMockRecorder) UnbindNodeFromService(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbindNodeFromService", reflect.TypeOf((*MockDeploymentService)(nil).UnbindNodeFromService), arg0, arg1, arg2, arg3)
+}
+
+// UndeployAllEnvironments mocks base method.
+func (m *MockDeploymentService) UndeployAllEnvironments(arg0 context.Context, arg1 string, arg2 alien4cloud.UndeployAllOptions) ([]alien4cloud.UndeployResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UndeployAllEnvironments", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]alien4cloud.UndeployResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UndeployAllEnvironments indicates an expected call of UndeployAllEnvironments.
+func (mr *MockDeploymentServiceMockRecorder) UndeployAllEnvironments(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndeployAllEnvironments", reflect.TypeOf((*MockDeploymentService)(nil).UndeployAllEnvironments), arg0, arg1, arg2)
+}
+
 // UndeployApplication mocks base method.
 func (m *MockDeploymentService) UndeployApplication(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -349,7 +856,7 @@ func (mr *MockDeploymentServiceMockRecorder) UpdateApplication(arg0, arg1, arg2
 }
 
 // UpdateDeploymentTopology mocks base method.
-func (m *MockDeploymentService) UpdateDeploymentTopology(arg0 context.Context, arg1, arg2 string, arg3 alien4cloud.UpdateDeploymentTopologyRequest) error {
+func (m *MockDeploymentService) UpdateDeploymentTopology(arg0 context.Context, arg1, arg2 string, arg3 types.UpdateDeploymentTopologyRequest) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateDeploymentTopology", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
@@ -395,3 +902,37 @@ func (mr *MockDeploymentServiceMockRecorder) WaitUntilStateIs(arg0, arg1, arg2 i
 	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilStateIs", reflect.TypeOf((*MockDeploymentService)(nil).WaitUntilStateIs), varargs...)
 }
+
+// WaitUntilStateIsWithOptions mocks base method.
+func (m *MockDeploymentService) WaitUntilStateIsWithOptions(arg0 context.Context, arg1, arg2 string, arg3 alien4cloud.WaitUntilStateOptions, arg4 ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WaitUntilStateIsWithOptions", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitUntilStateIsWithOptions indicates an expected call of WaitUntilStateIsWithOptions.
+func (mr *MockDeploymentServiceMockRecorder) WaitUntilStateIsWithOptions(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilStateIsWithOptions", reflect.TypeOf((*MockDeploymentService)(nil).WaitUntilStateIsWithOptions), varargs...)
+}
+
+// WatchAttributes mocks base method.
+func (m *MockDeploymentService) WatchAttributes(arg0 context.Context, arg1, arg2, arg3 string, arg4 []string, arg5 time.Duration, arg6 alien4cloud.AttributeChangeCallback) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchAttributes", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchAttributes indicates an expected call of WatchAttributes.
+func (mr *MockDeploymentServiceMockRecorder) WatchAttributes(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchAttributes", reflect.TypeOf((*MockDeploymentService)(nil).WatchAttributes), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
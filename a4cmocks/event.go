@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	alien4cloud "github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud"
+	types "github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -36,10 +37,10 @@ func (m *MockEventService) EXPECT() *MockEventServiceMockRecorder {
 }
 
 // GetEventsForApplicationEnvironment mocks base method.
-func (m *MockEventService) GetEventsForApplicationEnvironment(arg0 context.Context, arg1 string, arg2, arg3 int) ([]alien4cloud.Event, int, error) {
+func (m *MockEventService) GetEventsForApplicationEnvironment(arg0 context.Context, arg1 string, arg2, arg3 int) ([]types.Event, int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetEventsForApplicationEnvironment", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].([]alien4cloud.Event)
+	ret0, _ := ret[0].([]types.Event)
 	ret1, _ := ret[1].(int)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
@@ -50,3 +51,17 @@ func (mr *MockEventServiceMockRecorder) GetEventsForApplicationEnvironment(arg0,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsForApplicationEnvironment", reflect.TypeOf((*MockEventService)(nil).GetEventsForApplicationEnvironment), arg0, arg1, arg2, arg3)
 }
+
+// SubscribeToEvents mocks base method.
+func (m *MockEventService) SubscribeToEvents(arg0 context.Context, arg1 string, arg2 alien4cloud.EventCallback) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeToEvents", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribeToEvents indicates an expected call of SubscribeToEvents.
+func (mr *MockEventServiceMockRecorder) SubscribeToEvents(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToEvents", reflect.TypeOf((*MockEventService)(nil).SubscribeToEvents), arg0, arg1, arg2)
+}
@@ -0,0 +1,166 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud (interfaces: LocationService)
+
+// Package a4cmocks is a generated GoMock package.
+package a4cmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/types"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLocationService is a mock of LocationService interface.
+type MockLocationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLocationServiceMockRecorder
+}
+
+// MockLocationServiceMockRecorder is the mock recorder for MockLocationService.
+type MockLocationServiceMockRecorder struct {
+	mock *MockLocationService
+}
+
+// NewMockLocationService creates a new mock instance.
+func NewMockLocationService(ctrl *gomock.Controller) *MockLocationService {
+	mock := &MockLocationService{ctrl: ctrl}
+	mock.recorder = &MockLocationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocationService) EXPECT() *MockLocationServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateLocation mocks base method.
+func (m *MockLocationService) CreateLocation(arg0 context.Context, arg1, arg2, arg3 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLocation", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLocation indicates an expected call of CreateLocation.
+func (mr *MockLocationServiceMockRecorder) CreateLocation(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLocation", reflect.TypeOf((*MockLocationService)(nil).CreateLocation), arg0, arg1, arg2, arg3)
+}
+
+// CreateLocationResource mocks base method.
+func (m *MockLocationService) CreateLocationResource(arg0 context.Context, arg1, arg2 string, arg3 types.LocationResourceCreateRequest) (types.LocationResourceTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLocationResource", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(types.LocationResourceTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLocationResource indicates an expected call of CreateLocationResource.
+func (mr *MockLocationServiceMockRecorder) CreateLocationResource(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLocationResource", reflect.TypeOf((*MockLocationService)(nil).CreateLocationResource), arg0, arg1, arg2, arg3)
+}
+
+// DeleteLocation mocks base method.
+func (m *MockLocationService) DeleteLocation(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLocation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLocation indicates an expected call of DeleteLocation.
+func (mr *MockLocationServiceMockRecorder) DeleteLocation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLocation", reflect.TypeOf((*MockLocationService)(nil).DeleteLocation), arg0, arg1, arg2)
+}
+
+// DeleteLocationResource mocks base method.
+func (m *MockLocationService) DeleteLocationResource(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLocationResource", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLocationResource indicates an expected call of DeleteLocationResource.
+func (mr *MockLocationServiceMockRecorder) DeleteLocationResource(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLocationResource", reflect.TypeOf((*MockLocationService)(nil).DeleteLocationResource), arg0, arg1, arg2, arg3)
+}
+
+// GetLocationResources mocks base method.
+func (m *MockLocationService) GetLocationResources(arg0 context.Context, arg1, arg2 string) ([]types.LocationResourceTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocationResources", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]types.LocationResourceTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLocationResources indicates an expected call of GetLocationResources.
+func (mr *MockLocationServiceMockRecorder) GetLocationResources(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocationResources", reflect.TypeOf((*MockLocationService)(nil).GetLocationResources), arg0, arg1, arg2)
+}
+
+// GetLocations mocks base method.
+func (m *MockLocationService) GetLocations(arg0 context.Context, arg1 string) ([]types.LocationConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocations", arg0, arg1)
+	ret0, _ := ret[0].([]types.LocationConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLocations indicates an expected call of GetLocations.
+func (mr *MockLocationServiceMockRecorder) GetLocations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocations", reflect.TypeOf((*MockLocationService)(nil).GetLocations), arg0, arg1)
+}
+
+// SetLocationResourceProperty mocks base method.
+func (m *MockLocationService) SetLocationResourceProperty(arg0 context.Context, arg1, arg2, arg3, arg4 string, arg5 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLocationResourceProperty", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLocationResourceProperty indicates an expected call of SetLocationResourceProperty.
+func (mr *MockLocationServiceMockRecorder) SetLocationResourceProperty(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLocationResourceProperty", reflect.TypeOf((*MockLocationService)(nil).SetLocationResourceProperty), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// UpdateLocationMetaProperties mocks base method.
+func (m *MockLocationService) UpdateLocationMetaProperties(arg0 context.Context, arg1, arg2 string, arg3 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLocationMetaProperties", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLocationMetaProperties indicates an expected call of UpdateLocationMetaProperties.
+func (mr *MockLocationServiceMockRecorder) UpdateLocationMetaProperties(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLocationMetaProperties", reflect.TypeOf((*MockLocationService)(nil).UpdateLocationMetaProperties), arg0, arg1, arg2, arg3)
+}
+
+// UpdateLocationResource mocks base method.
+func (m *MockLocationService) UpdateLocationResource(arg0 context.Context, arg1, arg2, arg3 string, arg4 types.LocationResourceUpdateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLocationResource", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLocationResource indicates an expected call of UpdateLocationResource.
+func (mr *MockLocationServiceMockRecorder) UpdateLocationResource(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLocationResource", reflect.TypeOf((*MockLocationService)(nil).UpdateLocationResource), arg0, arg1, arg2, arg3, arg4)
+}
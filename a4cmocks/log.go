@@ -7,8 +7,10 @@ package a4cmocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	alien4cloud "github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud"
+	types "github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -35,11 +37,26 @@ func (m *MockLogService) EXPECT() *MockLogServiceMockRecorder {
 	return m.recorder
 }
 
+// GetExecutionLogsSummary mocks base method.
+func (m *MockLogService) GetExecutionLogsSummary(arg0 context.Context, arg1, arg2, arg3 string) (types.LogsSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExecutionLogsSummary", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(types.LogsSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExecutionLogsSummary indicates an expected call of GetExecutionLogsSummary.
+func (mr *MockLogServiceMockRecorder) GetExecutionLogsSummary(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExecutionLogsSummary", reflect.TypeOf((*MockLogService)(nil).GetExecutionLogsSummary), arg0, arg1, arg2, arg3)
+}
+
 // GetLogsOfApplication mocks base method.
-func (m *MockLogService) GetLogsOfApplication(arg0 context.Context, arg1, arg2 string, arg3 alien4cloud.LogFilter, arg4 int) ([]alien4cloud.Log, int, error) {
+func (m *MockLogService) GetLogsOfApplication(arg0 context.Context, arg1, arg2 string, arg3 types.LogFilter, arg4 int) ([]types.Log, int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetLogsOfApplication", arg0, arg1, arg2, arg3, arg4)
-	ret0, _ := ret[0].([]alien4cloud.Log)
+	ret0, _ := ret[0].([]types.Log)
 	ret1, _ := ret[1].(int)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
@@ -50,3 +67,17 @@ func (mr *MockLogServiceMockRecorder) GetLogsOfApplication(arg0, arg1, arg2, arg
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogsOfApplication", reflect.TypeOf((*MockLogService)(nil).GetLogsOfApplication), arg0, arg1, arg2, arg3, arg4)
 }
+
+// StreamLogs mocks base method.
+func (m *MockLogService) StreamLogs(arg0 context.Context, arg1, arg2 string, arg3 types.LogFilter, arg4 time.Duration, arg5 alien4cloud.LogCallback) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamLogs", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamLogs indicates an expected call of StreamLogs.
+func (mr *MockLogServiceMockRecorder) StreamLogs(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamLogs", reflect.TypeOf((*MockLogService)(nil).StreamLogs), arg0, arg1, arg2, arg3, arg4, arg5)
+}
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	alien4cloud "github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud"
+	types "github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -35,6 +36,78 @@ func (m *MockOrchestratorService) EXPECT() *MockOrchestratorServiceMockRecorder
 	return m.recorder
 }
 
+// CreateOrchestrator mocks base method.
+func (m *MockOrchestratorService) CreateOrchestrator(arg0 context.Context, arg1, arg2, arg3 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrchestrator", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrchestrator indicates an expected call of CreateOrchestrator.
+func (mr *MockOrchestratorServiceMockRecorder) CreateOrchestrator(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrchestrator", reflect.TypeOf((*MockOrchestratorService)(nil).CreateOrchestrator), arg0, arg1, arg2, arg3)
+}
+
+// DeleteOrchestrator mocks base method.
+func (m *MockOrchestratorService) DeleteOrchestrator(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrchestrator", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrchestrator indicates an expected call of DeleteOrchestrator.
+func (mr *MockOrchestratorServiceMockRecorder) DeleteOrchestrator(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrchestrator", reflect.TypeOf((*MockOrchestratorService)(nil).DeleteOrchestrator), arg0, arg1)
+}
+
+// DisableOrchestrator mocks base method.
+func (m *MockOrchestratorService) DisableOrchestrator(arg0 context.Context, arg1 string, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableOrchestrator", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableOrchestrator indicates an expected call of DisableOrchestrator.
+func (mr *MockOrchestratorServiceMockRecorder) DisableOrchestrator(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableOrchestrator", reflect.TypeOf((*MockOrchestratorService)(nil).DisableOrchestrator), arg0, arg1, arg2)
+}
+
+// EnableOrchestrator mocks base method.
+func (m *MockOrchestratorService) EnableOrchestrator(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableOrchestrator", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableOrchestrator indicates an expected call of EnableOrchestrator.
+func (mr *MockOrchestratorServiceMockRecorder) EnableOrchestrator(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableOrchestrator", reflect.TypeOf((*MockOrchestratorService)(nil).EnableOrchestrator), arg0, arg1)
+}
+
+// GetOrchestratorConfiguration mocks base method.
+func (m *MockOrchestratorService) GetOrchestratorConfiguration(arg0 context.Context, arg1 string) (map[string]interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrchestratorConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(map[string]interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrchestratorConfiguration indicates an expected call of GetOrchestratorConfiguration.
+func (mr *MockOrchestratorServiceMockRecorder) GetOrchestratorConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrchestratorConfiguration", reflect.TypeOf((*MockOrchestratorService)(nil).GetOrchestratorConfiguration), arg0, arg1)
+}
+
 // GetOrchestratorIDbyName mocks base method.
 func (m *MockOrchestratorService) GetOrchestratorIDbyName(arg0 context.Context, arg1 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -51,10 +124,10 @@ func (mr *MockOrchestratorServiceMockRecorder) GetOrchestratorIDbyName(arg0, arg
 }
 
 // GetOrchestratorLocations mocks base method.
-func (m *MockOrchestratorService) GetOrchestratorLocations(arg0 context.Context, arg1 string) ([]alien4cloud.Location, error) {
+func (m *MockOrchestratorService) GetOrchestratorLocations(arg0 context.Context, arg1 string) ([]types.Location, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetOrchestratorLocations", arg0, arg1)
-	ret0, _ := ret[0].([]alien4cloud.Location)
+	ret0, _ := ret[0].([]types.Location)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -64,3 +137,72 @@ func (mr *MockOrchestratorServiceMockRecorder) GetOrchestratorLocations(arg0, ar
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrchestratorLocations", reflect.TypeOf((*MockOrchestratorService)(nil).GetOrchestratorLocations), arg0, arg1)
 }
+
+// ListOrchestrators mocks base method.
+func (m *MockOrchestratorService) ListOrchestrators(arg0 context.Context) ([]types.OrchestratorSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrchestrators", arg0)
+	ret0, _ := ret[0].([]types.OrchestratorSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrchestrators indicates an expected call of ListOrchestrators.
+func (mr *MockOrchestratorServiceMockRecorder) ListOrchestrators(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrchestrators", reflect.TypeOf((*MockOrchestratorService)(nil).ListOrchestrators), arg0)
+}
+
+// SetOrchestratorConfiguration mocks base method.
+func (m *MockOrchestratorService) SetOrchestratorConfiguration(arg0 context.Context, arg1 string, arg2 map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOrchestratorConfiguration", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOrchestratorConfiguration indicates an expected call of SetOrchestratorConfiguration.
+func (mr *MockOrchestratorServiceMockRecorder) SetOrchestratorConfiguration(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOrchestratorConfiguration", reflect.TypeOf((*MockOrchestratorService)(nil).SetOrchestratorConfiguration), arg0, arg1, arg2)
+}
+
+// WaitForOrchestratorState mocks base method.
+func (m *MockOrchestratorService) WaitForOrchestratorState(arg0 context.Context, arg1 string, arg2 ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WaitForOrchestratorState", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForOrchestratorState indicates an expected call of WaitForOrchestratorState.
+func (mr *MockOrchestratorServiceMockRecorder) WaitForOrchestratorState(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForOrchestratorState", reflect.TypeOf((*MockOrchestratorService)(nil).WaitForOrchestratorState), varargs...)
+}
+
+// WaitForOrchestratorStateWithOptions mocks base method.
+func (m *MockOrchestratorService) WaitForOrchestratorStateWithOptions(arg0 context.Context, arg1 string, arg2 alien4cloud.WaitForOrchestratorStateOptions, arg3 ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WaitForOrchestratorStateWithOptions", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForOrchestratorStateWithOptions indicates an expected call of WaitForOrchestratorStateWithOptions.
+func (mr *MockOrchestratorServiceMockRecorder) WaitForOrchestratorStateWithOptions(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForOrchestratorStateWithOptions", reflect.TypeOf((*MockOrchestratorService)(nil).WaitForOrchestratorStateWithOptions), varargs...)
+}
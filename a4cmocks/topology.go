@@ -6,9 +6,11 @@ package a4cmocks
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	alien4cloud "github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud"
+	types "github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -35,6 +37,53 @@ func (m *MockTopologyService) EXPECT() *MockTopologyServiceMockRecorder {
 	return m.recorder
 }
 
+// AddAbstractNode mocks base method.
+func (m *MockTopologyService) AddAbstractNode(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAbstractNode", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAbstractNode indicates an expected call of AddAbstractNode.
+func (mr *MockTopologyServiceMockRecorder) AddAbstractNode(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAbstractNode", reflect.TypeOf((*MockTopologyService)(nil).AddAbstractNode), arg0, arg1, arg2, arg3, arg4)
+}
+
+// AddGroupPolicy mocks base method.
+func (m *MockTopologyService) AddGroupPolicy(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3 string, arg4 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddGroupPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddGroupPolicy indicates an expected call of AddGroupPolicy.
+func (mr *MockTopologyServiceMockRecorder) AddGroupPolicy(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroupPolicy", reflect.TypeOf((*MockTopologyService)(nil).AddGroupPolicy), varargs...)
+}
+
+// AddInput mocks base method.
+func (m *MockTopologyService) AddInput(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2 string, arg3 types.PropertyDefinition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddInput", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddInput indicates an expected call of AddInput.
+func (mr *MockTopologyServiceMockRecorder) AddInput(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddInput", reflect.TypeOf((*MockTopologyService)(nil).AddInput), arg0, arg1, arg2, arg3)
+}
+
 // AddNodeInA4CTopology mocks base method.
 func (m *MockTopologyService) AddNodeInA4CTopology(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3 string) error {
 	m.ctrl.T.Helper()
@@ -49,6 +98,20 @@ func (mr *MockTopologyServiceMockRecorder) AddNodeInA4CTopology(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNodeInA4CTopology", reflect.TypeOf((*MockTopologyService)(nil).AddNodeInA4CTopology), arg0, arg1, arg2, arg3)
 }
 
+// AddNodeToGroup mocks base method.
+func (m *MockTopologyService) AddNodeToGroup(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNodeToGroup", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddNodeToGroup indicates an expected call of AddNodeToGroup.
+func (mr *MockTopologyServiceMockRecorder) AddNodeToGroup(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNodeToGroup", reflect.TypeOf((*MockTopologyService)(nil).AddNodeToGroup), arg0, arg1, arg2, arg3)
+}
+
 // AddPolicy mocks base method.
 func (m *MockTopologyService) AddPolicy(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3 string) error {
 	m.ctrl.T.Helper()
@@ -105,6 +168,39 @@ func (mr *MockTopologyServiceMockRecorder) AddWorkflowActivity(arg0, arg1, arg2,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowActivity", reflect.TypeOf((*MockTopologyService)(nil).AddWorkflowActivity), arg0, arg1, arg2, arg3)
 }
 
+// AddWorkflowEdge mocks base method.
+func (m *MockTopologyService) AddWorkflowEdge(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowEdge", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddWorkflowEdge indicates an expected call of AddWorkflowEdge.
+func (mr *MockTopologyServiceMockRecorder) AddWorkflowEdge(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowEdge", reflect.TypeOf((*MockTopologyService)(nil).AddWorkflowEdge), arg0, arg1, arg2, arg3, arg4)
+}
+
+// CreateGroup mocks base method.
+func (m *MockTopologyService) CreateGroup(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2 string, arg3 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateGroup", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateGroup indicates an expected call of CreateGroup.
+func (mr *MockTopologyServiceMockRecorder) CreateGroup(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*MockTopologyService)(nil).CreateGroup), varargs...)
+}
+
 // CreateWorkflow mocks base method.
 func (m *MockTopologyService) CreateWorkflow(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -119,6 +215,48 @@ func (mr *MockTopologyServiceMockRecorder) CreateWorkflow(arg0, arg1, arg2 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkflow", reflect.TypeOf((*MockTopologyService)(nil).CreateWorkflow), arg0, arg1, arg2)
 }
 
+// DeleteGroup mocks base method.
+func (m *MockTopologyService) DeleteGroup(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroup", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroup indicates an expected call of DeleteGroup.
+func (mr *MockTopologyServiceMockRecorder) DeleteGroup(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*MockTopologyService)(nil).DeleteGroup), arg0, arg1, arg2)
+}
+
+// DeleteInput mocks base method.
+func (m *MockTopologyService) DeleteInput(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInput", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteInput indicates an expected call of DeleteInput.
+func (mr *MockTopologyServiceMockRecorder) DeleteInput(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInput", reflect.TypeOf((*MockTopologyService)(nil).DeleteInput), arg0, arg1, arg2)
+}
+
+// DeleteNode mocks base method.
+func (m *MockTopologyService) DeleteNode(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNode", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNode indicates an expected call of DeleteNode.
+func (mr *MockTopologyServiceMockRecorder) DeleteNode(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNode", reflect.TypeOf((*MockTopologyService)(nil).DeleteNode), arg0, arg1, arg2)
+}
+
 // DeletePolicy mocks base method.
 func (m *MockTopologyService) DeletePolicy(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -133,6 +271,20 @@ func (mr *MockTopologyServiceMockRecorder) DeletePolicy(arg0, arg1, arg2 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePolicy", reflect.TypeOf((*MockTopologyService)(nil).DeletePolicy), arg0, arg1, arg2)
 }
 
+// DeleteRelationship mocks base method.
+func (m *MockTopologyService) DeleteRelationship(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRelationship", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRelationship indicates an expected call of DeleteRelationship.
+func (mr *MockTopologyServiceMockRecorder) DeleteRelationship(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRelationship", reflect.TypeOf((*MockTopologyService)(nil).DeleteRelationship), arg0, arg1, arg2, arg3)
+}
+
 // DeleteWorkflow mocks base method.
 func (m *MockTopologyService) DeleteWorkflow(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -147,11 +299,60 @@ func (mr *MockTopologyServiceMockRecorder) DeleteWorkflow(arg0, arg1, arg2 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflow", reflect.TypeOf((*MockTopologyService)(nil).DeleteWorkflow), arg0, arg1, arg2)
 }
 
+// DuplicateWorkflow mocks base method.
+func (m *MockTopologyService) DuplicateWorkflow(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DuplicateWorkflow", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DuplicateWorkflow indicates an expected call of DuplicateWorkflow.
+func (mr *MockTopologyServiceMockRecorder) DuplicateWorkflow(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DuplicateWorkflow", reflect.TypeOf((*MockTopologyService)(nil).DuplicateWorkflow), arg0, arg1, arg2, arg3)
+}
+
+// GetGroupMembers mocks base method.
+func (m *MockTopologyService) GetGroupMembers(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2 ...string) ([]string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGroupMembers", varargs...)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupMembers indicates an expected call of GetGroupMembers.
+func (mr *MockTopologyServiceMockRecorder) GetGroupMembers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembers", reflect.TypeOf((*MockTopologyService)(nil).GetGroupMembers), varargs...)
+}
+
+// GetNodeOperations mocks base method.
+func (m *MockTopologyService) GetNodeOperations(arg0 context.Context, arg1, arg2, arg3 string) ([]types.NodeOperationDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodeOperations", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]types.NodeOperationDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNodeOperations indicates an expected call of GetNodeOperations.
+func (mr *MockTopologyServiceMockRecorder) GetNodeOperations(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeOperations", reflect.TypeOf((*MockTopologyService)(nil).GetNodeOperations), arg0, arg1, arg2, arg3)
+}
+
 // GetTopologies mocks base method.
-func (m *MockTopologyService) GetTopologies(arg0 context.Context, arg1 string) ([]alien4cloud.BasicTopologyInfo, error) {
+func (m *MockTopologyService) GetTopologies(arg0 context.Context, arg1 string) ([]types.BasicTopologyInfo, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetTopologies", arg0, arg1)
-	ret0, _ := ret[0].([]alien4cloud.BasicTopologyInfo)
+	ret0, _ := ret[0].([]types.BasicTopologyInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -163,25 +364,30 @@ func (mr *MockTopologyServiceMockRecorder) GetTopologies(arg0, arg1 interface{})
 }
 
 // GetTopology mocks base method.
-func (m *MockTopologyService) GetTopology(arg0 context.Context, arg1, arg2 string) (*alien4cloud.Topology, error) {
+func (m *MockTopologyService) GetTopology(arg0 context.Context, arg1, arg2 string, arg3 ...alien4cloud.TopologyOption) (*types.Topology, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTopology", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*alien4cloud.Topology)
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTopology", varargs...)
+	ret0, _ := ret[0].(*types.Topology)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTopology indicates an expected call of GetTopology.
-func (mr *MockTopologyServiceMockRecorder) GetTopology(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockTopologyServiceMockRecorder) GetTopology(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopology", reflect.TypeOf((*MockTopologyService)(nil).GetTopology), arg0, arg1, arg2)
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopology", reflect.TypeOf((*MockTopologyService)(nil).GetTopology), varargs...)
 }
 
 // GetTopologyByID mocks base method.
-func (m *MockTopologyService) GetTopologyByID(arg0 context.Context, arg1 string) (*alien4cloud.Topology, error) {
+func (m *MockTopologyService) GetTopologyByID(arg0 context.Context, arg1 string) (*types.Topology, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetTopologyByID", arg0, arg1)
-	ret0, _ := ret[0].(*alien4cloud.Topology)
+	ret0, _ := ret[0].(*types.Topology)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -192,6 +398,21 @@ func (mr *MockTopologyServiceMockRecorder) GetTopologyByID(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopologyByID", reflect.TypeOf((*MockTopologyService)(nil).GetTopologyByID), arg0, arg1)
 }
 
+// GetTopologyByIDWithSections mocks base method.
+func (m *MockTopologyService) GetTopologyByIDWithSections(arg0 context.Context, arg1 string, arg2 alien4cloud.TopologySections) (*types.Topology, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopologyByIDWithSections", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.Topology)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopologyByIDWithSections indicates an expected call of GetTopologyByIDWithSections.
+func (mr *MockTopologyServiceMockRecorder) GetTopologyByIDWithSections(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopologyByIDWithSections", reflect.TypeOf((*MockTopologyService)(nil).GetTopologyByIDWithSections), arg0, arg1, arg2)
+}
+
 // GetTopologyID mocks base method.
 func (m *MockTopologyService) GetTopologyID(arg0 context.Context, arg1, arg2 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -222,6 +443,203 @@ func (mr *MockTopologyServiceMockRecorder) GetTopologyTemplateIDByName(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopologyTemplateIDByName", reflect.TypeOf((*MockTopologyService)(nil).GetTopologyTemplateIDByName), arg0, arg1)
 }
 
+// NewTopologyEditorContext mocks base method.
+func (m *MockTopologyService) NewTopologyEditorContext(arg0 context.Context, arg1, arg2 string) (*alien4cloud.TopologyEditorContext, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewTopologyEditorContext", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*alien4cloud.TopologyEditorContext)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewTopologyEditorContext indicates an expected call of NewTopologyEditorContext.
+func (mr *MockTopologyServiceMockRecorder) NewTopologyEditorContext(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTopologyEditorContext", reflect.TypeOf((*MockTopologyService)(nil).NewTopologyEditorContext), arg0, arg1, arg2)
+}
+
+// Recover mocks base method.
+func (m *MockTopologyService) Recover(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recover", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Recover indicates an expected call of Recover.
+func (mr *MockTopologyServiceMockRecorder) Recover(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recover", reflect.TypeOf((*MockTopologyService)(nil).Recover), arg0, arg1)
+}
+
+// RemoveNodeFromGroup mocks base method.
+func (m *MockTopologyService) RemoveNodeFromGroup(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNodeFromGroup", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveNodeFromGroup indicates an expected call of RemoveNodeFromGroup.
+func (mr *MockTopologyServiceMockRecorder) RemoveNodeFromGroup(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNodeFromGroup", reflect.TypeOf((*MockTopologyService)(nil).RemoveNodeFromGroup), arg0, arg1, arg2, arg3)
+}
+
+// RemoveOutputAttribute mocks base method.
+func (m *MockTopologyService) RemoveOutputAttribute(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOutputAttribute", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveOutputAttribute indicates an expected call of RemoveOutputAttribute.
+func (mr *MockTopologyServiceMockRecorder) RemoveOutputAttribute(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOutputAttribute", reflect.TypeOf((*MockTopologyService)(nil).RemoveOutputAttribute), arg0, arg1, arg2, arg3)
+}
+
+// RemoveOutputCapabilityProperty mocks base method.
+func (m *MockTopologyService) RemoveOutputCapabilityProperty(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOutputCapabilityProperty", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveOutputCapabilityProperty indicates an expected call of RemoveOutputCapabilityProperty.
+func (mr *MockTopologyServiceMockRecorder) RemoveOutputCapabilityProperty(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOutputCapabilityProperty", reflect.TypeOf((*MockTopologyService)(nil).RemoveOutputCapabilityProperty), arg0, arg1, arg2, arg3, arg4)
+}
+
+// RemoveOutputProperty mocks base method.
+func (m *MockTopologyService) RemoveOutputProperty(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOutputProperty", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveOutputProperty indicates an expected call of RemoveOutputProperty.
+func (mr *MockTopologyServiceMockRecorder) RemoveOutputProperty(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOutputProperty", reflect.TypeOf((*MockTopologyService)(nil).RemoveOutputProperty), arg0, arg1, arg2, arg3)
+}
+
+// RemoveWorkflowEdge mocks base method.
+func (m *MockTopologyService) RemoveWorkflowEdge(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveWorkflowEdge", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveWorkflowEdge indicates an expected call of RemoveWorkflowEdge.
+func (mr *MockTopologyServiceMockRecorder) RemoveWorkflowEdge(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveWorkflowEdge", reflect.TypeOf((*MockTopologyService)(nil).RemoveWorkflowEdge), arg0, arg1, arg2, arg3, arg4)
+}
+
+// RemoveWorkflowStep mocks base method.
+func (m *MockTopologyService) RemoveWorkflowStep(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveWorkflowStep", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveWorkflowStep indicates an expected call of RemoveWorkflowStep.
+func (mr *MockTopologyServiceMockRecorder) RemoveWorkflowStep(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveWorkflowStep", reflect.TypeOf((*MockTopologyService)(nil).RemoveWorkflowStep), arg0, arg1, arg2, arg3)
+}
+
+// RenameGroup mocks base method.
+func (m *MockTopologyService) RenameGroup(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameGroup", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameGroup indicates an expected call of RenameGroup.
+func (mr *MockTopologyServiceMockRecorder) RenameGroup(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameGroup", reflect.TypeOf((*MockTopologyService)(nil).RenameGroup), arg0, arg1, arg2, arg3)
+}
+
+// RenameInput mocks base method.
+func (m *MockTopologyService) RenameInput(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameInput", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameInput indicates an expected call of RenameInput.
+func (mr *MockTopologyServiceMockRecorder) RenameInput(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameInput", reflect.TypeOf((*MockTopologyService)(nil).RenameInput), arg0, arg1, arg2, arg3)
+}
+
+// RenameNode mocks base method.
+func (m *MockTopologyService) RenameNode(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameNode", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameNode indicates an expected call of RenameNode.
+func (mr *MockTopologyServiceMockRecorder) RenameNode(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameNode", reflect.TypeOf((*MockTopologyService)(nil).RenameNode), arg0, arg1, arg2, arg3)
+}
+
+// RenameWorkflow mocks base method.
+func (m *MockTopologyService) RenameWorkflow(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameWorkflow", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameWorkflow indicates an expected call of RenameWorkflow.
+func (mr *MockTopologyServiceMockRecorder) RenameWorkflow(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameWorkflow", reflect.TypeOf((*MockTopologyService)(nil).RenameWorkflow), arg0, arg1, arg2, arg3)
+}
+
+// RenameWorkflowStep mocks base method.
+func (m *MockTopologyService) RenameWorkflowStep(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameWorkflowStep", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameWorkflowStep indicates an expected call of RenameWorkflowStep.
+func (mr *MockTopologyServiceMockRecorder) RenameWorkflowStep(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameWorkflowStep", reflect.TypeOf((*MockTopologyService)(nil).RenameWorkflowStep), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ResetNodeDeploymentArtifact mocks base method.
+func (m *MockTopologyService) ResetNodeDeploymentArtifact(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetNodeDeploymentArtifact", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetNodeDeploymentArtifact indicates an expected call of ResetNodeDeploymentArtifact.
+func (mr *MockTopologyServiceMockRecorder) ResetNodeDeploymentArtifact(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetNodeDeploymentArtifact", reflect.TypeOf((*MockTopologyService)(nil).ResetNodeDeploymentArtifact), arg0, arg1, arg2, arg3)
+}
+
 // SaveA4CTopology mocks base method.
 func (m *MockTopologyService) SaveA4CTopology(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext) error {
 	m.ctrl.T.Helper()
@@ -236,6 +654,151 @@ func (mr *MockTopologyServiceMockRecorder) SaveA4CTopology(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveA4CTopology", reflect.TypeOf((*MockTopologyService)(nil).SaveA4CTopology), arg0, arg1)
 }
 
+// SetNodeCapabilityPropertyAsInput mocks base method.
+func (m *MockTopologyService) SetNodeCapabilityPropertyAsInput(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3, arg4, arg5 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNodeCapabilityPropertyAsInput", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNodeCapabilityPropertyAsInput indicates an expected call of SetNodeCapabilityPropertyAsInput.
+func (mr *MockTopologyServiceMockRecorder) SetNodeCapabilityPropertyAsInput(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNodeCapabilityPropertyAsInput", reflect.TypeOf((*MockTopologyService)(nil).SetNodeCapabilityPropertyAsInput), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SetNodePropertyAsInput mocks base method.
+func (m *MockTopologyService) SetNodePropertyAsInput(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNodePropertyAsInput", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNodePropertyAsInput indicates an expected call of SetNodePropertyAsInput.
+func (mr *MockTopologyServiceMockRecorder) SetNodePropertyAsInput(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNodePropertyAsInput", reflect.TypeOf((*MockTopologyService)(nil).SetNodePropertyAsInput), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SetOutputAttribute mocks base method.
+func (m *MockTopologyService) SetOutputAttribute(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOutputAttribute", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOutputAttribute indicates an expected call of SetOutputAttribute.
+func (mr *MockTopologyServiceMockRecorder) SetOutputAttribute(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOutputAttribute", reflect.TypeOf((*MockTopologyService)(nil).SetOutputAttribute), arg0, arg1, arg2, arg3)
+}
+
+// SetOutputCapabilityProperty mocks base method.
+func (m *MockTopologyService) SetOutputCapabilityProperty(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOutputCapabilityProperty", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOutputCapabilityProperty indicates an expected call of SetOutputCapabilityProperty.
+func (mr *MockTopologyServiceMockRecorder) SetOutputCapabilityProperty(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOutputCapabilityProperty", reflect.TypeOf((*MockTopologyService)(nil).SetOutputCapabilityProperty), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SetOutputProperty mocks base method.
+func (m *MockTopologyService) SetOutputProperty(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOutputProperty", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOutputProperty indicates an expected call of SetOutputProperty.
+func (mr *MockTopologyServiceMockRecorder) SetOutputProperty(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOutputProperty", reflect.TypeOf((*MockTopologyService)(nil).SetOutputProperty), arg0, arg1, arg2, arg3)
+}
+
+// SetPolicyTargetsFromGroups mocks base method.
+func (m *MockTopologyService) SetPolicyTargetsFromGroups(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2 string, arg3 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetPolicyTargetsFromGroups", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPolicyTargetsFromGroups indicates an expected call of SetPolicyTargetsFromGroups.
+func (mr *MockTopologyServiceMockRecorder) SetPolicyTargetsFromGroups(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPolicyTargetsFromGroups", reflect.TypeOf((*MockTopologyService)(nil).SetPolicyTargetsFromGroups), varargs...)
+}
+
+// SwapWorkflowSteps mocks base method.
+func (m *MockTopologyService) SwapWorkflowSteps(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SwapWorkflowSteps", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SwapWorkflowSteps indicates an expected call of SwapWorkflowSteps.
+func (mr *MockTopologyServiceMockRecorder) SwapWorkflowSteps(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwapWorkflowSteps", reflect.TypeOf((*MockTopologyService)(nil).SwapWorkflowSteps), arg0, arg1, arg2, arg3, arg4)
+}
+
+// UndoRedo mocks base method.
+func (m *MockTopologyService) UndoRedo(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UndoRedo", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UndoRedo indicates an expected call of UndoRedo.
+func (mr *MockTopologyServiceMockRecorder) UndoRedo(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndoRedo", reflect.TypeOf((*MockTopologyService)(nil).UndoRedo), arg0, arg1, arg2)
+}
+
+// UnsetNodeCapabilityPropertyAsInput mocks base method.
+func (m *MockTopologyService) UnsetNodeCapabilityPropertyAsInput(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsetNodeCapabilityPropertyAsInput", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsetNodeCapabilityPropertyAsInput indicates an expected call of UnsetNodeCapabilityPropertyAsInput.
+func (mr *MockTopologyServiceMockRecorder) UnsetNodeCapabilityPropertyAsInput(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsetNodeCapabilityPropertyAsInput", reflect.TypeOf((*MockTopologyService)(nil).UnsetNodeCapabilityPropertyAsInput), arg0, arg1, arg2, arg3, arg4)
+}
+
+// UnsetNodePropertyAsInput mocks base method.
+func (m *MockTopologyService) UnsetNodePropertyAsInput(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsetNodePropertyAsInput", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsetNodePropertyAsInput indicates an expected call of UnsetNodePropertyAsInput.
+func (mr *MockTopologyServiceMockRecorder) UnsetNodePropertyAsInput(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsetNodePropertyAsInput", reflect.TypeOf((*MockTopologyService)(nil).UnsetNodePropertyAsInput), arg0, arg1, arg2, arg3)
+}
+
 // UpdateCapabilityProperty mocks base method.
 func (m *MockTopologyService) UpdateCapabilityProperty(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3, arg4, arg5 string) error {
 	m.ctrl.T.Helper()
@@ -277,3 +840,60 @@ func (mr *MockTopologyServiceMockRecorder) UpdateComponentPropertyComplexType(ar
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComponentPropertyComplexType", reflect.TypeOf((*MockTopologyService)(nil).UpdateComponentPropertyComplexType), arg0, arg1, arg2, arg3, arg4)
 }
+
+// UpdateNodeDeploymentArtifact mocks base method.
+func (m *MockTopologyService) UpdateNodeDeploymentArtifact(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3, arg4 string, arg5 io.Reader) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNodeDeploymentArtifact", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNodeDeploymentArtifact indicates an expected call of UpdateNodeDeploymentArtifact.
+func (mr *MockTopologyServiceMockRecorder) UpdateNodeDeploymentArtifact(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNodeDeploymentArtifact", reflect.TypeOf((*MockTopologyService)(nil).UpdateNodeDeploymentArtifact), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// UpdateNodePosition mocks base method.
+func (m *MockTopologyService) UpdateNodePosition(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2 string, arg3, arg4 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNodePosition", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNodePosition indicates an expected call of UpdateNodePosition.
+func (mr *MockTopologyServiceMockRecorder) UpdateNodePosition(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNodePosition", reflect.TypeOf((*MockTopologyService)(nil).UpdateNodePosition), arg0, arg1, arg2, arg3, arg4)
+}
+
+// UpdateRelationshipProperty mocks base method.
+func (m *MockTopologyService) UpdateRelationshipProperty(arg0 context.Context, arg1 *alien4cloud.TopologyEditorContext, arg2, arg3, arg4, arg5 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRelationshipProperty", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRelationshipProperty indicates an expected call of UpdateRelationshipProperty.
+func (mr *MockTopologyServiceMockRecorder) UpdateRelationshipProperty(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRelationshipProperty", reflect.TypeOf((*MockTopologyService)(nil).UpdateRelationshipProperty), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// ValidateTopology mocks base method.
+func (m *MockTopologyService) ValidateTopology(arg0 context.Context, arg1 string) (*types.TopologyValidationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateTopology", arg0, arg1)
+	ret0, _ := ret[0].(*types.TopologyValidationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateTopology indicates an expected call of ValidateTopology.
+func (mr *MockTopologyServiceMockRecorder) ValidateTopology(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateTopology", reflect.TypeOf((*MockTopologyService)(nil).ValidateTopology), arg0, arg1)
+}
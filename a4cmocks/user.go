@@ -8,7 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
-	alien4cloud "github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud"
+	types "github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -50,7 +50,7 @@ func (mr *MockUserServiceMockRecorder) AddRole(arg0, arg1, arg2 interface{}) *go
 }
 
 // CreateGroup mocks base method.
-func (m *MockUserService) CreateGroup(arg0 context.Context, arg1 alien4cloud.Group) (string, error) {
+func (m *MockUserService) CreateGroup(arg0 context.Context, arg1 types.Group) (string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateGroup", arg0, arg1)
 	ret0, _ := ret[0].(string)
@@ -65,7 +65,7 @@ func (mr *MockUserServiceMockRecorder) CreateGroup(arg0, arg1 interface{}) *gomo
 }
 
 // CreateUser mocks base method.
-func (m *MockUserService) CreateUser(arg0 context.Context, arg1 alien4cloud.CreateUpdateUserRequest) error {
+func (m *MockUserService) CreateUser(arg0 context.Context, arg1 types.CreateUpdateUserRequest) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -106,11 +106,26 @@ func (mr *MockUserServiceMockRecorder) DeleteUser(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserService)(nil).DeleteUser), arg0, arg1)
 }
 
+// ExportSecurityConfiguration mocks base method.
+func (m *MockUserService) ExportSecurityConfiguration(arg0 context.Context) (types.SecurityConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportSecurityConfiguration", arg0)
+	ret0, _ := ret[0].(types.SecurityConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportSecurityConfiguration indicates an expected call of ExportSecurityConfiguration.
+func (mr *MockUserServiceMockRecorder) ExportSecurityConfiguration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSecurityConfiguration", reflect.TypeOf((*MockUserService)(nil).ExportSecurityConfiguration), arg0)
+}
+
 // GetGroup mocks base method.
-func (m *MockUserService) GetGroup(arg0 context.Context, arg1 string) (alien4cloud.Group, error) {
+func (m *MockUserService) GetGroup(arg0 context.Context, arg1 string) (types.Group, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetGroup", arg0, arg1)
-	ret0, _ := ret[0].(alien4cloud.Group)
+	ret0, _ := ret[0].(types.Group)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -122,10 +137,10 @@ func (mr *MockUserServiceMockRecorder) GetGroup(arg0, arg1 interface{}) *gomock.
 }
 
 // GetGroups mocks base method.
-func (m *MockUserService) GetGroups(arg0 context.Context, arg1 []string) ([]alien4cloud.Group, error) {
+func (m *MockUserService) GetGroups(arg0 context.Context, arg1 []string) ([]types.Group, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetGroups", arg0, arg1)
-	ret0, _ := ret[0].([]alien4cloud.Group)
+	ret0, _ := ret[0].([]types.Group)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -137,10 +152,10 @@ func (mr *MockUserServiceMockRecorder) GetGroups(arg0, arg1 interface{}) *gomock
 }
 
 // GetUser mocks base method.
-func (m *MockUserService) GetUser(arg0 context.Context, arg1 string) (alien4cloud.User, error) {
+func (m *MockUserService) GetUser(arg0 context.Context, arg1 string) (types.User, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
-	ret0, _ := ret[0].(alien4cloud.User)
+	ret0, _ := ret[0].(types.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -152,10 +167,10 @@ func (mr *MockUserServiceMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.C
 }
 
 // GetUsers mocks base method.
-func (m *MockUserService) GetUsers(arg0 context.Context, arg1 []string) ([]alien4cloud.User, error) {
+func (m *MockUserService) GetUsers(arg0 context.Context, arg1 []string) ([]types.User, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUsers", arg0, arg1)
-	ret0, _ := ret[0].([]alien4cloud.User)
+	ret0, _ := ret[0].([]types.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -166,6 +181,20 @@ func (mr *MockUserServiceMockRecorder) GetUsers(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockUserService)(nil).GetUsers), arg0, arg1)
 }
 
+// ImportSecurityConfiguration mocks base method.
+func (m *MockUserService) ImportSecurityConfiguration(arg0 context.Context, arg1 types.SecurityConfiguration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportSecurityConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportSecurityConfiguration indicates an expected call of ImportSecurityConfiguration.
+func (mr *MockUserServiceMockRecorder) ImportSecurityConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportSecurityConfiguration", reflect.TypeOf((*MockUserService)(nil).ImportSecurityConfiguration), arg0, arg1)
+}
+
 // RemoveRole mocks base method.
 func (m *MockUserService) RemoveRole(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -181,10 +210,10 @@ func (mr *MockUserServiceMockRecorder) RemoveRole(arg0, arg1, arg2 interface{})
 }
 
 // SearchGroups mocks base method.
-func (m *MockUserService) SearchGroups(arg0 context.Context, arg1 alien4cloud.SearchRequest) ([]alien4cloud.Group, int, error) {
+func (m *MockUserService) SearchGroups(arg0 context.Context, arg1 types.SearchRequest) ([]types.Group, int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SearchGroups", arg0, arg1)
-	ret0, _ := ret[0].([]alien4cloud.Group)
+	ret0, _ := ret[0].([]types.Group)
 	ret1, _ := ret[1].(int)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
@@ -197,10 +226,10 @@ func (mr *MockUserServiceMockRecorder) SearchGroups(arg0, arg1 interface{}) *gom
 }
 
 // SearchUsers mocks base method.
-func (m *MockUserService) SearchUsers(arg0 context.Context, arg1 alien4cloud.SearchRequest) ([]alien4cloud.User, int, error) {
+func (m *MockUserService) SearchUsers(arg0 context.Context, arg1 types.SearchRequest) ([]types.User, int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SearchUsers", arg0, arg1)
-	ret0, _ := ret[0].([]alien4cloud.User)
+	ret0, _ := ret[0].([]types.User)
 	ret1, _ := ret[1].(int)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
@@ -213,7 +242,7 @@ func (mr *MockUserServiceMockRecorder) SearchUsers(arg0, arg1 interface{}) *gomo
 }
 
 // UpdateGroup mocks base method.
-func (m *MockUserService) UpdateGroup(arg0 context.Context, arg1 string, arg2 alien4cloud.Group) error {
+func (m *MockUserService) UpdateGroup(arg0 context.Context, arg1 string, arg2 types.Group) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateGroup", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
@@ -227,7 +256,7 @@ func (mr *MockUserServiceMockRecorder) UpdateGroup(arg0, arg1, arg2 interface{})
 }
 
 // UpdateUser mocks base method.
-func (m *MockUserService) UpdateUser(arg0 context.Context, arg1 string, arg2 alien4cloud.CreateUpdateUserRequest) error {
+func (m *MockUserService) UpdateUser(arg0 context.Context, arg1 string, arg2 types.CreateUpdateUserRequest) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateUser", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
@@ -30,6 +30,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/leader"
+	"github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/types"
 	"github.com/goware/urlx"
 	"github.com/pkg/errors"
 )
@@ -39,6 +41,33 @@ type Client interface {
 	Login(ctx context.Context) error
 	Logout(ctx context.Context) error
 
+	// StartSessionKeeper starts a goroutine logging in again every interval until the given context is cancelled,
+	// so that the session never expires. Login errors are sent on the returned channel, which is closed once
+	// the context is cancelled.
+	StartSessionKeeper(ctx context.Context, interval time.Duration) <-chan error
+
+	// Preflight verifies that the client is able to connect and log in to Alien4Cloud
+	// and that requirements described in the given PreflightSpec are met.
+	//
+	// Failed checks are reported in the returned PreflightReport, a non-nil error
+	// is returned only if checks could not be performed.
+	Preflight(ctx context.Context, spec PreflightSpec) (PreflightReport, error)
+
+	// RegisterDeploymentStatusCallback registers a callback called each time the deployment status
+	// of the given application environment changes, and on errors retrieving this status.
+	//
+	// All registrations share a single poller, so the deployment status of an environment
+	// is retrieved once per polling period whatever the number of callbacks registered on it.
+	// The returned function unregisters the callback, the poller stops when no callback remains.
+	RegisterDeploymentStatusCallback(appID, envID string, callback DeploymentStatusCallback) (unregister func())
+
+	// HasRole returns true if the logged in user has the given role.
+	// Users with the ADMIN role are considered as having all roles.
+	HasRole(ctx context.Context, role string) (bool, error)
+	// RequireRoles returns a *MissingRolesError if the logged in user does not have all the given roles.
+	// Users with the ADMIN role are considered as having all roles.
+	RequireRoles(ctx context.Context, roles ...string) error
+
 	ApplicationService() ApplicationService
 	DeploymentService() DeploymentService
 	EventService() EventService
@@ -47,6 +76,7 @@ type Client interface {
 	TopologyService() TopologyService
 	CatalogService() CatalogService
 	UserService() UserService
+	LocationService() LocationService
 
 	// NewRequest allows to create a custom request to be sent to Alien4Cloud
 	// given a Context, method, url path and optional body.
@@ -79,6 +109,8 @@ type Client interface {
 	// Note: a special Retry function is always added at the end of the retries list. It will
 	// automatically retry 403 Forbidden errors by trying to call Client.Login first.
 	// This is for backward compatibility.
+	// Before those functions, requests may be retried on transient errors according to the
+	// RetryPolicy configured using WithRetryPolicy.
 	Do(req *http.Request, retries ...Retry) (*http.Response, error)
 }
 
@@ -102,66 +134,109 @@ type Retry func(client Client, request *http.Request, response *http.Response) (
 const (
 	// DefaultEnvironmentName is the default name of the environment created by
 	// Alien4Cloud for an application
-	DefaultEnvironmentName = "Environment"
+	DefaultEnvironmentName = types.DefaultEnvironmentName
 	// ApplicationDeploymentInProgress a4c status
-	ApplicationDeploymentInProgress = "DEPLOYMENT_IN_PROGRESS"
+	ApplicationDeploymentInProgress = types.ApplicationDeploymentInProgress
 	// ApplicationDeployed a4c status
-	ApplicationDeployed = "DEPLOYED"
+	ApplicationDeployed = types.ApplicationDeployed
 	// ApplicationUndeploymentInProgress a4c status
-	ApplicationUndeploymentInProgress = "UNDEPLOYMENT_IN_PROGRESS"
+	ApplicationUndeploymentInProgress = types.ApplicationUndeploymentInProgress
 	// ApplicationUndeployed a4c status
-	ApplicationUndeployed = "UNDEPLOYED"
+	ApplicationUndeployed = types.ApplicationUndeployed
 	// ApplicationError a4c status
-	ApplicationError = "FAILURE"
+	ApplicationError = types.ApplicationError
 	// ApplicationUpdateError a4c status
-	ApplicationUpdateError = "UPDATE_FAILURE"
+	ApplicationUpdateError = types.ApplicationUpdateError
 	// ApplicationUpdated a4c status
-	ApplicationUpdated = "UPDATED"
+	ApplicationUpdated = types.ApplicationUpdated
 	// ApplicationUpdateInProgress a4c status
-	ApplicationUpdateInProgress = "UPDATE_IN_PROGRESS"
+	ApplicationUpdateInProgress = types.ApplicationUpdateInProgress
 
 	// WorkflowSucceeded workflow a4c status
-	WorkflowSucceeded = "SUCCEEDED"
+	WorkflowSucceeded = types.WorkflowSucceeded
 	// WorkflowRunning workflow a4c status
-	WorkflowRunning = "RUNNING"
+	WorkflowRunning = types.WorkflowRunning
 	// WorkflowFailed workflow a4c status
-	WorkflowFailed = "FAILED"
+	WorkflowFailed = types.WorkflowFailed
 
 	// NodeStart node a4c status
-	NodeStart = "initial"
+	NodeStart = types.NodeStart
 	// NodeSubmitting node a4c status
-	NodeSubmitting = "submitting"
+	NodeSubmitting = types.NodeSubmitting
 	// NodeSubmitted node  a4c status
-	NodeSubmitted = "submitted"
+	NodeSubmitted = types.NodeSubmitted
 	// NodePending node  a4c status
-	NodePending = "pending"
+	NodePending = types.NodePending
 	// NodeRunning node  a4c status
-	NodeRunning = "running"
+	NodeRunning = types.NodeRunning
 	// NodeExecuting node  a4c status
-	NodeExecuting = "executing"
+	NodeExecuting = types.NodeExecuting
 	// NodeExecuted node  a4c status
-	NodeExecuted = "executed"
+	NodeExecuted = types.NodeExecuted
 	// NodeEnd node  a4c status
-	NodeEnd = "end"
+	NodeEnd = types.NodeEnd
 	// NodeError node  a4c status
-	NodeError = "error"
+	NodeError = types.NodeError
 	// NodeFailed node  a4c status
-	NodeFailed = "failed"
+	NodeFailed = types.NodeFailed
 	// NodeStart node  a4c status
 
+	// OrchestratorConnected is the state of an enabled orchestrator connected to Alien4Cloud
+	OrchestratorConnected = types.OrchestratorConnected
+	// OrchestratorConnecting is the state of an orchestrator being enabled
+	OrchestratorConnecting = types.OrchestratorConnecting
+	// OrchestratorDisconnected is the state of an enabled orchestrator that lost its connection to Alien4Cloud
+	OrchestratorDisconnected = types.OrchestratorDisconnected
+	// OrchestratorDisabled is the state of a disabled orchestrator
+	OrchestratorDisabled = types.OrchestratorDisabled
+
+	// InputAdded is the kind of change of an input value that is not yet set
+	InputAdded = types.InputAdded
+	// InputUpdated is the kind of change of an input value that is already set
+	InputUpdated = types.InputUpdated
+
+	// LogLevelDebug is the debug level of a log entry
+	LogLevelDebug = types.LogLevelDebug
+	// LogLevelInfo is the info level of a log entry
+	LogLevelInfo = types.LogLevelInfo
+	// LogLevelWarn is the warning level of a log entry
+	LogLevelWarn = types.LogLevelWarn
+	// LogLevelError is the error level of a log entry
+	LogLevelError = types.LogLevelError
+
 	// FunctionConcat is a function used in attribute/property values to concatenate strings
-	FunctionConcat = "concat"
+	FunctionConcat = types.FunctionConcat
 	// FunctionGetInput is a function used in attribute/property values to reference an input property
-	FunctionGetInput = "get_input"
+	FunctionGetInput = types.FunctionGetInput
+	// FunctionGetProperty is a function used in attribute/property values to reference a property of an entity
+	FunctionGetProperty = types.FunctionGetProperty
+	// FunctionGetAttribute is a function used in attribute/property values to reference an attribute of an entity
+	FunctionGetAttribute = types.FunctionGetAttribute
+	// FunctionGetSecret is a function used in property values to reference a secret resolved by the orchestrator
+	FunctionGetSecret = types.FunctionGetSecret
+	// SecretMask is the value replacing secret property values masked by Topology.MaskSecrets()
+	SecretMask = types.SecretMask
 
 	// ROLE_ADMIN is the adminstrator role
-	ROLE_ADMIN = "ADMIN"
+	ROLE_ADMIN = types.ROLE_ADMIN
 	// ROLE_COMPONENTS_MANAGER allows to define packages on how to install, configure, start and connect components (mapped as node types)
-	ROLE_COMPONENTS_MANAGER = "COMPONENTS_MANAGER"
+	ROLE_COMPONENTS_MANAGER = types.ROLE_COMPONENTS_MANAGER
 	// ROLE_ARCHITECT allows to define application templates (topologies) by reusing building blocks (node types defined by components managers)
-	ROLE_ARCHITECT = "ARCHITECT"
+	ROLE_ARCHITECT = types.ROLE_ARCHITECT
 	// ROLE_APPLICATIONS_MANAGER allows to define applications with it’s own topologies that can be linked to a global topology from architects and that can reuse components defined by the components managers
-	ROLE_APPLICATIONS_MANAGER = "APPLICATIONS_MANAGER"
+	ROLE_APPLICATIONS_MANAGER = types.ROLE_APPLICATIONS_MANAGER
+
+	// ApplicationRoleManager is the application role allowing to manage an application, its versions and environments
+	ApplicationRoleManager = types.ApplicationRoleManager
+	// ApplicationRoleDevOps is the application role allowing to edit the topologies of an application
+	ApplicationRoleDevOps = types.ApplicationRoleDevOps
+
+	// PromotionStatusInit is the status of a workspace promotion request waiting for approval
+	PromotionStatusInit = types.PromotionStatusInit
+	// PromotionStatusAccepted is the status of an accepted workspace promotion request
+	PromotionStatusAccepted = types.PromotionStatusAccepted
+	// PromotionStatusRefused is the status of a refused workspace promotion request
+	PromotionStatusRefused = types.PromotionStatusRefused
 )
 
 const (
@@ -175,6 +250,19 @@ type a4cClient struct {
 	baseURL  string
 	username string
 	password string
+	token    string
+	// sessionCookies are pre-established session cookies, Login is not possible when they are set
+	sessionCookies []*http.Cookie
+	// retryPolicy defines how requests are retried on transient errors
+	retryPolicy RetryPolicy
+	// rateLimiter limits the rate of requests sent if not nil
+	rateLimiter *rateLimiter
+	// protectionTag is the tag protecting applications from deletion and undeployment if not nil
+	protectionTag *Tag
+	// inputSecretProvider stores values of sensitive inputs outside of Alien4Cloud if not nil
+	inputSecretProvider InputSecretProvider
+	// leaderElector restricts polling to the leader replica if not nil
+	leaderElector leader.Elector
 
 	applicationService  *applicationService
 	deploymentService   *deploymentService
@@ -184,10 +272,16 @@ type a4cClient struct {
 	topologyService     *topologyService
 	catalogService      *catalogService
 	userService         *userService
+	locationService     *locationService
+
+	statusRegistry *deploymentStatusRegistry
 }
 
 // NewClient instanciates and returns Client
-func NewClient(address string, user string, password string, caFile string, skipSecure bool) (Client, error) {
+//
+// Options may be given to configure the client, for instance WithToken to authenticate
+// using an API token instead of user and password.
+func NewClient(address string, user string, password string, caFile string, skipSecure bool, opts ...ClientOption) (Client, error) {
 	a4cAPI := strings.TrimRight(address, "/")
 
 	if m, _ := regexp.Match("^http[s]?://.*", []byte(a4cAPI)); !m {
@@ -263,11 +357,25 @@ func NewClient(address string, user string, password string, caFile string, skip
 	c.topologyService = &topologyService{c}
 	c.catalogService = &catalogService{c}
 	c.userService = &userService{c}
+	c.locationService = &locationService{c}
+	c.statusRegistry = newDeploymentStatusRegistry(c)
+	for _, opt := range opts {
+		opt(c)
+	}
+	if len(c.sessionCookies) > 0 {
+		c.client.Jar.SetCookies(url, c.sessionCookies)
+	}
 	return c, nil
 }
 
 // Login login to alien4cloud
+//
+// When the client is configured to use an API token or pre-established session cookies,
+// requests are authenticated using them and Login does nothing.
 func (c *a4cClient) Login(ctx context.Context) error {
+	if !c.canRelogin() {
+		return nil
+	}
 	values := url.Values{}
 	values.Set("username", c.username)
 	values.Set("password", c.password)
@@ -288,6 +396,12 @@ func (c *a4cClient) Login(ctx context.Context) error {
 	return ReadA4CResponse(response, nil)
 }
 
+// canRelogin returns false if requests are authenticated using an API token or pre-established
+// session cookies which can't be renewed by logging in again
+func (c *a4cClient) canRelogin() bool {
+	return c.token == "" && len(c.sessionCookies) == 0
+}
+
 // Logout log out from alien4cloud
 func (c *a4cClient) Logout(ctx context.Context) error {
 	request, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/logout", c.baseURL), nil)
@@ -346,3 +460,8 @@ func (c *a4cClient) CatalogService() CatalogService {
 func (c *a4cClient) UserService() UserService {
 	return c.userService
 }
+
+// LocationService retrieves the Location Service
+func (c *a4cClient) LocationService() LocationService {
+	return c.locationService
+}
@@ -50,10 +50,19 @@ func (c *a4cClient) NewRequest(ctx context.Context, method, urlStr string, body
 	// Add default headers
 	request.Header.Add(contentTypeHeaderName, appJSONHeader)
 	request.Header.Add(acceptHeaderName, appJSONHeader)
+	if c.token != "" {
+		request.Header.Set(authorizationHeaderName, "Bearer "+c.token)
+	}
 	return request, nil
 }
 
 func (c *a4cClient) Do(request *http.Request, retries ...Retry) (*http.Response, error) {
+	return c.do(request, true, retries)
+}
+
+// do sends a request and applies the given retries, relogin tells if the request may be resent
+// after logging in again when Alien4Cloud answers with a 403 Forbidden error.
+func (c *a4cClient) do(request *http.Request, relogin bool, retries []Retry) (*http.Response, error) {
 	// Close request body if underling reader allows it.
 	var ncrsBody *nopCloserReadSeeker
 	if request.Body != nil {
@@ -67,15 +76,21 @@ func (c *a4cClient) Do(request *http.Request, retries ...Retry) (*http.Response,
 		}
 	}
 
-	// always add retry forbidden errors
-	retriesWithDefaults := append(retries, retryForbidden)
+	retriesWithDefaults := retries
+	if relogin {
+		retriesWithDefaults = append(retries[:len(retries):len(retries)], retryForbidden)
+	}
 
-	response, err := c.client.Do(request)
+	var body io.Seeker
+	if ncrsBody != nil {
+		body = ncrsBody
+	}
+	response, err := c.doWithRetryPolicy(request, body)
 	if err != nil {
-		return response, err
+		return response, classifyTimeout(request.Method+" "+request.URL.Path, err)
 	}
 
-	for _, retry := range retriesWithDefaults {
+	for i, retry := range retriesWithDefaults {
 		if ncrsBody != nil {
 			// Restart reading request body from the beginning
 			ncrsBody.Seek(0, io.SeekStart)
@@ -87,7 +102,9 @@ func (c *a4cClient) Do(request *http.Request, retries ...Retry) (*http.Response,
 		if req != nil {
 			// Before retrying we need to fully read and close this response
 			discardHTTPResponseBody(response)
-			return c.Do(req, retries...)
+			// Logging in again is attempted only once, a request still forbidden afterwards
+			// returns the 403 error to the caller
+			return c.do(req, relogin && i < len(retries), retries)
 		}
 	}
 
@@ -97,22 +114,19 @@ func (c *a4cClient) Do(request *http.Request, retries ...Retry) (*http.Response,
 // ReadA4CResponse is an helper function that allow to fully read and close a response body and
 // unmarshal its json content into a provided data structure.
 // If response status code is greather or equal to 400 it automatically parse an error response and
-// returns it as a non-nil error.
+// returns it as a non-nil *A4CError.
 func ReadA4CResponse(response *http.Response, data interface{}) error {
 	defer response.Body.Close()
 	responseBody, err := ioutil.ReadAll(response.Body)
 	if err != nil {
-		return errors.Wrap(err, "Cannot read the response from Alien4Cloud")
+		var operation string
+		if response.Request != nil {
+			operation = response.Request.Method + " " + response.Request.URL.Path
+		}
+		return errors.Wrap(classifyTimeout(operation, err), "Cannot read the response from Alien4Cloud")
 	}
 	if response.StatusCode >= 400 {
-		var res struct {
-			Error Error `json:"error"`
-		}
-		err = json.Unmarshal(responseBody, &res)
-		if err != nil {
-			return errors.Wrap(err, "Unable to unmarshal content of the Alien4Cloud error response")
-		}
-		return errors.New(res.Error.Message)
+		return newA4CError(response.StatusCode, responseBody)
 	}
 	if data != nil {
 		err = json.Unmarshal(responseBody, &data)
@@ -125,6 +139,10 @@ func retryForbidden(client Client, request *http.Request, response *http.Respons
 		// Nothing to retry
 		return nil, nil
 	}
+	if c, ok := client.(*a4cClient); ok && !c.canRelogin() {
+		// A new login would not renew the token or session cookies, let the caller handle the 403 error
+		return nil, nil
+	}
 	err := client.Login(request.Context())
 	return request, err
 }
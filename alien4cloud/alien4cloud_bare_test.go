@@ -114,3 +114,31 @@ there
 	assert.Equal(t, respData.Data, "success")
 
 }
+
+func Test_reloginOnce(t *testing.T) {
+	var logins, requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if regexp.MustCompile(`.*/login`).Match([]byte(r.URL.Path)) {
+			logins++
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		requests++
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error":{"code": 403,"message":"Access is denied"}}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL, "a", "a", "", false)
+	assert.NilError(t, err)
+	req, err := client.NewRequest(context.Background(), "GET", "/somepath", nil)
+	assert.NilError(t, err)
+
+	// A server always answering 403 errors does not make the client log in again endlessly
+	resp, err := client.Do(req)
+	assert.NilError(t, err)
+	assert.Equal(t, resp.StatusCode, http.StatusForbidden)
+	discardHTTPResponseBody(resp)
+	assert.Equal(t, logins, 1)
+	assert.Equal(t, requests, 2)
+}
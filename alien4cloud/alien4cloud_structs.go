@@ -15,105 +15,132 @@
 package alien4cloud
 
 import (
-	"encoding/json"
-	"fmt"
-	"sort"
-	"strconv"
-	"strings"
-	"time"
+	"github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/types"
 )
 
-// CSAR holds properties defining a Cloud Service ARchive
-type CSAR struct {
-	DefinitionHash          string           `json:"definitionHash,omitempty"`
-	DelegateID              string           `json:"delegateId,omitempty"`
-	DelegateType            string           `json:"delegateType,omitempty"`
-	Dependencies            []CSARDependency `json:"dependencies,omitempty"`
-	Description             string           `json:"description,omitempty"`
-	HasTopology             bool             `json:"hasTopology,omitempty"`
-	Hash                    string           `json:"hash,omitempty"`
-	ID                      string           `json:"id,omitempty"`
-	ImportDate              int              `json:"importDate,omitempty"`
-	ImportSource            string           `json:"importSource,omitempty"`
-	License                 string           `json:"license,omitempty"`
-	Name                    string           `json:"name,omitempty"`
-	NestedVersion           Version          `json:"nestedVersion,omitempty"`
-	NodeTypesCount          int              `json:"nodeTypesCount,omitempty"`
-	Tags                    []Tag            `json:"tags,omitempty"`
-	TemplateAuthor          string           `json:"templateAuthor,omitempty"`
-	ToscaDefaultNamespace   string           `json:"toscaDefaultNamespace,omitempty"`
-	ToscaDefinitionsVersion string           `json:"toscaDefinitionsVersion,omitempty"`
-	Version                 string           `json:"version,omitempty"`
-	Workspace               string           `json:"workspace,omitempty"`
-	YamlFilePath            string           `json:"yamlFilePath,omitempty"`
-}
-
-// Version represents a version with its decomposed fields
-type Version struct {
-	MajorVersion       int    `json:"majorVersion,omitempty"`
-	MinorVersion       int    `json:"minorVersion,omitempty"`
-	IncrementalVersion int    `json:"incrementalVersion,omitempty"`
-	BuildNumber        int    `json:"buildNumber,omitempty"`
-	Qualifier          string `json:"qualifier,omitempty"`
-}
-
-// CSARDependency holds properties defining a dependency on an archive
-type CSARDependency struct {
-	Name    string `json:"name,omitempty"`
-	Version string `json:"version,omitempty"`
-	Hash    string `json:"hash,omitempty"`
-}
-
-// LocationModifierReference holds a reference to a location modifier
-type LocationModifierReference struct {
-	PluginID string `json:"pluginId"`
-	BeanName string `json:"beanName"`
-	Phase    string `json:"phase,omitempty"`
-}
-
-// SecretProviderConfiguration holds the configuraiton of a secret provider
-type SecretProviderConfiguration struct {
-	PluginName    string      `json:"pluginName,omitempty"`
-	Configuration interface{} `json:"configuration,omitempty"`
-}
-
-// LocationConfiguration holds a location configuration properties
-type LocationConfiguration struct {
-	ID                          string                      `json:"id"`
-	CreationDate                int64                       `json:"creationDate,omitempty"`
-	LastUpdateDate              int64                       `json:"lastUpdateDate,omitempty"`
-	Dependencies                []CSARDependency            `json:"dependencies,omitempty"`
-	EnvironmentType             string                      `json:"environmentType,omitempty"`
-	InfrastructureType          string                      `json:"infrastructureType,omitempty"`
-	MetaProperties              map[string]string           `json:"metaProperties,omitempty"`
-	Modifiers                   []LocationModifierReference `json:"modifiers,omitempty"`
-	Name                        string                      `json:"name,omitempty"`
-	OrchestratorID              string                      `json:"orchestratorId,omitempty"`
-	SecretProviderConfiguration SecretProviderConfiguration `json:"secretProviderConfiguration,omitempty"`
-	ApplicationPermissions      map[string][]string         `json:"applicationPermissions,omitempty"`
-	EnvironmentPermissions      map[string][]string         `json:"environmentPermissions,omitempty"`
-	EnvironmentTypePermissions  map[string][]string         `json:"environmentTypePermissions,omitempty"`
-	GroupPermissions            map[string][]string         `json:"groupPermissions,omitempty"`
-	UserPermissions             map[string][]string         `json:"userPermissions,omitempty"`
-}
-
-// Orchestrator holds properties of an orchestrator
-type Orchestrator struct {
-	ID                    string `json:"id"`
-	Name                  string `json:"name"`
-	PluginID              string `json:"pluginId,omitempty"`
-	PluginBean            string `json:"pluginBean,omitempty"`
-	DeploymentNamePattern string `json:"deploymentNamePattern,omitempty"`
-	State                 string `json:"state,omitempty"`
-}
+// Aliases of types defined in the types package for backward compatibility.
+// New code may use the types package directly to avoid depending on the client.
+type (
+	CSAR                             = types.CSAR
+	Version                          = types.Version
+	CSARDependency                   = types.CSARDependency
+	LocationModifierReference        = types.LocationModifierReference
+	SecretProviderConfiguration      = types.SecretProviderConfiguration
+	LocationConfiguration            = types.LocationConfiguration
+	Orchestrator                     = types.Orchestrator
+	LocationMatch                    = types.LocationMatch
+	LocationResourceTemplate         = types.LocationResourceTemplate
+	LocationResourceNodeType         = types.LocationResourceNodeType
+	Error                            = types.Error
+	ParsingError                     = types.ParsingError
+	SimpleMark                       = types.SimpleMark
+	SearchRequest                    = types.SearchRequest
+	NodeTemplatePropertyValue        = types.NodeTemplatePropertyValue
+	NodeTemplate                     = types.NodeTemplate
+	Location                         = types.Location
+	Deployment                       = types.Deployment
+	PropertyValue                    = types.PropertyValue
+	EntrySchema                      = types.EntrySchema
+	PropertyDefinition               = types.PropertyDefinition
+	DeploymentArtifact               = types.DeploymentArtifact
+	Activity                         = types.Activity
+	WorkflowStep                     = types.WorkflowStep
+	Workflow                         = types.Workflow
+	Topology                         = types.Topology
+	InputChange                      = types.InputChange
+	UpdateDeploymentTopologyRequest  = types.UpdateDeploymentTopologyRequest
+	BasicTopologyInfo                = types.BasicTopologyInfo
+	ApplicationCreateRequest         = types.ApplicationCreateRequest
+	Tag                              = types.Tag
+	Application                      = types.Application
+	LocationPoliciesPostRequestIn    = types.LocationPoliciesPostRequestIn
+	CustomCommand                    = types.CustomCommand
+	CustomCommandRequest             = types.CustomCommandRequest
+	ApplicationDeployRequest         = types.ApplicationDeployRequest
+	Informations                     = types.Informations
+	RuntimeTopology                  = types.RuntimeTopology
+	Event                            = types.Event
+	Log                              = types.Log
+	Logs                             = types.Logs
+	LogsSummary                      = types.LogsSummary
+	LogFilter                        = types.LogFilter
+	WorkflowStepInstance             = types.WorkflowStepInstance
+	WorkflowExecution                = types.WorkflowExecution
+	Execution                        = types.Execution
+	Time                             = types.Time
+	FacetedSearchResult              = types.FacetedSearchResult
+	CancelExecRequest                = types.CancelExecRequest
+	User                             = types.User
+	CreateUpdateUserRequest          = types.CreateUpdateUserRequest
+	Group                            = types.Group
+	SecurityConfiguration            = types.SecurityConfiguration
+	Environment                      = types.Environment
+	GitLocation                      = types.GitLocation
+	GitLocationUpdateRequest         = types.GitLocationUpdateRequest
+	GitCredentials                   = types.GitCredentials
+	OrchestratorSummary              = types.OrchestratorSummary
+	LocationSummary                  = types.LocationSummary
+	LocationResourceCreateRequest    = types.LocationResourceCreateRequest
+	LocationResourceUpdateRequest    = types.LocationResourceUpdateRequest
+	EnvironmentCreateRequest         = types.EnvironmentCreateRequest
+	EnvironmentUpdateRequest         = types.EnvironmentUpdateRequest
+	ApplicationVersion               = types.ApplicationVersion
+	ApplicationTopologyVersion       = types.ApplicationTopologyVersion
+	ComponentUsage                   = types.ComponentUsage
+	ToscaTypeDescriptor              = types.ToscaTypeDescriptor
+	OrchestratorDeploymentInfo       = types.OrchestratorDeploymentInfo
+	InputArtifactBinding             = types.InputArtifactBinding
+	TaskFailure                      = types.TaskFailure
+	FailureReport                    = types.FailureReport
+	Task                             = types.Task
+	YorcOrchestratorConfiguration    = types.YorcOrchestratorConfiguration
+	VaultSecretProviderConfiguration = types.VaultSecretProviderConfiguration
+	KubernetesLocationConfiguration  = types.KubernetesLocationConfiguration
+	MetaPropertyDefinition           = types.MetaPropertyDefinition
+	NodeType                         = types.NodeType
+	RelationshipType                 = types.RelationshipType
+	PolicyType                       = types.PolicyType
+	ComponentSearchResult            = types.ComponentSearchResult
+	TopologyEditorOperation          = types.TopologyEditorOperation
+	CSARGitImportLocation            = types.CSARGitImportLocation
+	CSARGitRepository                = types.CSARGitRepository
+	CSARGitRepositoryCreateRequest   = types.CSARGitRepositoryCreateRequest
+	StepTimeline                     = types.StepTimeline
+	ExecutionTimeline                = types.ExecutionTimeline
+	Capability                       = types.Capability
+	NodeTemplateCapabilityValue      = types.NodeTemplateCapabilityValue
+	Requirement                      = types.Requirement
+	NodeTemplateRequirementValue     = types.NodeTemplateRequirementValue
+	RelationshipTemplate             = types.RelationshipTemplate
+	NodeTemplateRelationshipValue    = types.NodeTemplateRelationshipValue
+	NodeTemplateArtifactValue        = types.NodeTemplateArtifactValue
+	NodeTemplateInterfaceValue       = types.NodeTemplateInterfaceValue
+	NodeInterface                    = types.NodeInterface
+	NodeOperation                    = types.NodeOperation
+	ImplementationArtifact           = types.ImplementationArtifact
+	RuntimeWorkflow                  = types.RuntimeWorkflow
+	DeploymentSubstitutions          = types.DeploymentSubstitutions
+	LocationPlacementPolicy          = types.LocationPlacementPolicy
+	LocationGroup                    = types.LocationGroup
+	TopologyTask                     = types.TopologyTask
+	TopologyValidationResult         = types.TopologyValidationResult
+	DeploymentTopologyDTO            = types.DeploymentTopologyDTO
+	Workspace                        = types.Workspace
+	PromotionRequest                 = types.PromotionRequest
+	NodeOperationDefinition          = types.NodeOperationDefinition
+	DeploymentSearchRequest          = types.DeploymentSearchRequest
+)
 
-// LocationMatch holds details on a Location where an application can be deployed
-type LocationMatch struct {
-	Location     LocationConfiguration `json:"location"`
-	Orchestrator Orchestrator          `json:"orchestrator"`
-	Ready        bool                  `json:"ready"`
-	Reasons      interface{}           `json:"reasons,omitempty"`
-}
+type (
+	nodeType             = types.NodeType
+	nodeInterface        = types.NodeInterface
+	nodeOperation        = types.NodeOperation
+	relationshipType     = types.RelationshipType
+	componentRequirement = types.ComponentRequirement
+	capabilityType       = types.CapabilityType
+	componentCapability  = types.ComponentCapability
+	componentProperty    = types.ComponentProperty
+)
 
 // TopologyEditorContext A4C topology editor context to store PreviousOperationID
 type TopologyEditorContext struct {
@@ -134,73 +161,9 @@ var acceptAppJSONHeader = Header{"Accept", "application/json"}
 
 const contentTypeHeaderName = "Content-Type"
 const acceptHeaderName = "Accept"
+const authorizationHeaderName = "Authorization"
 const appJSONHeader = "application/json"
 
-// Error is the representation of an A4C error
-type Error struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
-}
-
-// ParsingError is the representation of an A4C parsing error (typically used in CSAR parsing)
-type ParsingError struct {
-	ErrorLevel string     `json:"errorLevel,omitempty"`
-	ErrorCode  string     `json:"errorCode,omitempty"`
-	Problem    string     `json:"problem,omitempty"`
-	Context    string     `json:"context,omitempty"`
-	Note       string     `json:"note,omitempty"`
-	StartMark  SimpleMark `json:"startMark,omitempty"`
-	EndMark    SimpleMark `json:"endMark,omitempty"`
-}
-
-func (pe *ParsingError) String() string {
-	var b strings.Builder
-	b.WriteString(pe.ErrorLevel)
-	b.WriteString(": ")
-	b.WriteString(pe.ErrorCode)
-	b.WriteString(" ")
-	b.WriteString(pe.Problem)
-	if pe.Context != "" {
-		b.WriteString(". ")
-		b.WriteString(pe.Context)
-	}
-	if pe.Note != "" {
-		b.WriteString(" (")
-		b.WriteString(pe.Note)
-		b.WriteString(")")
-	}
-	if pe.StartMark.Line != 0 || pe.StartMark.Column != 0 {
-		b.WriteString(" StartMark[")
-		b.WriteString(strconv.Itoa(pe.StartMark.Line))
-		b.WriteString(", ")
-		b.WriteString(strconv.Itoa(pe.StartMark.Column))
-		b.WriteString("]")
-	}
-	if pe.EndMark.Line != 0 || pe.EndMark.Column != 0 {
-		b.WriteString(" EndMark[")
-		b.WriteString(strconv.Itoa(pe.EndMark.Line))
-		b.WriteString(", ")
-		b.WriteString(strconv.Itoa(pe.EndMark.Column))
-		b.WriteString("]")
-	}
-
-	return b.String()
-}
-
-// SimpleMark is a mark into a file (line+column)
-type SimpleMark struct {
-	Line   int `json:"line,omitempty"`
-	Column int `json:"column,omitempty"`
-}
-
-// SearchRequest is the representation of a request to search objects such as topologies, orchestrators in the A4C catalog
-type SearchRequest struct {
-	Query   string              `json:"query,omitempty"`
-	From    int                 `json:"from"`
-	Size    int                 `json:"size"`
-	Filters map[string][]string `json:"filters,omitempty"`
-}
-
 // logsSearchRequest is the representation of a request to search logs of an application in the A4C catalog
 type logsSearchRequest struct {
 	From    int    `json:"from"`
@@ -216,218 +179,6 @@ type logsSearchRequest struct {
 	} `json:"sortConfiguration"`
 }
 
-// NodeTemplatePropertyValue represents a node template property value
-type NodeTemplatePropertyValue struct {
-	Key   string        `json:"key,omitempty"`
-	Value PropertyValue `json:"value,omitempty"`
-}
-
-// NodeTemplate is the representation a node template
-type NodeTemplate struct {
-	Name       string                      `json:"name"`
-	Type       string                      `json:"type"`
-	Tags       []Tag                       `json:"tags,omitempty"`
-	Properties []NodeTemplatePropertyValue `json:"properties,omitempty"`
-}
-
-// nodeType is the representation a node type
-type nodeType struct {
-	ArchiveName    string                 `json:"archiveName"`
-	ArchiveVersion string                 `json:"archiveVersion"`
-	ElementID      string                 `json:"elementId"`
-	Requirements   []componentRequirement `json:"requirements"`
-	Capabilities   []componentCapability  `json:"capabilities"`
-	Properties     []componentProperty    `json:"properties"`
-}
-
-// relationshipType is the representation a relationship type
-type relationshipType struct {
-	ArchiveName    string   `json:"archiveName"`
-	ArchiveVersion string   `json:"archiveVersion"`
-	ElementID      string   `json:"elementId"`
-	DerivedFrom    []string `json:"deviredFrom"`
-	ValidTargets   []string `json:"validTargets"`
-	ID             string   `json:"id"`
-}
-
-// componentRequirement is the representation a component relationship requirement
-type componentRequirement struct {
-	ID               string `json:"id"`
-	Type             string `json:"type"`
-	RelationshipType string `json:"relationshipType"`
-}
-
-// capabilityType is the representation a component capability type
-type capabilityType struct {
-	ArchiveName    string   `json:"archiveName"`
-	ArchiveVersion string   `json:"archiveVersion"`
-	ElementID      string   `json:"elementId"`
-	DerivedFrom    []string `json:"deviredFrom"`
-	ID             string   `json:"id"`
-}
-
-// componentCapability is the representation a component capability
-type componentCapability struct {
-	ID   string `json:"id"`
-	Type string `json:"type"`
-}
-
-// componentProperty is the representation a component property
-type componentProperty struct {
-	Key   string `json:"key"`
-	Value struct {
-		Type     string `json:"type"`
-		Required bool   `json:"required"`
-	} `json:"value"`
-}
-
-// Location is the representation a location
-type Location struct {
-	ID   string
-	Name string
-}
-
-// Deployment is the representation a deployment
-type Deployment struct {
-	DeploymentUsername       string            `json:"deploymentUsername"`
-	EndDate                  Time              `json:"endDate"`
-	EnvironmentID            string            `json:"environmentId"`
-	ID                       string            `json:"id"`
-	LocationIds              []string          `json:"locationIds"`
-	OrchestratorDeploymentID string            `json:"orchestratorDeploymentId"`
-	OrchestratorID           string            `json:"orchestratorId"`
-	SourceID                 string            `json:"sourceId"`
-	SourceName               string            `json:"sourceName"`
-	SourceType               string            `json:"sourceType"`
-	StartDate                Time              `json:"startDate"`
-	VersionID                string            `json:"versionId"`
-	WorkflowExecutions       map[string]string `json:"workflowExecutions"`
-}
-
-// PropertyValue holds the definition of a property value
-type PropertyValue struct {
-	Definition     bool          `json:"definition,omitempty"`
-	Value          interface{}   `json:"value,omitempty"`
-	FunctionConcat string        `json:"function_concat,omitempty"`
-	Function       string        `json:"function,omitempty"`
-	Parameters     []interface{} `json:"parameters,omitempty"`
-}
-
-// EntrySchema holds the definition of the type of an element in a list
-type EntrySchema struct {
-	Type        string `json:"type"`
-	Description string `json:"description,omitempty"`
-}
-
-// PropertyDefinition holds the definition of a Topology input property
-type PropertyDefinition struct {
-	Type         string        `json:"type"`
-	EntrySchema  EntrySchema   `json:"entrySchema,omitempty"`
-	Required     bool          `json:"required,omitempty"`
-	DefaultValue PropertyValue `json:"default,omitempty"`
-	Description  string        `json:"description,omitempty"`
-	SuggestionID string        `json:"suggestionId,omitempty"`
-	Password     bool          `json:"password,omitempty"`
-}
-
-// DeploymentArtifact holds properties of an artifact (file) input definition in topology
-type DeploymentArtifact struct {
-	ArtifactType         string                 `json:"artifactType"`
-	ArtifactRef          string                 `json:"artifactRef,omitempty"`
-	ArtifactRepository   string                 `json:"artifactRepository,omitempty"`
-	ArchiveName          string                 `json:"archiveName,omitempty"`
-	ArchiveVersion       string                 `json:"archiveVersion,omitempty"`
-	RepositoryURL        string                 `json:"repositoryURL,omitempty"`
-	RepositoryCredential map[string]interface{} `json:"repositoryCredential,omitempty"`
-	RepositoryName       string                 `json:"repositoryName,omitempty"`
-	ArtifactName         string                 `json:"artifactName,omitempty"`
-	DeployPath           string                 `json:"deployPath,omitempty"`
-	Description          string                 `json:"description,omitempty"`
-}
-
-// Activity holds a workflow activity properties
-type Activity struct {
-	Type          string `json:"type,omitempty"`
-	InterfaceName string `json:"interfaceName,omitempty"` // for activities of type org.alien4cloud.tosca.model.workflow.activities.CallOperationWorkflowActivity
-	OperationName string `json:"operationName,omitempty"` // for activities of type org.alien4cloud.tosca.model.workflow.activities.CallOperationWorkflowActivity
-	Delegate      string `json:"delegate,omitempty"`      // for activities of type org.alien4cloud.tosca.model.workflow.activities.DelegateWorkflowActivity
-	StateName     string `json:"stateName,omitempty"`     // for activities of type org.alien4cloud.tosca.model.workflow.activities.SetStateWorkflowActivity
-	Inline        string `json:"inline,omitempty"`        // for activities of type org.alien4cloud.tosca.model.workflow.activities.InlineWorkflowActivity
-}
-
-// WorkflowStep holds a workflow step properties
-type WorkflowStep struct {
-	Name           string     `json:"name,omitempty"`
-	Target         string     `json:"target,omitempty"`
-	OperationHost  string     `json:"operationHost,omitempty"`
-	Activities     []Activity `json:"activities,omitempty"`
-	OnSuccess      []string   `json:"onSuccess,omitempty"`
-	OnFailure      []string   `json:"onFailure,omitempty"`
-	PrecedingSteps []string   `json:"precedingSteps,omitempty"`
-}
-
-// Workflow holds a workflow properties
-type Workflow struct {
-	Name        string                        `json:"name,omitempty"`
-	Description string                        `json:"description,omitempty"`
-	Metadata    map[string]string             `json:"metadata,omitempty"`
-	Inputs      map[string]PropertyDefinition `json:"inputs,omitempty"`
-	Steps       map[string]WorkflowStep       `json:"steps,omitempty"`
-}
-
-// Topology is the representation a topology template
-type Topology struct {
-	Data struct {
-		NodeTypes         map[string]nodeType         `json:"nodeTypes"`
-		RelationshipTypes map[string]relationshipType `json:"relationshipTypes"`
-		CapabilityTypes   map[string]capabilityType   `json:"capabilityTypes"`
-		Topology          struct {
-			ArchiveName             string                        `json:"archiveName"`
-			ArchiveVersion          string                        `json:"archiveVersion"`
-			Description             string                        `json:"description,omitempty"`
-			NodeTemplates           map[string]NodeTemplate       `json:"nodeTemplates"`
-			Inputs                  map[string]PropertyDefinition `json:"inputs,omitempty"`
-			InputArtifacts          map[string]DeploymentArtifact `json:"inputArtifacts,omitempty"`
-			DeployerInputProperties map[string]PropertyValue      `json:"deployerInputProperties,omitempty"`
-			UploadedInputArtifacts  map[string]DeploymentArtifact `json:"uploadedinputArtifacts,omitempty"`
-			Workflows               map[string]Workflow           `json:"workflows,omitempty"`
-		} `json:"topology"`
-	} `json:"data"`
-}
-
-// UpdateDeploymentTopologyRequest holds a request to update inputs of a deployment
-// topology
-type UpdateDeploymentTopologyRequest struct {
-	InputProperties              map[string]interface{} `json:"inputProperties,omitempty"`
-	ProviderDeploymentProperties map[string]string      `json:"providerDeploymentProperties,omitempty"`
-}
-
-type BasicTopologyInfo struct {
-	ArchiveName string
-	Workspace   string
-	ID          string
-}
-
-// ApplicationCreateRequest is the representation of a request to create an application from a topology template
-type ApplicationCreateRequest struct {
-	Name                      string `json:"name"`
-	ArchiveName               string `json:"archiveName"`
-	TopologyTemplateVersionID string `json:"topologyTemplateVersionId"`
-}
-
-// Tag tag key/value json mapping
-type Tag struct {
-	Key   string `json:"name"`
-	Value string `json:"value"`
-}
-
-// Application represent fields of an application returned by A4C
-type Application struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-	Tags []Tag  `json:"tags,omitempty"`
-}
-
 // TopologyEditor is the representation a topology template editor
 type TopologyEditor interface {
 	getPreviousOperationID() string
@@ -504,162 +255,6 @@ type TopologyEditorAddRelationships struct {
 	TargetedCapabilityName string `json:"targetedCapabilityName"`
 }
 
-// LocationPoliciesPostRequestIn is the representation of a request to set location policies of a topology
-type LocationPoliciesPostRequestIn struct {
-	GroupsToLocations struct {
-		A4CAll string `json:"_A4C_ALL"`
-	} `json:"groupsToLocations"`
-	OrchestratorID string `json:"orchestratorId"`
-}
-
-// ApplicationDeployRequest is the representation of a request to deploy an application in the A4C
-type ApplicationDeployRequest struct {
-	ApplicationEnvironmentID string `json:"applicationEnvironmentId"`
-	ApplicationID            string `json:"applicationId"`
-}
-
-// Informations represents information returned from a4c rest api
-type Informations struct {
-	Data map[string]map[string]struct {
-		State      string            `json:"state"`
-		Attributes map[string]string `json:"attributes"`
-	} `json:"data"`
-	Error Error `json:"error"`
-}
-
-// RuntimeTopology represents runtime topology from a4c rest api
-type RuntimeTopology struct {
-	Data struct {
-		Topology struct {
-			OutputAttributes map[string][]string
-		} `json:"topology"`
-	} `json:"data"`
-	Error Error `json:"error"`
-}
-
-// Event represents an event entry returned by the A4C REST API
-type Event struct {
-	DeploymentID         string                 `json:"deploymentId,omitempty"`
-	Date                 Time                   `json:"date,omitempty"`
-	DeploymentStatus     string                 `json:"deploymentStatus,omitempty"`
-	NodeTemplateId       string                 `json:"nodeTemplateId,omitempty"`
-	InstanceId           string                 `json:"instanceId,omitempty"`
-	InstanceState        string                 `json:"instanceState,omitempty"`
-	InstanceStatus       string                 `json:"instanceStatus,omitempty"`
-	Attributes           map[string]string      `json:"attributes,omitempty"`
-	RuntimeProperties    map[string]string      `json:"runtimeProperties,omitempty"`
-	PersistentProperties map[string]interface{} `json:"persistentProperties,omitempty"`
-	Message              string                 `json:"message,omitempty"`
-}
-
-// Log represents the log entry return by the a4c rest api
-type Log struct {
-	ID               string `json:"id"`
-	DeploymentID     string `json:"deploymentId"`
-	DeploymentPaaSID string `json:"deploymentPaaSId"`
-	Level            string `json:"level"`
-	Timestamp        Time   `json:"timestamp"`
-	WorkflowID       string `json:"workflowId"`
-	ExecutionID      string `json:"executionId"`
-	NodeID           string `json:"nodeId"`
-	InstanceID       string `json:"instanceId"`
-	InterfaceName    string `json:"interfaceName"`
-	OperationName    string `json:"operationName"`
-	Content          string `json:"content"`
-}
-
-// Logs a list of a4c logs
-type Logs []Log
-
-// UnmarshalJSON unmarshals the a4c logs
-func (l *Logs) UnmarshalJSON(b []byte) (err error) {
-
-	logs := []Log{}
-
-	if err := json.Unmarshal(b, &logs); err != nil {
-		return err
-	}
-
-	sort.Slice(logs, func(i, j int) bool {
-		return logs[i].ID < logs[j].ID
-	})
-
-	fmt.Printf("Logs here : %v\n", logs)
-
-	a4cLogs := Logs(logs)
-
-	*l = a4cLogs
-	return
-}
-
-// LogFilter represents rest api A4C logs
-type LogFilter struct {
-	Level       []string `json:"level,omitempty"`
-	WorkflowID  []string `json:"workflowId,omitempty"`
-	ExecutionID []string `json:"executionId,omitempty"`
-}
-
-// WorkflowStepInstance holds properties of a workflow step instance
-type WorkflowStepInstance struct {
-	ID               string `json:"id,omitempty"`
-	StepId           string `json:"stepId,omitempty"`
-	DeploymentId     string `json:"deploymentId,omitempty"`
-	ExecutionId      string `json:"executionId,omitempty"`
-	NodeId           string `json:"nodeId,omitempty"`
-	InstanceId       string `json:"instanceId,omitempty"`
-	TargetNodeId     string `json:"targetNodeId,omitempty"`
-	TargetInstanceId string `json:"targetInstanceId,omitempty"`
-	OperationName    string `json:"operationName,omitempty"`
-	HasFailedTasks   bool   `json:"hasFailedTasks,omitempty"`
-	Status           string `json:"status,omitempty"`
-}
-
-// WorkflowExecution represents rest api workflow execution
-type WorkflowExecution struct {
-	Execution     Execution                         `json:"execution,omitempty"`
-	StepStatus    map[string]string                 `json:"stepStatus,omitempty"`
-	StepInstances map[string][]WorkflowStepInstance `json:"stepInstances,omitempty"`
-}
-
-// Execution hold properties of the execution of a workflow
-type Execution struct {
-	ID                  string `json:"id"`
-	DeploymentID        string `json:"deploymentId"`
-	WorkflowID          string `json:"workflowId"`
-	WorkflowName        string `json:"workflowName"`
-	DisplayWorkflowName string `json:"displayWorkflowName"`
-	Status              string `json:"status"`
-	HasFailedTasks      bool   `json:"hasFailedTasks"`
-	StartDate           Time   `json:"startDate,omitempty"`
-	EndDate             Time   `json:"endDate,omitempty"`
-}
-
-// Time represents the timestamp field from A4C
-type Time struct {
-	time.Time
-}
-
-// MarshalJSON marshals a4c json time data and return the result
-func (t Time) MarshalJSON() ([]byte, error) {
-	// 1 ms = 1 000 000 ns
-	return json.Marshal(t.UnixNano() / int64(1000000))
-}
-
-// UnmarshalJSON unmarshal a4c json time data and sets the Time
-func (t *Time) UnmarshalJSON(b []byte) (err error) {
-	var parsedTime int64
-
-	if err := json.Unmarshal(b, &parsedTime); err != nil {
-		return err
-	}
-
-	// We try to Unmarshal data with nanoseconds precision.
-	// Because timestamp from Alien4Cloud is Millisecond, we need to initialize the time
-	// object with the number of seconds and the number of nano seconds
-	t.Time = time.Unix(parsedTime/int64(1000), (parsedTime%int64(1000))*int64(1000000))
-	return nil
-}
-
 // topologyEditorExecuteRequest is the representation of a request to edit an application from a topology template
 type topologyEditorExecuteRequest struct {
 	PreviousOperationID *string `json:"previousOperationId"`
@@ -685,58 +280,103 @@ type topologyEditorPolicies struct {
 	Targets      []string `json:"targets,omitempty"`
 }
 
-// FacetedSearchResult allows to retrieve pagination information
-type FacetedSearchResult struct {
-	TotalResults int `json:"totalResults"`
-	From         int `json:"from"`
-	To           int `json:"to"`
+// topologyEditorAddNode is the representation of a request to add a node of a given type version
+type topologyEditorAddNode struct {
+	topologyEditorExecuteRequest
+	NodeName          string `json:"nodeName"`
+	IndexedNodeTypeID string `json:"indexedNodeTypeId"`
+}
+
+// topologyEditorGroups is the representation of a request to execute the topology editor on groups
+type topologyEditorGroups struct {
+	topologyEditorExecuteRequest
+	GroupName string `json:"groupName"`
+	NodeName  string `json:"nodeName,omitempty"`
+	NewName   string `json:"newName,omitempty"`
 }
 
-// cancelExecRequest is the representation of a request to cancel an execution.
-type CancelExecRequest struct {
-	EnvironmentID string `json:"environmentId"`
-	ExecutionID   string `json:"executionId"`
+// topologyEditorOutputs is the representation of a request to execute the topology editor on outputs
+type topologyEditorOutputs struct {
+	topologyEditorExecuteRequest
+	NodeName       string `json:"nodeName"`
+	AttributeName  string `json:"attributeName,omitempty"`
+	PropertyName   string `json:"propertyName,omitempty"`
+	CapabilityName string `json:"capabilityName,omitempty"`
 }
 
-// User hosts an Alien4Cloud user properties
-type User struct {
-	UserName string `json:"username"`
-	//Password  string   `json:"password,omitempty"`
-	FirstName string   `json:"firstName,omitempty"`
-	LastName  string   `json:"lastName,omitempty"`
-	Email     string   `json:"email,omitempty"`
-	Roles     []string `json:"roles,omitempty"`
+// topologyEditorInputs is the representation of a request to execute the topology editor on inputs
+type topologyEditorInputs struct {
+	topologyEditorExecuteRequest
+	InputName          string                            `json:"inputName"`
+	NewInputName       string                            `json:"newInputName,omitempty"`
+	PropertyDefinition *topologyEditorPropertyDefinition `json:"propertyDefinition,omitempty"`
+	NodeName           string                            `json:"nodeName,omitempty"`
+	PropertyName       string                            `json:"propertyName,omitempty"`
+	CapabilityName     string                            `json:"capabilityName,omitempty"`
+}
+
+// topologyEditorPropertyDefinition is the representation of a property definition in topology editor requests,
+// unset entry schema and default value are omitted
+type topologyEditorPropertyDefinition struct {
+	Type        string         `json:"type"`
+	EntrySchema *EntrySchema   `json:"entrySchema,omitempty"`
+	Required    bool           `json:"required"`
+	Default     *PropertyValue `json:"default,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Password    bool           `json:"password,omitempty"`
+}
+
+// topologyEditorWorkflowName is the representation of a request to execute the topology editor
+// on a workflow with a new name
+type topologyEditorWorkflowName struct {
+	topologyEditorExecuteRequest
+	WorkflowName string `json:"workflowName"`
+	NewName      string `json:"newName"`
 }
 
-// CreateUserRequest holds parameters of a requets to create or update a user
-type CreateUpdateUserRequest struct {
-	UserName  string   `json:"username"`
-	FirstName string   `json:"firstName,omitempty"`
-	LastName  string   `json:"lastName,omitempty"`
-	Email     string   `json:"email,omitempty"`
-	Roles     []string `json:"roles,omitempty"`
-	Password  string   `json:"password,omitempty"`
+// topologyEditorWorkflowStep is the representation of a request to execute the topology editor on steps of a workflow
+type topologyEditorWorkflowStep struct {
+	topologyEditorExecuteRequest
+	WorkflowName string   `json:"workflowName"`
+	StepID       string   `json:"stepId,omitempty"`
+	NewName      string   `json:"newName,omitempty"`
+	TargetID     string   `json:"targetId,omitempty"`
+	FromStepID   string   `json:"fromStepId,omitempty"`
+	ToStepID     string   `json:"toStepId,omitempty"`
+	ToStepIDs    []string `json:"toStepIds,omitempty"`
+}
+
+// topologyEditorNode is the representation of a request to execute the topology editor on a node
+type topologyEditorNode struct {
+	topologyEditorExecuteRequest
+	NodeName string                `json:"nodeName"`
+	NewName  string                `json:"newName,omitempty"`
+	Coords   *topologyEditorCoords `json:"coords,omitempty"`
 }
 
-// Group hosts an Alien4Cloud user properties
-type Group struct {
-	Name        string   `json:"name"`
-	Email       string   `json:"email,omitempty"`
-	Description string   `json:"description,omitempty"`
-	Users       []string `json:"users,omitempty"`
-	Roles       []string `json:"roles,omitempty"`
+// topologyEditorCoords are coordinates of a node in the topology editor
+type topologyEditorCoords struct {
+	X int `json:"x"`
+	Y int `json:"y"`
 }
 
-// Environment holds properties of an Alien4Cloud environment
-type Environment struct {
-	ID                 string              `json:"id"`
-	Name               string              `json:"name"`
-	Status             string              `json:"status,omitempty"`
-	ApplicationID      string              `json:"applicationId,omitempty"`
-	CurrentVersionName string              `json:"currentVersionName,omitempty"`
-	DeployedVersion    string              `json:"deployedVersion,omitempty"`
-	Description        string              `json:"description,omitempty"`
-	EnvironmentType    string              `json:"environmentType,omitempty"`
-	UserRoles          map[string][]string `json:"userRoles,omitempty"`
-	GroupRoles         map[string][]string `json:"GroupRoles,omitempty"`
+// topologyEditorNodeArtifact is the representation of a request to execute the topology editor on a deployment artifact of a node
+type topologyEditorNodeArtifact struct {
+	topologyEditorExecuteRequest
+	NodeName     string `json:"nodeName"`
+	ArtifactName string `json:"artifactName"`
+}
+
+// topologyEditorRelationship is the representation of a request to execute the topology editor on a relationship of a node
+type topologyEditorRelationship struct {
+	topologyEditorExecuteRequest
+	NodeName         string `json:"nodeName"`
+	RelationshipName string `json:"relationshipName"`
+}
+
+// topologyEditorRelationshipProperty is the representation of a request to update a property of a relationship
+type topologyEditorRelationshipProperty struct {
+	topologyEditorRelationship
+	PropertyName  string `json:"propertyName"`
+	PropertyValue string `json:"propertyValue"`
 }
@@ -19,7 +19,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -38,9 +38,12 @@ type ApplicationService interface {
 	//
 	// It returns a slice of Application and the total number of applications matching the search request query and filters.
 	// That means that this number can be used to control pagination processing along with the from and size parameters
-	// of the SearchRequest.
+	// of the SearchRequest. The size defaults to DefaultPageSize and is capped to MaxPageSize.
+	// A TruncatedResultsError is then returned along with results if more resources match the request.
 	SearchApplications(ctx context.Context, searchRequest SearchRequest) ([]Application, int, error)
 	// Returns the application ID using the given filter
+	//
+	// All matching applications are returned, paging through search results as needed.
 	GetApplicationsID(ctx context.Context, filter string) ([]string, error)
 	// Returns the application with the given ID
 	GetApplicationByID(ctx context.Context, id string) (*Application, error)
@@ -50,14 +53,82 @@ type ApplicationService interface {
 	SetTagToApplication(ctx context.Context, applicationID string, tagKey string, tagValue string) error
 	// Returns the tag value for the given application ID and tag key
 	GetApplicationTag(ctx context.Context, applicationID string, tagKey string) (string, error)
+	// Removes the tag tagKey from the application
+	DeleteTagFromApplication(ctx context.Context, applicationID string, tagKey string) error
+	// Sets a tag tagKey/tagValue to several applications concurrently, errors are aggregated in a *BulkError
+	BulkSetTag(ctx context.Context, applicationIDs []string, tagKey string, tagValue string) error
+	// Removes the tag tagKey from several applications concurrently, errors are aggregated in a *BulkError
+	BulkDeleteTag(ctx context.Context, applicationIDs []string, tagKey string) error
 	// Returns the deployment topology for an application given an environment
-	GetDeploymentTopology(ctx context.Context, appID string, envID string) (*Topology, error)
+	//
+	// Secret properties are returned in clear unless the WithSecretsMasked or WithSecretsExcluded option is given.
+	GetDeploymentTopology(ctx context.Context, appID string, envID string, opts ...TopologyOption) (*Topology, error)
+	// Returns the deployment topology of an application environment with the deployment sections computed by Alien4Cloud
+	// (location policies, substitutions, validation)
+	GetDeploymentTopologyDTO(ctx context.Context, appID string, envID string) (*DeploymentTopologyDTO, error)
 	// SearchEnvironments allows to list environments of a given applications using a given SearchRequest
 	//
 	// It returns a slice of Application and the total number of environments matching the search request query and filters.
 	// That means that this number can be used to control pagination processing along with the from and size parameters
-	// of the SearchRequest.
+	// of the SearchRequest. The size defaults to DefaultPageSize and is capped to MaxPageSize.
+	// A TruncatedResultsError is then returned along with results if more resources match the request.
 	SearchEnvironments(ctx context.Context, applicationID string, searchRequest SearchRequest) ([]Environment, int, error)
+	// Points an environment at another topology version of the application
+	//
+	// variant is the optional topology version qualifier, an empty variant selects the main topology of the version.
+	SetEnvironmentTopologyVersion(ctx context.Context, appID, envID, versionName, variant string) error
+	// Returns the Git location where the topology of an application version is stored
+	GetApplicationVersionGitLocation(ctx context.Context, appID, version string) (GitLocation, error)
+	// Stores the topology of an application version in a remote Git repository
+	UpdateApplicationVersionGitLocation(ctx context.Context, appID, version string, updateRequest GitLocationUpdateRequest) error
+	// Stores again the topology of an application version in the local Git repository of Alien4Cloud
+	DeleteApplicationVersionGitLocation(ctx context.Context, appID, version string) error
+	// Updates the topology of an application version from its remote Git location,
+	// credentials may be nil to use those stored with the Git location
+	PullApplicationVersionFromGit(ctx context.Context, appID, version string, credentials *GitCredentials) error
+	// Pushes the topology of an application version to its remote Git location,
+	// credentials may be nil to use those stored with the Git location
+	PushApplicationVersionToGit(ctx context.Context, appID, version string, credentials *GitCredentials) error
+	// Creates an environment for an application and returns its ID
+	CreateEnvironment(ctx context.Context, appID string, createRequest EnvironmentCreateRequest) (string, error)
+	// Updates an environment of an application
+	UpdateEnvironment(ctx context.Context, appID, envID string, updateRequest EnvironmentUpdateRequest) error
+	// Deletes an environment of an application, it should not be deployed
+	DeleteEnvironment(ctx context.Context, appID, envID string) error
+	// Returns an environment of an application
+	GetEnvironment(ctx context.Context, appID, envID string) (Environment, error)
+	// SearchApplicationVersions allows to list versions of a given application using a given SearchRequest
+	//
+	// It returns a slice of ApplicationVersion and the total number of versions matching the search request query and filters.
+	SearchApplicationVersions(ctx context.Context, appID string, searchRequest SearchRequest) ([]ApplicationVersion, int, error)
+	// Creates a version of an application
+	//
+	// fromVersion is an optional existing version of the application whose topologies are copied to the new version,
+	// an empty fromVersion creates a version with an empty topology.
+	CreateApplicationVersion(ctx context.Context, appID, version, description, fromVersion string) error
+	// Deletes a version of an application, it should not be used by an environment
+	DeleteApplicationVersion(ctx context.Context, appID, version string) error
+	// Creates a topology version identified by a qualifier in a version of an application
+	//
+	// fromQualifier is an optional qualifier of an existing topology version of the application version to copy,
+	// an empty fromQualifier copies the main topology of the version.
+	CreateTopologyVersion(ctx context.Context, appID, version, qualifier, description, fromQualifier string) error
+	// Deletes a topology version of a version of an application, it should not be used by an environment
+	DeleteTopologyVersion(ctx context.Context, appID, version, qualifier string) error
+	// Creates a new application from a snapshot of the topology of an existing application and returns its ID
+	CloneApplication(ctx context.Context, srcAppID, newName string) (string, error)
+	// Grants an application role, like ApplicationRoleManager or ApplicationRoleDevOps, to a user on an application
+	AddApplicationUserRole(ctx context.Context, appID, username, role string) error
+	// Revokes an application role of a user on an application
+	RemoveApplicationUserRole(ctx context.Context, appID, username, role string) error
+	// Grants an application role to a group of users on an application
+	AddApplicationGroupRole(ctx context.Context, appID, groupID, role string) error
+	// Revokes an application role of a group of users on an application
+	RemoveApplicationGroupRole(ctx context.Context, appID, groupID, role string) error
+	// Requests the promotion of the topology of a version of an application to another workspace
+	//
+	// This is a premium feature. See CatalogService PromoteCSAR for details on the returned promotion request.
+	PromoteApplicationVersion(ctx context.Context, appID, version, targetWorkspace string) (*PromotionRequest, error)
 }
 
 type applicationService struct {
@@ -67,17 +138,22 @@ type applicationService struct {
 // CreateAppli Create an application from a template and return its ID
 func (a *applicationService) CreateAppli(ctx context.Context, appName string, appTemplate string) (string, error) {
 
-	var appID string
 	topologyTemplateID, err := a.client.topologyService.GetTopologyTemplateIDByName(ctx, appTemplate)
 	if err != nil {
-		return appID, errors.Wrapf(err, "Unable to get the topology template id of template '%s'", appTemplate)
+		return "", errors.Wrapf(err, "Unable to get the topology template id of template '%s'", appTemplate)
 	}
 
+	return a.createApplicationFromTopology(ctx, appName, topologyTemplateID)
+}
+
+// createApplicationFromTopology creates an application initialized with a copy of the given topology and returns its ID
+func (a *applicationService) createApplicationFromTopology(ctx context.Context, appName string, topologyID string) (string, error) {
+	var appID string
 	appliCreateJSON, err := json.Marshal(
 		ApplicationCreateRequest{
-			appName,
-			appName,
-			topologyTemplateID,
+			Name:                      appName,
+			ArchiveName:               appName,
+			TopologyTemplateVersionID: topologyID,
 		},
 	)
 
@@ -105,57 +181,25 @@ func (a *applicationService) CreateAppli(ctx context.Context, appName string, ap
 }
 
 // GetEnvironmentIDbyName Return the Alien4Cloud environment ID from a given application ID and environment name
+//
+// All environments of the application are searched, paging through search results as needed.
 func (a *applicationService) GetEnvironmentIDbyName(ctx context.Context, appID string, envName string) (string, error) {
-
-	envsSearchBody, err := json.Marshal(
-		SearchRequest{
-			From: 0,
-			Size: 0,
-		},
-	)
-	if err != nil {
-		return "", errors.Wrap(err, "Cannot marshal a SearchRequest structure")
-	}
-
-	request, err := a.client.NewRequest(ctx,
-		"POST",
-		fmt.Sprintf("%s/applications/%s/environments/search", a4CRestAPIPrefix, appID),
-		bytes.NewReader(envsSearchBody))
-
-	if err != nil {
-		return "", errors.Wrap(err, "Unable to create request to get environment ID from its name of an application")
-	}
-
-	var res struct {
-		Data struct {
-			Types []string `json:"types"`
-			Data  []struct {
-				ID   string `json:"id"`
-				Name string `json:"name"`
-			} `json:"data"`
-		} `json:"data"`
-	}
-	response, err := a.client.Do(request)
-	if err != nil {
-		return "", errors.Wrapf(err, "Unable to create request to get environment ID named '%s' for application '%s'", envName, appID)
-	}
-	err = ReadA4CResponse(response, &res)
-	if err != nil {
-		return "", errors.Wrapf(err, "Unable to get environment ID for environment named '%s' in application '%s'", envName, appID)
-	}
-
-	var envID string
-	for i := range res.Data.Data {
-		if res.Data.Data[i].Name == envName {
-			envID = res.Data.Data[i].ID
-			break
+	envsSearchReq := SearchRequest{From: 0, Size: MaxPageSize}
+	for {
+		envs, totalResults, err := a.SearchEnvironments(ctx, appID, envsSearchReq)
+		if err != nil {
+			return "", errors.Wrapf(err, "Unable to get environment ID for environment named '%s' in application '%s'", envName, appID)
+		}
+		for i := range envs {
+			if envs[i].Name == envName {
+				return envs[i].ID, nil
+			}
+		}
+		envsSearchReq.From += len(envs)
+		if len(envs) == 0 || envsSearchReq.From >= totalResults {
+			return "", fmt.Errorf("'%s' environment for application '%s' not found", envName, appID)
 		}
 	}
-
-	if envID == "" {
-		return envID, fmt.Errorf("'%s' environment for application '%s' not found", envName, appID)
-	}
-	return envID, nil
 }
 
 // IsApplicationExist Return true if the application with the given ID exists
@@ -175,46 +219,32 @@ func (a *applicationService) IsApplicationExist(ctx context.Context, application
 		return false, errors.Wrap(err, "Can't check if an application exists")
 	}
 
-	switch response.StatusCode {
-	case http.StatusNotFound:
-		discardHTTPResponseBody(response)
+	err = ReadA4CResponse(response, nil)
+	if errors.Is(err, ErrNotFound) {
 		return false, nil
-
-	default:
-		err = ReadA4CResponse(response, nil)
-		return err == nil, errors.Wrap(err, "Can't check if an application exists")
 	}
+	return err == nil, errors.Wrap(err, "Can't check if an application exists")
 }
 
 // GetApplicationsID returns the application ID using the given filter
+//
+// All matching applications are returned, paging through search results as needed.
 func (a *applicationService) GetApplicationsID(ctx context.Context, filter string) ([]string, error) {
-
-	appsSearchReq :=
-		SearchRequest{
-			filter,
-			0,
-			0,
-			nil,
+	var applicationIds []string
+	appsSearchReq := SearchRequest{Query: filter, From: 0, Size: MaxPageSize}
+	for {
+		apps, totalResults, err := a.SearchApplications(ctx, appsSearchReq)
+		if err != nil {
+			return nil, err
+		}
+		for _, application := range apps {
+			applicationIds = append(applicationIds, application.ID)
+		}
+		appsSearchReq.From += len(apps)
+		if len(apps) == 0 || appsSearchReq.From >= totalResults {
+			return applicationIds, nil
 		}
-
-	apps, totalResults, err := a.SearchApplications(ctx, appsSearchReq)
-	if err != nil {
-		return nil, err
-	}
-
-	if totalResults <= 0 {
-		// No result have been returned
-		return nil, nil
-	}
-
-	applicationIds := []string{}
-
-	for _, application := range apps {
-		applicationIds = append(applicationIds, application.ID)
 	}
-
-	return applicationIds, nil
-
 }
 
 // GetApplicationByID returns the application with the given ID
@@ -246,6 +276,9 @@ func (a *applicationService) GetApplicationByID(ctx context.Context, id string)
 
 // DeleteApplication delete an application
 func (a *applicationService) DeleteApplication(ctx context.Context, appID string) error {
+	if err := a.client.checkDeletionProtection(ctx, appID, "delete"); err != nil {
+		return err
+	}
 
 	request, err := a.client.NewRequest(ctx,
 		"DELETE",
@@ -321,7 +354,7 @@ func (a *applicationService) GetApplicationTag(ctx context.Context, applicationI
 	return "", fmt.Errorf("no tag with key '%s'", tagKey)
 }
 
-func (a *applicationService) GetDeploymentTopology(ctx context.Context, appID string, envID string) (*Topology, error) {
+func (a *applicationService) GetDeploymentTopology(ctx context.Context, appID string, envID string, opts ...TopologyOption) (*Topology, error) {
 	request, err := a.client.NewRequest(ctx,
 		"GET",
 		fmt.Sprintf("%s/applications/%s/environments/%s/deployment-topology", a4CRestAPIPrefix, appID, envID),
@@ -337,12 +370,41 @@ func (a *applicationService) GetDeploymentTopology(ctx context.Context, appID st
 		return res, errors.Wrapf(err, "Cannot get the deployment topology content for application '%s' on environment '%s'", appID, envID)
 	}
 	err = ReadA4CResponse(resp, res)
-	return res, errors.Wrapf(err, "Cannot get the deployment topology content for application '%s' on environment '%s'", appID, envID)
+	if err != nil {
+		return res, errors.Wrapf(err, "Cannot get the deployment topology content for application '%s' on environment '%s'", appID, envID)
+	}
+	applyTopologyOptions(res, opts)
+	return res, nil
+}
+
+// GetDeploymentTopologyDTO returns the deployment topology of an application environment with the deployment sections
+// computed by Alien4Cloud
+func (a *applicationService) GetDeploymentTopologyDTO(ctx context.Context, appID string, envID string) (*DeploymentTopologyDTO, error) {
+	request, err := a.client.NewRequest(ctx,
+		"GET",
+		fmt.Sprintf("%s/applications/%s/environments/%s/deployment-topology", a4CRestAPIPrefix, appID, envID),
+		nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot get the deployment topology for application '%s' on environment '%s'", appID, envID)
+	}
+
+	var res struct {
+		Data DeploymentTopologyDTO `json:"data"`
+	}
+	resp, err := a.client.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot get the deployment topology for application '%s' on environment '%s'", appID, envID)
+	}
+	err = ReadA4CResponse(resp, &res)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot get the deployment topology for application '%s' on environment '%s'", appID, envID)
+	}
+	return &res.Data, nil
 }
 
 func (a *applicationService) SearchApplications(ctx context.Context, searchRequest SearchRequest) ([]Application, int, error) {
 
-	appsSearchBody, err := json.Marshal(searchRequest)
+	appsSearchBody, err := json.Marshal(paginate(searchRequest))
 
 	if err != nil {
 		return nil, 0, errors.Wrap(err, "Cannot marshal a SearchRequest structure")
@@ -371,24 +433,22 @@ func (a *applicationService) SearchApplications(ctx context.Context, searchReque
 		return nil, 0, errors.Wrap(err, "Unable to send request to search A4C application")
 	}
 
-	if response.StatusCode == http.StatusNotFound {
-		discardHTTPResponseBody(response)
+	err = ReadA4CResponse(response, &res)
+	if errors.Is(err, ErrNotFound) {
 		// No application with this filter have been found
 		return nil, 0, nil
 	}
-
-	err = ReadA4CResponse(response, &res)
 	if err != nil {
 		return nil, 0, errors.Wrap(err, "Can't get applications")
 	}
 
-	return res.Data.Data, res.Data.TotalResults, nil
+	return res.Data.Data, res.Data.TotalResults, checkTruncated(searchRequest, len(res.Data.Data), res.Data.TotalResults)
 
 }
 
 func (a *applicationService) SearchEnvironments(ctx context.Context, applicationID string, searchRequest SearchRequest) ([]Environment, int, error) {
 
-	envSearchBody, err := json.Marshal(searchRequest)
+	envSearchBody, err := json.Marshal(paginate(searchRequest))
 
 	if err != nil {
 		return nil, 0, errors.Wrap(err, "Cannot marshal a SearchRequest structure")
@@ -417,17 +477,55 @@ func (a *applicationService) SearchEnvironments(ctx context.Context, application
 		return nil, 0, errors.Wrap(err, "Unable to send request to search A4C environment")
 	}
 
-	if response.StatusCode == http.StatusNotFound {
-		discardHTTPResponseBody(response)
-		// No application with this filter have been found
-		return nil, 0, errors.Errorf("application %q does not exist", applicationID)
-	}
-
 	err = ReadA4CResponse(response, &res)
+	if errors.Is(err, ErrNotFound) {
+		return nil, 0, errors.Wrapf(err, "application %q does not exist", applicationID)
+	}
 	if err != nil {
 		return nil, 0, errors.Wrap(err, "Can't get environments")
 	}
 
-	return res.Data.Data, res.Data.TotalResults, nil
+	return res.Data.Data, res.Data.TotalResults, checkTruncated(searchRequest, len(res.Data.Data), res.Data.TotalResults)
+
+}
+
+// SetEnvironmentTopologyVersion points an environment at another topology version of the application.
+//
+// The topology version is computed from the application version name and the variant (the topology version qualifier)
+// the same way Alien4Cloud does: the variant is inserted before the -SNAPSHOT suffix if any.
+func (a *applicationService) SetEnvironmentTopologyVersion(ctx context.Context, appID, envID, versionName, variant string) error {
+	body, err := json.Marshal(struct {
+		NewTopologyVersion string `json:"newTopologyVersion"`
+	}{
+		NewTopologyVersion: topologyVersion(versionName, variant),
+	})
+	if err != nil {
+		return errors.Wrap(err, "Cannot marshal a topology version update request")
+	}
 
+	request, err := a.client.NewRequest(ctx,
+		"PUT",
+		fmt.Sprintf("%s/applications/%s/environments/%s/topology-version", a4CRestAPIPrefix, appID, envID),
+		bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "Cannot create a request to update the topology version of an environment")
+	}
+
+	response, err := a.client.Do(request)
+	if err != nil {
+		return errors.Wrap(err, "Cannot send a request to update the topology version of an environment")
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Cannot set topology version %q variant %q to environment %q of application %q", versionName, variant, envID, appID)
+}
+
+func topologyVersion(versionName, variant string) string {
+	if variant == "" {
+		return versionName
+	}
+	const snapshotSuffix = "-SNAPSHOT"
+	if strings.HasSuffix(versionName, snapshotSuffix) {
+		return strings.TrimSuffix(versionName, snapshotSuffix) + "-" + variant + snapshotSuffix
+	}
+	return versionName + "-" + variant
 }
@@ -0,0 +1,143 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// bulkConcurrency is the maximum number of concurrent requests sent by bulk operations
+const bulkConcurrency = 8
+
+// BulkError is returned by bulk operations when the operation failed on some items.
+// Errors are indexed by item ID (an application ID for instance).
+type BulkError struct {
+	Errors map[string]error
+}
+
+func (e *BulkError) Error() string {
+	ids := make([]string, 0, len(e.Errors))
+	for id := range e.Errors {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	msgs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		msgs = append(msgs, fmt.Sprintf("%s: %v", id, e.Errors[id]))
+	}
+	return fmt.Sprintf("%d operation(s) failed: %s", len(ids), strings.Join(msgs, "; "))
+}
+
+// runBulk calls fn for each ID with at most bulkConcurrency concurrent calls.
+// It returns a *BulkError holding errors of failed calls or nil if all calls succeeded.
+// Remaining calls are not started once the context is cancelled, the context error is reported for them.
+func runBulk(ctx context.Context, ids []string, fn func(ctx context.Context, id string) error) error {
+	return runBulkWithConcurrency(ctx, bulkConcurrency, ids, fn)
+}
+
+// runBulkWithConcurrency is like runBulk with at most concurrency concurrent calls
+func runBulkWithConcurrency(ctx context.Context, concurrency int, ids []string, fn func(ctx context.Context, id string) error) error {
+	errs := runBulkIndexed(ctx, concurrency, len(ids), func(ctx context.Context, i int) error {
+		return fn(ctx, ids[i])
+	})
+	if len(errs) == 0 {
+		return nil
+	}
+	bulkErr := &BulkError{Errors: make(map[string]error, len(errs))}
+	for i, err := range errs {
+		bulkErr.Errors[ids[i]] = err
+	}
+	return bulkErr
+}
+
+// runBulkIndexed calls fn for each index from 0 to n-1 with at most concurrency concurrent calls.
+// It returns errors of failed calls indexed by their index, or nil if all calls succeeded.
+// Remaining calls are not started once the context is cancelled, the context error is reported for them.
+func runBulkIndexed(ctx context.Context, concurrency, n int, fn func(ctx context.Context, i int) error) map[int]error {
+	var lock sync.Mutex
+	errs := make(map[int]error)
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i := 0; i < n; i++ {
+		select {
+		case <-ctx.Done():
+		case sem <- struct{}{}:
+		}
+		if ctx.Err() != nil {
+			lock.Lock()
+			errs[i] = ctx.Err()
+			lock.Unlock()
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := fn(ctx, i); err != nil {
+				lock.Lock()
+				errs[i] = err
+				lock.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// DeleteTagFromApplication removes the tag tagKey from an application
+func (a *applicationService) DeleteTagFromApplication(ctx context.Context, applicationID string, tagKey string) error {
+	request, err := a.client.NewRequest(ctx,
+		"DELETE",
+		fmt.Sprintf("%s/applications/%s/tags/%s", a4CRestAPIPrefix, applicationID, url.PathEscape(tagKey)),
+		nil)
+	if err != nil {
+		return errors.Wrap(err, "Unable to create request to delete a tag of an application")
+	}
+
+	response, err := a.client.Do(request)
+	if err != nil {
+		return errors.Wrap(err, "Unable to send request to delete a tag of an application")
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Unable to delete tag %q of application %q", tagKey, applicationID)
+}
+
+// BulkSetTag sets the tag tagKey/tagValue to all given applications.
+//
+// Requests are sent concurrently, a *BulkError is returned holding errors of applications that could not be tagged.
+func (a *applicationService) BulkSetTag(ctx context.Context, applicationIDs []string, tagKey string, tagValue string) error {
+	return runBulk(ctx, applicationIDs, func(ctx context.Context, appID string) error {
+		return a.SetTagToApplication(ctx, appID, tagKey, tagValue)
+	})
+}
+
+// BulkDeleteTag removes the tag tagKey from all given applications.
+//
+// Requests are sent concurrently, a *BulkError is returned holding errors of applications that could not be updated.
+func (a *applicationService) BulkDeleteTag(ctx context.Context, applicationIDs []string, tagKey string) error {
+	return runBulk(ctx, applicationIDs, func(ctx context.Context, appID string) error {
+		return a.DeleteTagFromApplication(ctx, appID, tagKey)
+	})
+}
@@ -0,0 +1,102 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_applicationService_BulkTags(t *testing.T) {
+	var lock sync.Mutex
+	calls := make(map[string]string)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if regexp.MustCompile(`.*/applications/failing.*/tags.*`).Match([]byte(r.URL.Path)) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+			return
+		}
+		if regexp.MustCompile(`.*/applications/.*/tags.*`).Match([]byte(r.URL.Path)) {
+			lock.Lock()
+			calls[r.URL.Path] = r.Method
+			lock.Unlock()
+			_, _ = w.Write([]byte(`{"data":null}`))
+			return
+		}
+		t.Errorf("Unexpected call for request %+v", r)
+	}))
+	defer ts.Close()
+
+	a := &applicationService{
+		client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL},
+	}
+
+	var appIDs []string
+	for i := 0; i < 20; i++ {
+		appIDs = append(appIDs, fmt.Sprintf("app%d", i))
+	}
+	assert.NilError(t, a.BulkSetTag(context.Background(), appIDs, "team", "blue"))
+	assert.Equal(t, len(calls), 20)
+	assert.Equal(t, calls["/rest/latest/applications/app3/tags"], http.MethodPost)
+
+	err := a.BulkDeleteTag(context.Background(), []string{"app1", "failing1", "failing2"}, "team")
+	var bulkErr *BulkError
+	assert.Assert(t, errors.As(err, &bulkErr))
+	assert.Equal(t, len(bulkErr.Errors), 2)
+	assert.ErrorContains(t, bulkErr.Errors["failing1"], "not found")
+	assert.Equal(t, calls["/rest/latest/applications/app1/tags/team"], http.MethodDelete)
+	assert.ErrorContains(t, err, "2 operation(s) failed: failing1:")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = a.BulkSetTag(ctx, []string{"app1"}, "team", "blue")
+	assert.Assert(t, errors.As(err, &bulkErr))
+	assert.Equal(t, bulkErr.Errors["app1"], context.Canceled)
+}
+
+func Test_runBulkIndexed(t *testing.T) {
+	var lock sync.Mutex
+	var running, maxRunning int
+	errs := runBulkIndexed(context.Background(), 3, 10, func(ctx context.Context, i int) error {
+		lock.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		lock.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		lock.Lock()
+		running--
+		lock.Unlock()
+		if i%4 == 0 {
+			return fmt.Errorf("failure %d", i)
+		}
+		return nil
+	})
+	assert.Assert(t, maxRunning <= 3, "%d concurrent calls", maxRunning)
+	assert.Equal(t, len(errs), 3)
+	assert.Error(t, errs[8], "failure 8")
+
+	assert.Assert(t, runBulkIndexed(context.Background(), 3, 2, func(ctx context.Context, i int) error { return nil }) == nil)
+}
@@ -0,0 +1,45 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// CloneApplication creates a new application named newName from a snapshot of the topology
+// of the srcAppID application and returns the ID of the new application.
+//
+// The snapshot is taken from the topology of the first environment of the source application,
+// the way Alien4Cloud creates an application from a topology template. Later changes on any of
+// both applications do not affect the other one.
+func (a *applicationService) CloneApplication(ctx context.Context, srcAppID, newName string) (string, error) {
+	envs, _, err := a.SearchEnvironments(ctx, srcAppID, SearchRequest{From: 0, Size: 1})
+	if err != nil {
+		return "", errors.Wrapf(err, "Unable to get environments of application %q", srcAppID)
+	}
+	if len(envs) == 0 {
+		return "", errors.Errorf("application %q has no environment to clone the topology from", srcAppID)
+	}
+
+	topologyID, err := a.client.topologyService.GetTopologyID(ctx, srcAppID, envs[0].ID)
+	if err != nil {
+		return "", errors.Wrapf(err, "Unable to get the topology of application %q", srcAppID)
+	}
+
+	appID, err := a.createApplicationFromTopology(ctx, newName, topologyID)
+	return appID, errors.Wrapf(err, "Unable to clone application %q into %q", srcAppID, newName)
+}
@@ -0,0 +1,66 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_applicationService_CloneApplication(t *testing.T) {
+	var createRequest ApplicationCreateRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/applications/src/environments/search$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":{"data":[{"id":"srcEnv","name":"Environment"}],"totalResults":1}}`))
+		case regexp.MustCompile(`.*/applications/noenv/environments/search$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":{"data":[],"totalResults":0}}`))
+		case regexp.MustCompile(`.*/applications/src/environments/srcEnv/topology$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":"src:0.1.0-SNAPSHOT"}`))
+		case regexp.MustCompile(`.*/applications$`).Match([]byte(r.URL.Path)) && r.Method == http.MethodPost:
+			if err := json.NewDecoder(r.Body).Decode(&createRequest); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":{"code": 400,"message":"bad request"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":"sandbox"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL, "", "", "", false)
+	assert.NilError(t, err)
+	a := &applicationService{client: client.(*a4cClient)}
+
+	appID, err := a.CloneApplication(context.Background(), "src", "sandbox")
+	assert.NilError(t, err)
+	assert.Equal(t, appID, "sandbox")
+	assert.DeepEqual(t, createRequest, ApplicationCreateRequest{Name: "sandbox", ArchiveName: "sandbox", TopologyTemplateVersionID: "src:0.1.0-SNAPSHOT"})
+
+	_, err = a.CloneApplication(context.Background(), "noenv", "sandbox")
+	assert.ErrorContains(t, err, "has no environment")
+
+	_, err = a.CloneApplication(context.Background(), "unknown", "sandbox")
+	assert.ErrorContains(t, err, "does not exist")
+}
@@ -0,0 +1,114 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const environmentsEndpointFormat = "%s/applications/%s/environments"
+
+// CreateEnvironment creates an environment for an application and returns its ID
+func (a *applicationService) CreateEnvironment(ctx context.Context, appID string, createRequest EnvironmentCreateRequest) (string, error) {
+	body, err := json.Marshal(createRequest)
+	if err != nil {
+		return "", errors.Wrap(err, "Cannot marshal an EnvironmentCreateRequest structure")
+	}
+
+	request, err := a.client.NewRequest(ctx,
+		"POST",
+		fmt.Sprintf(environmentsEndpointFormat, a4CRestAPIPrefix, appID),
+		bytes.NewReader(body))
+	if err != nil {
+		return "", errors.Wrap(err, "Cannot create a request to create an environment")
+	}
+
+	var res struct {
+		Data string `json:"data"`
+	}
+	response, err := a.client.Do(request)
+	if err != nil {
+		return "", errors.Wrap(err, "Cannot send a request to create an environment")
+	}
+	err = ReadA4CResponse(response, &res)
+	return res.Data, errors.Wrapf(err, "Cannot create environment %q for application %q", createRequest.Name, appID)
+}
+
+// UpdateEnvironment updates an environment of an application
+func (a *applicationService) UpdateEnvironment(ctx context.Context, appID, envID string, updateRequest EnvironmentUpdateRequest) error {
+	body, err := json.Marshal(updateRequest)
+	if err != nil {
+		return errors.Wrap(err, "Cannot marshal an EnvironmentUpdateRequest structure")
+	}
+
+	request, err := a.client.NewRequest(ctx,
+		"PUT",
+		fmt.Sprintf(environmentsEndpointFormat+"/%s", a4CRestAPIPrefix, appID, envID),
+		bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "Cannot create a request to update an environment")
+	}
+
+	response, err := a.client.Do(request)
+	if err != nil {
+		return errors.Wrap(err, "Cannot send a request to update an environment")
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Cannot update environment %q of application %q", envID, appID)
+}
+
+// DeleteEnvironment deletes an environment of an application
+func (a *applicationService) DeleteEnvironment(ctx context.Context, appID, envID string) error {
+	request, err := a.client.NewRequest(ctx,
+		"DELETE",
+		fmt.Sprintf(environmentsEndpointFormat+"/%s", a4CRestAPIPrefix, appID, envID),
+		nil)
+	if err != nil {
+		return errors.Wrap(err, "Cannot create a request to delete an environment")
+	}
+
+	response, err := a.client.Do(request)
+	if err != nil {
+		return errors.Wrap(err, "Cannot send a request to delete an environment")
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Cannot delete environment %q of application %q", envID, appID)
+}
+
+// GetEnvironment returns an environment of an application
+func (a *applicationService) GetEnvironment(ctx context.Context, appID, envID string) (Environment, error) {
+	request, err := a.client.NewRequest(ctx,
+		"GET",
+		fmt.Sprintf(environmentsEndpointFormat+"/%s", a4CRestAPIPrefix, appID, envID),
+		nil)
+	if err != nil {
+		return Environment{}, errors.Wrap(err, "Cannot create a request to get an environment")
+	}
+
+	var res struct {
+		Data Environment `json:"data"`
+	}
+	response, err := a.client.Do(request)
+	if err != nil {
+		return Environment{}, errors.Wrap(err, "Cannot send a request to get an environment")
+	}
+	err = ReadA4CResponse(response, &res)
+	return res.Data, errors.Wrapf(err, "Cannot get environment %q of application %q", envID, appID)
+}
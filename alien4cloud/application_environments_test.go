@@ -0,0 +1,87 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_applicationService_Environments(t *testing.T) {
+	var requests []string
+	var bodies []map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.ContentLength > 0 {
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":{"code": 400,"message":"bad request"}}`))
+				return
+			}
+			bodies = append(bodies, body)
+		}
+		switch {
+		case regexp.MustCompile(`.*/applications/app/environments$`).Match([]byte(r.URL.Path)) && r.Method == http.MethodPost:
+			_, _ = w.Write([]byte(`{"data":"prodEnvID"}`))
+		case regexp.MustCompile(`.*/applications/app/environments/prodEnvID$`).Match([]byte(r.URL.Path)) && r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"data":{"id":"prodEnvID","name":"prod","applicationId":"app","environmentType":"PRODUCTION","currentVersionName":"0.1.0-SNAPSHOT","status":"UNDEPLOYED"}}`))
+		case regexp.MustCompile(`.*/applications/app/environments/prodEnvID$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	a := &applicationService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	ctx := context.Background()
+
+	envID, err := a.CreateEnvironment(ctx, "app", EnvironmentCreateRequest{
+		Name: "prod", EnvironmentType: "PRODUCTION", VersionID: "app:0.1.0-SNAPSHOT", InputCandidate: "devEnvID",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, envID, "prodEnvID")
+
+	env, err := a.GetEnvironment(ctx, "app", envID)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, env, Environment{
+		ID: "prodEnvID", Name: "prod", ApplicationID: "app", EnvironmentType: "PRODUCTION", CurrentVersionName: "0.1.0-SNAPSHOT", Status: "UNDEPLOYED",
+	})
+
+	assert.NilError(t, a.UpdateEnvironment(ctx, "app", envID, EnvironmentUpdateRequest{Description: "Production"}))
+	assert.NilError(t, a.DeleteEnvironment(ctx, "app", envID))
+
+	assert.DeepEqual(t, requests, []string{
+		"POST /rest/latest/applications/app/environments",
+		"GET /rest/latest/applications/app/environments/prodEnvID",
+		"PUT /rest/latest/applications/app/environments/prodEnvID",
+		"DELETE /rest/latest/applications/app/environments/prodEnvID",
+	})
+	assert.DeepEqual(t, bodies, []map[string]interface{}{
+		{"name": "prod", "environmentType": "PRODUCTION", "versionId": "app:0.1.0-SNAPSHOT", "inputCandidate": "devEnvID"},
+		{"description": "Production"},
+	})
+
+	_, err = a.GetEnvironment(ctx, "app", "unknown")
+	assert.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,134 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const gitLocationEndpointFormat = versionsEndpointFormat + "/%s/git"
+
+// GetApplicationVersionGitLocation returns the Git location where the topology of an application version is stored
+func (a *applicationService) GetApplicationVersionGitLocation(ctx context.Context, appID, version string) (GitLocation, error) {
+	request, err := a.client.NewRequest(ctx,
+		"GET",
+		fmt.Sprintf(gitLocationEndpointFormat, a4CRestAPIPrefix, appID, url.PathEscape(version)),
+		nil)
+	if err != nil {
+		return GitLocation{}, errors.Wrap(err, "Cannot create a request to get the Git location of an application version")
+	}
+
+	var res struct {
+		Data GitLocation `json:"data"`
+	}
+	response, err := a.client.Do(request)
+	if err != nil {
+		return GitLocation{}, errors.Wrap(err, "Cannot send a request to get the Git location of an application version")
+	}
+	err = ReadA4CResponse(response, &res)
+	return res.Data, errors.Wrapf(err, "Cannot get the Git location of version %q of application %q", version, appID)
+}
+
+// UpdateApplicationVersionGitLocation stores the topology of an application version in a remote Git repository.
+//
+// The topology could then be retrieved from a branch of this repository using PullApplicationVersionFromGit.
+func (a *applicationService) UpdateApplicationVersionGitLocation(ctx context.Context, appID, version string, updateRequest GitLocationUpdateRequest) error {
+	body, err := json.Marshal(updateRequest)
+	if err != nil {
+		return errors.Wrap(err, "Cannot marshal a Git location update request")
+	}
+
+	request, err := a.client.NewRequest(ctx,
+		"PUT",
+		fmt.Sprintf(gitLocationEndpointFormat, a4CRestAPIPrefix, appID, url.PathEscape(version)),
+		bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "Cannot create a request to update the Git location of an application version")
+	}
+
+	response, err := a.client.Do(request)
+	if err != nil {
+		return errors.Wrap(err, "Cannot send a request to update the Git location of an application version")
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Cannot update the Git location of version %q of application %q", version, appID)
+}
+
+// DeleteApplicationVersionGitLocation stores again the topology of an application version in the local
+// Git repository of Alien4Cloud
+func (a *applicationService) DeleteApplicationVersionGitLocation(ctx context.Context, appID, version string) error {
+	request, err := a.client.NewRequest(ctx,
+		"DELETE",
+		fmt.Sprintf(gitLocationEndpointFormat, a4CRestAPIPrefix, appID, url.PathEscape(version)),
+		nil)
+	if err != nil {
+		return errors.Wrap(err, "Cannot create a request to delete the Git location of an application version")
+	}
+
+	response, err := a.client.Do(request)
+	if err != nil {
+		return errors.Wrap(err, "Cannot send a request to delete the Git location of an application version")
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Cannot delete the Git location of version %q of application %q", version, appID)
+}
+
+// PullApplicationVersionFromGit updates the topology of an application version from the branch of its remote Git location.
+//
+// credentials may be nil if the credentials stored with the Git location should be used.
+func (a *applicationService) PullApplicationVersionFromGit(ctx context.Context, appID, version string, credentials *GitCredentials) error {
+	return a.gitLocationAction(ctx, "pull", appID, version, credentials)
+}
+
+// PushApplicationVersionToGit pushes the topology of an application version to the branch of its remote Git location.
+//
+// credentials may be nil if the credentials stored with the Git location should be used.
+func (a *applicationService) PushApplicationVersionToGit(ctx context.Context, appID, version string, credentials *GitCredentials) error {
+	return a.gitLocationAction(ctx, "push", appID, version, credentials)
+}
+
+// gitLocationAction sends a request to apply an action (pull or push) to the Git location of an application version
+func (a *applicationService) gitLocationAction(ctx context.Context, action, appID, version string, credentials *GitCredentials) error {
+	var body io.ReadSeeker
+	if credentials != nil {
+		b, err := json.Marshal(credentials)
+		if err != nil {
+			return errors.Wrap(err, "Cannot marshal Git credentials")
+		}
+		body = bytes.NewReader(b)
+	}
+
+	request, err := a.client.NewRequest(ctx,
+		"POST",
+		fmt.Sprintf(gitLocationEndpointFormat+"/%s", a4CRestAPIPrefix, appID, url.PathEscape(version), action),
+		body)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot create a request to %s an application version Git location", action)
+	}
+
+	response, err := a.client.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot send a request to %s an application version Git location", action)
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Cannot %s the Git location of version %q of application %q", action, version, appID)
+}
@@ -0,0 +1,90 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_applicationService_GitLocation(t *testing.T) {
+	var updateRequest GitLocationUpdateRequest
+	var actions []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/applications/app/versions/0.1.0-SNAPSHOT/git$`).Match([]byte(r.URL.Path)) && r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"data":{"id":"app:0.1.0-SNAPSHOT","gitType":"ApplicationVersion","url":"https://git.example.com/app.git","branch":"dev","path":"topology","local":false}}`))
+		case regexp.MustCompile(`.*/applications/app/versions/0.1.0-SNAPSHOT/git$`).Match([]byte(r.URL.Path)) && r.Method == http.MethodPut:
+			if err := json.NewDecoder(r.Body).Decode(&updateRequest); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":{"code": 400,"message":"bad request"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{}`))
+		case regexp.MustCompile(`.*/applications/app/versions/0.1.0-SNAPSHOT/git$`).Match([]byte(r.URL.Path)) && r.Method == http.MethodDelete:
+			actions = append(actions, "delete")
+			_, _ = w.Write([]byte(`{}`))
+		case regexp.MustCompile(`.*/applications/app/versions/0.1.0-SNAPSHOT/git/(pull|push)$`).Match([]byte(r.URL.Path)) && r.Method == http.MethodPost:
+			var credentials GitCredentials
+			if r.ContentLength > 0 {
+				if err := json.NewDecoder(r.Body).Decode(&credentials); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					_, _ = w.Write([]byte(`{"error":{"code": 400,"message":"bad request"}}`))
+					return
+				}
+			}
+			actions = append(actions, path.Base(r.URL.Path)+":"+credentials.Username)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	a := &applicationService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	ctx := context.Background()
+
+	location, err := a.GetApplicationVersionGitLocation(ctx, "app", "0.1.0-SNAPSHOT")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, location, GitLocation{
+		ID:      "app:0.1.0-SNAPSHOT",
+		GitType: "ApplicationVersion",
+		URL:     "https://git.example.com/app.git",
+		Branch:  "dev",
+		Path:    "topology",
+	})
+
+	update := GitLocationUpdateRequest{URL: "https://git.example.com/app.git", Username: "bot", Password: "secret", Branch: "dev"}
+	assert.NilError(t, a.UpdateApplicationVersionGitLocation(ctx, "app", "0.1.0-SNAPSHOT", update))
+	assert.DeepEqual(t, updateRequest, update)
+
+	assert.NilError(t, a.PullApplicationVersionFromGit(ctx, "app", "0.1.0-SNAPSHOT", nil))
+	assert.NilError(t, a.PushApplicationVersionToGit(ctx, "app", "0.1.0-SNAPSHOT", &GitCredentials{Username: "bot", Password: "secret"}))
+	assert.NilError(t, a.DeleteApplicationVersionGitLocation(ctx, "app", "0.1.0-SNAPSHOT"))
+	assert.DeepEqual(t, actions, []string{"pull:", "push:bot", "delete"})
+
+	_, err = a.GetApplicationVersionGitLocation(ctx, "app", "unknown")
+	assert.ErrorContains(t, err, "not found")
+	err = a.PullApplicationVersionFromGit(ctx, "app", "unknown", nil)
+	assert.ErrorContains(t, err, "not found")
+}
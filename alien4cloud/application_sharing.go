@@ -0,0 +1,70 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// AddApplicationUserRole grants an application role to a user on an application
+func (a *applicationService) AddApplicationUserRole(ctx context.Context, appID, username, role string) error {
+	err := a.setApplicationRole(ctx, "PUT", appID, "users", username, role)
+	return errors.Wrapf(err, "Unable to grant role %s on application %s to user %s", role, appID, username)
+}
+
+// RemoveApplicationUserRole revokes an application role of a user on an application
+func (a *applicationService) RemoveApplicationUserRole(ctx context.Context, appID, username, role string) error {
+	err := a.setApplicationRole(ctx, "DELETE", appID, "users", username, role)
+	return errors.Wrapf(err, "Unable to revoke role %s on application %s from user %s", role, appID, username)
+}
+
+// AddApplicationGroupRole grants an application role to a group of users on an application
+func (a *applicationService) AddApplicationGroupRole(ctx context.Context, appID, groupID, role string) error {
+	err := a.setApplicationRole(ctx, "PUT", appID, "groups", groupID, role)
+	return errors.Wrapf(err, "Unable to grant role %s on application %s to group %s", role, appID, groupID)
+}
+
+// RemoveApplicationGroupRole revokes an application role of a group of users on an application
+func (a *applicationService) RemoveApplicationGroupRole(ctx context.Context, appID, groupID, role string) error {
+	err := a.setApplicationRole(ctx, "DELETE", appID, "groups", groupID, role)
+	return errors.Wrapf(err, "Unable to revoke role %s on application %s from group %s", role, appID, groupID)
+}
+
+// setApplicationRole grants (PUT method) or revokes (DELETE method) a role on an application to a subject,
+// subjectKind being either users or groups
+func (a *applicationService) setApplicationRole(ctx context.Context, method, appID, subjectKind, subject, role string) error {
+	request, err := a.client.NewRequest(ctx, method,
+		fmt.Sprintf("%s/applications/%s/roles/%s/%s/%s", a4CRestAPIPrefix, appID, subjectKind, url.PathEscape(subject), role),
+		nil,
+	)
+	if err != nil {
+		return errors.Wrap(err, "Cannot create a request to update application roles")
+	}
+	response, err := a.client.Do(request)
+	if err != nil {
+		return errors.Wrap(err, "Cannot send a request to update application roles")
+	}
+	return ReadA4CResponse(response, nil)
+}
+
+// PromoteApplicationVersion requests the promotion of the topology of a version of an application to another workspace
+func (a *applicationService) PromoteApplicationVersion(ctx context.Context, appID, version, targetWorkspace string) (*PromotionRequest, error) {
+	promotion, err := a.client.catalogService.PromoteCSAR(ctx, appID, version, targetWorkspace)
+	return promotion, errors.Wrapf(err, "Unable to promote version %s of application %s", version, appID)
+}
@@ -0,0 +1,55 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_applicationService_ApplicationRoles(t *testing.T) {
+	var calls []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/latest/applications/unknown/roles/users/jdoe/APPLICATION_MANAGER" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":504,"message":"application not found"}}`))
+			return
+		}
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		_, _ = w.Write([]byte(`{"data":null}`))
+	}))
+	defer ts.Close()
+
+	appService := &applicationService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	ctx := context.Background()
+
+	assert.NilError(t, appService.AddApplicationUserRole(ctx, "app", "jdoe", ApplicationRoleManager))
+	assert.NilError(t, appService.RemoveApplicationUserRole(ctx, "app", "jdoe", ApplicationRoleManager))
+	assert.NilError(t, appService.AddApplicationGroupRole(ctx, "app", "devs", ApplicationRoleDevOps))
+	assert.NilError(t, appService.RemoveApplicationGroupRole(ctx, "app", "devs", ApplicationRoleDevOps))
+	assert.DeepEqual(t, calls, []string{
+		"PUT /rest/latest/applications/app/roles/users/jdoe/APPLICATION_MANAGER",
+		"DELETE /rest/latest/applications/app/roles/users/jdoe/APPLICATION_MANAGER",
+		"PUT /rest/latest/applications/app/roles/groups/devs/APPLICATION_DEVOPS",
+		"DELETE /rest/latest/applications/app/roles/groups/devs/APPLICATION_DEVOPS",
+	})
+
+	err := appService.AddApplicationUserRole(ctx, "unknown", "jdoe", ApplicationRoleManager)
+	assert.ErrorContains(t, err, "application not found")
+}
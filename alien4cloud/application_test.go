@@ -205,6 +205,32 @@ func Test_applicationService_GetEnvironmentIDbyName(t *testing.T) {
 	}
 }
 
+func Test_applicationService_GetEnvironmentIDbyNamePaging(t *testing.T) {
+	var froms []int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req SearchRequest
+		assert.NilError(t, json.NewDecoder(r.Body).Decode(&req))
+		froms = append(froms, req.From)
+		envs := make([]string, 0, req.Size)
+		for i := req.From; i < req.From+req.Size && i < MaxPageSize+2; i++ {
+			envs = append(envs, fmt.Sprintf(`{"id":"env%dID","name":"env%d"}`, i, i))
+		}
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"data":{"data":[%s],"totalResults":%d}}`, strings.Join(envs, ","), MaxPageSize+2)))
+	}))
+	defer ts.Close()
+
+	a := &applicationService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	envID, err := a.GetEnvironmentIDbyName(context.Background(), "app", fmt.Sprintf("env%d", MaxPageSize+1))
+	assert.NilError(t, err)
+	assert.Equal(t, envID, fmt.Sprintf("env%dID", MaxPageSize+1))
+	assert.DeepEqual(t, froms, []int{0, MaxPageSize})
+
+	froms = nil
+	_, err = a.GetEnvironmentIDbyName(context.Background(), "app", "unknown")
+	assert.ErrorContains(t, err, "'unknown' environment for application 'app' not found")
+	assert.DeepEqual(t, froms, []int{0, MaxPageSize})
+}
+
 func Test_applicationService_DeleteApplication(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch {
@@ -361,6 +387,46 @@ func Test_applicationService_GetDeploymentTopology(t *testing.T) {
 	}
 }
 
+func Test_applicationService_GetDeploymentTopologyDTO(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/latest/applications/app/environments/env/deployment-topology":
+			_, _ = w.Write([]byte(`{"data":{
+				"topology":{"id":"app:0.1.0:env","archiveName":"app","archiveVersion":"0.1.0","orchestratorId":"orch1",
+					"nodeTemplates":{"Compute":{"name":"Compute","type":"org.Compute"}},
+					"locationGroups":{"_A4C_ALL":{"name":"_A4C_ALL","members":["Compute"],"policies":[{"name":"Location placement policy","locationId":"zone-a"}]}},
+					"substitutedNodes":{"Compute":"res1"},
+					"originalNodes":{"Compute":{"name":"Compute","type":"tosca.nodes.Compute"}}},
+				"nodeTypes":{"org.Compute":{"elementId":"org.Compute"}},
+				"locationPolicies":{"_A4C_ALL":"zone-a"},
+				"validation":{"valid":false,"taskList":[{"code":"INPUT_PROPERTY","nodeTemplateName":"Compute"}]},
+				"availableSubstitutions":{"availableSubstitutions":{"Compute":["res1"]},"substitutionsTemplates":{"res1":{"id":"res1","name":"small"}}}
+			}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":404,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	a := &applicationService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	dto, err := a.GetDeploymentTopologyDTO(context.Background(), "app", "env")
+	assert.NilError(t, err)
+	assert.Equal(t, dto.Topology.OrchestratorID, "orch1")
+	assert.Equal(t, dto.Topology.NodeTemplates["Compute"].Type, "org.Compute")
+	assert.Equal(t, dto.Topology.OriginalNodes["Compute"].Type, "tosca.nodes.Compute")
+	assert.DeepEqual(t, dto.Topology.SubstitutedNodes, map[string]string{"Compute": "res1"})
+	assert.DeepEqual(t, dto.Topology.LocationGroups["_A4C_ALL"].Policies, []LocationPlacementPolicy{{Name: "Location placement policy", LocationID: "zone-a"}})
+	assert.DeepEqual(t, dto.LocationPolicies, map[string]string{"_A4C_ALL": "zone-a"})
+	assert.DeepEqual(t, dto.Validation, TopologyValidationResult{TaskList: []TopologyTask{{Code: "INPUT_PROPERTY", NodeTemplateName: "Compute"}}})
+	assert.DeepEqual(t, dto.AvailableSubstitutions.AvailableSubstitutions, map[string][]string{"Compute": {"res1"}})
+	assert.Equal(t, dto.AvailableSubstitutions.SubstitutionsTemplates["res1"].Name, "small")
+	assert.Equal(t, dto.NodeTypes["org.Compute"].ElementID, "org.Compute")
+
+	_, err = a.GetDeploymentTopologyDTO(context.Background(), "unknown", "env")
+	assert.ErrorContains(t, err, "not found")
+}
+
 func Test_applicationService_IsApplicationExists(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch {
@@ -664,7 +730,12 @@ func Test_applicationService_SearchEnvironments(t *testing.T) {
 				Status: "deployed",
 			},
 		}, 2, false},
-		{"Size0", args{"existing", SearchRequest{Query: "queryval", Size: 0}}, nil, 0, false},
+		{"DefaultSize", args{"existing", SearchRequest{Query: "queryval", Size: 0}}, []Environment{
+			{
+				ID:     "01",
+				Name:   "queryval",
+				Status: "deployed",
+			}}, 1, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -687,3 +758,53 @@ func Test_applicationService_SearchEnvironments(t *testing.T) {
 		})
 	}
 }
+
+func Test_applicationService_SetEnvironmentTopologyVersion(t *testing.T) {
+	var gotVersion string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/applications/app/environments/env/topology-version`).Match([]byte(r.URL.Path)) && r.Method == http.MethodPut:
+			var req struct {
+				NewTopologyVersion string `json:"newTopologyVersion"`
+			}
+			assert.NilError(t, json.NewDecoder(r.Body).Decode(&req))
+			gotVersion = req.NewTopologyVersion
+			_, _ = w.Write([]byte(`{"data":null}`))
+			return
+		case regexp.MustCompile(`.*/applications/.*/environments/.*/topology-version`).Match([]byte(r.URL.Path)):
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+			return
+		}
+		t.Errorf("Unexpected call for request %+v", r)
+	}))
+	defer ts.Close()
+
+	tests := []struct {
+		name        string
+		appID       string
+		versionName string
+		variant     string
+		want        string
+		wantErr     bool
+	}{
+		{"Version", "app", "1.0.0", "", "1.0.0", false},
+		{"Variant", "app", "1.0.0", "canary", "1.0.0-canary", false},
+		{"SnapshotVariant", "app", "1.1.0-SNAPSHOT", "canary", "1.1.0-canary-SNAPSHOT", false},
+		{"UnknownApp", "unknown", "1.0.0", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotVersion = ""
+			a := &applicationService{
+				client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL},
+			}
+			err := a.SetEnvironmentTopologyVersion(context.Background(), tt.appID, "env", tt.versionName, tt.variant)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("applicationService.SetEnvironmentTopologyVersion() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, gotVersion, tt.want)
+		})
+	}
+}
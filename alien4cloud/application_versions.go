@@ -0,0 +1,169 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const versionsEndpointFormat = "%s/applications/%s/versions"
+
+// applicationVersionID returns the ID of an application version the way Alien4Cloud computes it
+func applicationVersionID(appID, version string) string {
+	return appID + ":" + version
+}
+
+// SearchApplicationVersions allows to list versions of a given application using a given SearchRequest
+func (a *applicationService) SearchApplicationVersions(ctx context.Context, appID string, searchRequest SearchRequest) ([]ApplicationVersion, int, error) {
+	body, err := json.Marshal(paginate(searchRequest))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Cannot marshal a SearchRequest structure")
+	}
+
+	request, err := a.client.NewRequest(ctx,
+		"POST",
+		fmt.Sprintf(versionsEndpointFormat+"/search", a4CRestAPIPrefix, appID),
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Cannot create a request to search application versions")
+	}
+
+	var res struct {
+		Data struct {
+			Data         []ApplicationVersion `json:"data"`
+			TotalResults int                  `json:"totalResults"`
+		} `json:"data"`
+	}
+	response, err := a.client.Do(request)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Cannot send a request to search application versions")
+	}
+	err = ReadA4CResponse(response, &res)
+	if errors.Is(err, ErrNotFound) {
+		return nil, 0, errors.Wrapf(err, "application %q does not exist", appID)
+	}
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "Cannot get versions of application %q", appID)
+	}
+	return res.Data.Data, res.Data.TotalResults, checkTruncated(searchRequest, len(res.Data.Data), res.Data.TotalResults)
+}
+
+// CreateApplicationVersion creates a version of an application, optionally copying topologies of an existing version
+func (a *applicationService) CreateApplicationVersion(ctx context.Context, appID, version, description, fromVersion string) error {
+	createRequest := struct {
+		Version       string `json:"version"`
+		Description   string `json:"description,omitempty"`
+		FromVersionID string `json:"fromVersionId,omitempty"`
+	}{
+		Version:     version,
+		Description: description,
+	}
+	if fromVersion != "" {
+		createRequest.FromVersionID = applicationVersionID(appID, fromVersion)
+	}
+	body, err := json.Marshal(createRequest)
+	if err != nil {
+		return errors.Wrap(err, "Cannot marshal an application version create request")
+	}
+
+	request, err := a.client.NewRequest(ctx,
+		"POST",
+		fmt.Sprintf(versionsEndpointFormat, a4CRestAPIPrefix, appID),
+		bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "Cannot create a request to create an application version")
+	}
+
+	response, err := a.client.Do(request)
+	if err != nil {
+		return errors.Wrap(err, "Cannot send a request to create an application version")
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Cannot create version %q of application %q", version, appID)
+}
+
+// DeleteApplicationVersion deletes a version of an application
+func (a *applicationService) DeleteApplicationVersion(ctx context.Context, appID, version string) error {
+	request, err := a.client.NewRequest(ctx,
+		"DELETE",
+		fmt.Sprintf(versionsEndpointFormat+"/%s", a4CRestAPIPrefix, appID, applicationVersionID(appID, version)),
+		nil)
+	if err != nil {
+		return errors.Wrap(err, "Cannot create a request to delete an application version")
+	}
+
+	response, err := a.client.Do(request)
+	if err != nil {
+		return errors.Wrap(err, "Cannot send a request to delete an application version")
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Cannot delete version %q of application %q", version, appID)
+}
+
+// CreateTopologyVersion creates a topology version identified by a qualifier in a version of an application,
+// copying an existing topology version of the application version
+func (a *applicationService) CreateTopologyVersion(ctx context.Context, appID, version, qualifier, description, fromQualifier string) error {
+	createRequest := struct {
+		Qualifier                  string `json:"qualifier"`
+		Description                string `json:"description,omitempty"`
+		ApplicationTopologyVersion string `json:"applicationTopologyVersion"`
+	}{
+		Qualifier:                  qualifier,
+		Description:                description,
+		ApplicationTopologyVersion: topologyVersion(version, fromQualifier),
+	}
+	body, err := json.Marshal(createRequest)
+	if err != nil {
+		return errors.Wrap(err, "Cannot marshal a topology version create request")
+	}
+
+	request, err := a.client.NewRequest(ctx,
+		"POST",
+		fmt.Sprintf(versionsEndpointFormat+"/%s/topologyVersions", a4CRestAPIPrefix, appID, applicationVersionID(appID, version)),
+		bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "Cannot create a request to create a topology version")
+	}
+
+	response, err := a.client.Do(request)
+	if err != nil {
+		return errors.Wrap(err, "Cannot send a request to create a topology version")
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Cannot create topology version %q of version %q of application %q", qualifier, version, appID)
+}
+
+// DeleteTopologyVersion deletes a topology version of a version of an application
+func (a *applicationService) DeleteTopologyVersion(ctx context.Context, appID, version, qualifier string) error {
+	request, err := a.client.NewRequest(ctx,
+		"DELETE",
+		fmt.Sprintf(versionsEndpointFormat+"/%s/topologyVersions/%s", a4CRestAPIPrefix, appID, applicationVersionID(appID, version), topologyVersion(version, qualifier)),
+		nil)
+	if err != nil {
+		return errors.Wrap(err, "Cannot create a request to delete a topology version")
+	}
+
+	response, err := a.client.Do(request)
+	if err != nil {
+		return errors.Wrap(err, "Cannot send a request to delete a topology version")
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Cannot delete topology version %q of version %q of application %q", qualifier, version, appID)
+}
@@ -0,0 +1,90 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_applicationService_Versions(t *testing.T) {
+	var requests []string
+	var bodies []map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.ContentLength > 0 {
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":{"code": 400,"message":"bad request"}}`))
+				return
+			}
+			bodies = append(bodies, body)
+		}
+		switch {
+		case regexp.MustCompile(`.*/applications/app/versions/search$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":{"totalResults":1,"data":[{"id":"app:0.2.0-SNAPSHOT","applicationId":"app","version":"0.2.0-SNAPSHOT",
+				"topologyVersions":{"0.2.0-SNAPSHOT":{"archiveId":"app:0.2.0-SNAPSHOT"},"0.2.0-blue-SNAPSHOT":{"archiveId":"app:0.2.0-blue-SNAPSHOT","qualifier":"blue"}}}]}}`))
+		case regexp.MustCompile(`.*/applications/app/versions.*`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	a := &applicationService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	ctx := context.Background()
+
+	assert.NilError(t, a.CreateApplicationVersion(ctx, "app", "0.2.0-SNAPSHOT", "Next", "0.1.0-SNAPSHOT"))
+	assert.NilError(t, a.CreateTopologyVersion(ctx, "app", "0.2.0-SNAPSHOT", "blue", "", ""))
+
+	versions, total, err := a.SearchApplicationVersions(ctx, "app", SearchRequest{Size: 10})
+	assert.NilError(t, err)
+	assert.Equal(t, total, 1)
+	assert.DeepEqual(t, versions, []ApplicationVersion{
+		{ID: "app:0.2.0-SNAPSHOT", ApplicationID: "app", Version: "0.2.0-SNAPSHOT", TopologyVersions: map[string]ApplicationTopologyVersion{
+			"0.2.0-SNAPSHOT":      {ArchiveID: "app:0.2.0-SNAPSHOT"},
+			"0.2.0-blue-SNAPSHOT": {ArchiveID: "app:0.2.0-blue-SNAPSHOT", Qualifier: "blue"},
+		}},
+	})
+
+	assert.NilError(t, a.DeleteTopologyVersion(ctx, "app", "0.2.0-SNAPSHOT", "blue"))
+	assert.NilError(t, a.DeleteApplicationVersion(ctx, "app", "0.2.0-SNAPSHOT"))
+
+	assert.DeepEqual(t, requests, []string{
+		"POST /rest/latest/applications/app/versions",
+		"POST /rest/latest/applications/app/versions/app:0.2.0-SNAPSHOT/topologyVersions",
+		"POST /rest/latest/applications/app/versions/search",
+		"DELETE /rest/latest/applications/app/versions/app:0.2.0-SNAPSHOT/topologyVersions/0.2.0-blue-SNAPSHOT",
+		"DELETE /rest/latest/applications/app/versions/app:0.2.0-SNAPSHOT",
+	})
+	assert.DeepEqual(t, bodies[:2], []map[string]interface{}{
+		{"version": "0.2.0-SNAPSHOT", "description": "Next", "fromVersionId": "app:0.1.0-SNAPSHOT"},
+		{"qualifier": "blue", "applicationTopologyVersion": "0.2.0-SNAPSHOT"},
+	})
+
+	_, _, err = a.SearchApplicationVersions(ctx, "unknown", SearchRequest{})
+	assert.ErrorContains(t, err, `application "unknown" does not exist`)
+	assert.Assert(t, errors.Is(err, ErrNotFound), "unexpected error %v", err)
+}
@@ -1,11 +1,9 @@
 package alien4cloud
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"io"
-	"mime/multipart"
 	"net/url"
 	"strings"
 
@@ -22,10 +20,71 @@ type CatalogService interface {
 	// CSAR could be uploaded into a given workspace, this is a premium feature leave empty on OSS version.
 	// If workspace is empty the default workspace will be used.
 	//
+	// The archive is streamed rather than loaded in memory. Requests could only be retried, for instance
+	// after a session expiration, if csar is also an io.Seeker such as an *os.File.
+	//
 	// A critical note is that this function may return a ParsingErr. ParsingErr may contain only warnings
 	// or informative errors that could be ignored. This can be checked by type casting into a ParsingErr
 	// and calling HasCriticalErrors() function.
 	UploadCSAR(ctx context.Context, csar io.Reader, workspace string) (csarDefinition CSAR, err error)
+	// UploadCSARWithProgress submits a Cloud Service ARchive to Alien4Cloud catalog as UploadCSAR does,
+	// calling progress each time a part of the archive is sent.
+	//
+	// Progress receives the number of bytes of the archive sent so far and the archive size, or -1
+	// if the size is unknown because csar is not an io.Seeker. The upload stops as soon as ctx is cancelled.
+	UploadCSARWithProgress(ctx context.Context, csar io.Reader, workspace string, progress func(sent, total int64)) (csarDefinition CSAR, err error)
+	// GetDependencyGraph recursively resolves dependencies of the given CSAR version
+	//
+	// A *CSARDependencyCycleError is returned if archives depend on each other.
+	GetDependencyGraph(ctx context.Context, csarName, csarVersion string) (*CSARDependencyGraph, error)
+	// GetComponentUsage returns resources using the archive defining the given node type version
+	//
+	// It allows to analyze the impact of deprecating or upgrading a shared component.
+	GetComponentUsage(ctx context.Context, elementID, version string) ([]ComponentUsage, error)
+	// SearchComponents searches types of the catalog of the given component type
+	//
+	// The component type is one of ComponentTypeNode, ComponentTypeRelationship or ComponentTypePolicy,
+	// only the slice of the result matching this type is filled.
+	SearchComponents(ctx context.Context, searchRequest SearchRequest, componentType string) (ComponentSearchResult, error)
+	// SearchCSARs allows to list archives of the catalog corresponding to a given SearchRequest
+	//
+	// It returns the archives and the total number of archives matching the search request query and filters.
+	SearchCSARs(ctx context.Context, searchRequest SearchRequest) ([]CSAR, int, error)
+	// GetCSAR returns the definition of an archive of the catalog
+	GetCSAR(ctx context.Context, name, version string) (CSAR, error)
+	// GetCSARDependents returns resources (topologies, applications or archives) depending on the given archive
+	GetCSARDependents(ctx context.Context, name, version string) ([]ComponentUsage, error)
+	// DeleteCSAR deletes an archive from the catalog
+	//
+	// A *CSARInUseError is returned if other resources depend on the archive.
+	DeleteCSAR(ctx context.Context, name, version string) error
+	// GetComplexTOSCAType returns the description of a TOSCA type resolved against the given archives dependencies
+	//
+	// A TypeRegistry allows to cache those descriptions.
+	GetComplexTOSCAType(ctx context.Context, dependencies []CSARDependency, typeName string) (ToscaTypeDescriptor, error)
+	// CreateCSARGitRepository registers a Git repository from which archives are imported into the catalog and returns its ID
+	CreateCSARGitRepository(ctx context.Context, createRequest CSARGitRepositoryCreateRequest) (string, error)
+	// SearchCSARGitRepositories allows to list Git repositories from which archives are imported
+	//
+	// It returns the repositories and the total number of repositories matching the search request query.
+	SearchCSARGitRepositories(ctx context.Context, searchRequest SearchRequest) ([]CSARGitRepository, int, error)
+	// ImportCSARGitRepository triggers the import of archives from the import locations of a Git repository
+	// and returns the imported archives
+	//
+	// As UploadCSAR, this function may return a ParsingErr along with the imported archives.
+	ImportCSARGitRepository(ctx context.Context, repositoryID string) ([]CSAR, error)
+	// DeleteCSARGitRepository unregisters a Git repository, previously imported archives are kept in the catalog
+	DeleteCSARGitRepository(ctx context.Context, repositoryID string) error
+	// GetWorkspaces returns the workspaces in which the logged in user is allowed to upload archives
+	//
+	// This is a premium feature.
+	GetWorkspaces(ctx context.Context) ([]Workspace, error)
+	// PromoteCSAR requests the promotion of an archive, typically a topology template, to another workspace
+	//
+	// This is a premium feature. The promotion is done immediately if the logged in user is allowed to
+	// manage the target workspace, in such case the returned promotion request status is PromotionStatusAccepted.
+	// Otherwise the request status is PromotionStatusInit until a manager of the target workspace processes it.
+	PromoteCSAR(ctx context.Context, csarName, csarVersion, targetWorkspace string) (*PromotionRequest, error)
 }
 
 type catalogService struct {
@@ -78,36 +137,24 @@ func (pe *parsingErr) ParsingErrors() map[string][]ParsingError {
 }
 
 func (cs *catalogService) UploadCSAR(ctx context.Context, csar io.Reader, workspace string) (CSAR, error) {
+	return cs.UploadCSARWithProgress(ctx, csar, workspace, nil)
+}
+
+// UploadCSARWithProgress submits a Cloud Service ARchive to Alien4Cloud catalog reporting the upload progress
+func (cs *catalogService) UploadCSARWithProgress(ctx context.Context, csar io.Reader, workspace string, progress func(sent, total int64)) (CSAR, error) {
 	c := CSAR{}
 	u := fmt.Sprintf("%s/csars", a4CRestAPIPrefix)
 	if workspace != "" {
 		u += "?workspace=" + url.QueryEscape(workspace)
 	}
 
-	// TODO(loicalbertin) we may have an issue on large files as it will load the whole file in memory.
-	// We should consider using io.Pipe() to create a synchronous in-memory pipe.
-	// The tricky part will be to make it work with an expected io.ReadSeeker.
-	var b bytes.Buffer
-	m := multipart.NewWriter(&b)
-	defer m.Close()
 	if x, ok := csar.(io.Closer); ok {
 		defer x.Close()
 	}
-	fw, err := m.CreateFormFile("file", "types.zip")
-	if err != nil {
-		return c, errors.Wrap(err, "Cannot create multipart request")
-	}
-	_, err = io.Copy(fw, csar)
-	if err != nil {
-		return c, errors.Wrap(err, "Cannot copy multipart request data")
-	}
-	m.Close()
-
-	request, err := cs.client.NewRequest(ctx, "POST", u, bytes.NewReader(b.Bytes()))
+	request, err := cs.client.newMultipartStreamRequest(ctx, u, "types.zip", csar, progress)
 	if err != nil {
 		return c, errors.Wrap(err, "Cannot create a request in order to upload a CSAR")
 	}
-	request.Header.Set("Content-Type", m.FormDataContentType())
 
 	var res struct {
 		Data struct {
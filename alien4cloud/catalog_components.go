@@ -0,0 +1,84 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ComponentTypeNode is the component type of node types in catalog searches
+	ComponentTypeNode = types.ComponentTypeNode
+	// ComponentTypeRelationship is the component type of relationship types in catalog searches
+	ComponentTypeRelationship = types.ComponentTypeRelationship
+	// ComponentTypePolicy is the component type of policy types in catalog searches
+	ComponentTypePolicy = types.ComponentTypePolicy
+)
+
+// componentSearchRequest is the representation of a request to search components of the catalog
+type componentSearchRequest struct {
+	SearchRequest
+	Type string `json:"type"`
+}
+
+// SearchComponents searches types of the catalog of the given component type
+func (cs *catalogService) SearchComponents(ctx context.Context, searchRequest SearchRequest, componentType string) (ComponentSearchResult, error) {
+	var result ComponentSearchResult
+	var data interface{}
+	switch componentType {
+	case ComponentTypeNode:
+		data = &result.NodeTypes
+	case ComponentTypeRelationship:
+		data = &result.RelationshipTypes
+	case ComponentTypePolicy:
+		data = &result.PolicyTypes
+	default:
+		return result, errors.Errorf("Unsupported component type %q", componentType)
+	}
+
+	body, err := json.Marshal(componentSearchRequest{SearchRequest: paginate(searchRequest), Type: componentType})
+	if err != nil {
+		return result, errors.Wrap(err, "Cannot marshal a SearchRequest structure")
+	}
+	request, err := cs.client.NewRequest(ctx, "POST", fmt.Sprintf("%s/components/search", a4CRestAPIPrefix), bytes.NewReader(body))
+	if err != nil {
+		return result, errors.Wrapf(err, "Cannot create a request in order to search components of type %s", componentType)
+	}
+
+	var res struct {
+		Data struct {
+			Data interface{} `json:"data"`
+			FacetedSearchResult
+		} `json:"data"`
+	}
+	res.Data.Data = data
+	response, err := cs.client.Do(request)
+	if err != nil {
+		return result, errors.Wrapf(err, "Cannot send a request in order to search components of type %s", componentType)
+	}
+	err = ReadA4CResponse(response, &res)
+	if err != nil {
+		return result, errors.Wrapf(err, "Cannot search components of type %s", componentType)
+	}
+	result.TotalResults = res.Data.TotalResults
+	returned := len(result.NodeTypes) + len(result.RelationshipTypes) + len(result.PolicyTypes)
+	return result, checkTruncated(searchRequest, returned, result.TotalResults)
+}
@@ -0,0 +1,73 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_catalogService_SearchComponents(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/rest/latest/components/search")
+		var req componentSearchRequest
+		assert.NilError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, req.Size, DefaultPageSize)
+		switch req.Type {
+		case ComponentTypeNode:
+			_, _ = w.Write([]byte(`{"data":{"totalResults":12,"data":[{"id":"org.test.App:1.0.0","elementId":"org.test.App",
+				"archiveName":"test","archiveVersion":"1.0.0","derivedFrom":["tosca.nodes.SoftwareComponent","tosca.nodes.Root"],
+				"properties":[{"key":"port","value":{"type":"integer","required":true}}],
+				"capabilities":[{"id":"endpoint","type":"tosca.capabilities.Endpoint"}]}]}}`))
+		case ComponentTypeRelationship:
+			_, _ = w.Write([]byte(`{"data":{"totalResults":1,"data":[{"id":"org.test.ConnectsTo:1.0.0","elementId":"org.test.ConnectsTo",
+				"derivedFrom":["tosca.relationships.ConnectsTo"],"validTargets":["tosca.capabilities.Endpoint"]}]}}`))
+		case ComponentTypePolicy:
+			_, _ = w.Write([]byte(`{"data":{"totalResults":1,"data":[{"id":"org.test.Placement:1.0.0","elementId":"org.test.Placement",
+				"derivedFrom":["tosca.policies.Placement"],"targets":["tosca.nodes.Compute"]}]}}`))
+		}
+	}))
+	defer ts.Close()
+
+	cs := &catalogService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	ctx := context.Background()
+
+	res, err := cs.SearchComponents(ctx, SearchRequest{Query: "App"}, ComponentTypeNode)
+	assert.NilError(t, err)
+	assert.Equal(t, res.TotalResults, 12)
+	assert.Equal(t, len(res.NodeTypes), 1)
+	assert.Equal(t, len(res.RelationshipTypes), 0)
+	assert.DeepEqual(t, res.NodeTypes[0].DerivedFrom, []string{"tosca.nodes.SoftwareComponent", "tosca.nodes.Root"})
+	assert.Equal(t, res.NodeTypes[0].Properties[0].Value.Type, "integer")
+	assert.Equal(t, res.NodeTypes[0].Capabilities[0].Type, "tosca.capabilities.Endpoint")
+
+	res, err = cs.SearchComponents(ctx, SearchRequest{}, ComponentTypeRelationship)
+	assert.NilError(t, err)
+	assert.Equal(t, len(res.RelationshipTypes), 1)
+	assert.DeepEqual(t, res.RelationshipTypes[0].DerivedFrom, []string{"tosca.relationships.ConnectsTo"})
+
+	res, err = cs.SearchComponents(ctx, SearchRequest{}, ComponentTypePolicy)
+	assert.NilError(t, err)
+	assert.Equal(t, len(res.PolicyTypes), 1)
+	assert.DeepEqual(t, res.PolicyTypes[0].Targets, []string{"tosca.nodes.Compute"})
+
+	_, err = cs.SearchComponents(ctx, SearchRequest{}, "ARTIFACT_TYPE")
+	assert.ErrorContains(t, err, "Unsupported component type")
+}
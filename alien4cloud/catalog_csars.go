@@ -0,0 +1,129 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// CSARInUseError is returned by CatalogService.DeleteCSAR() when the archive could not be deleted
+// as other resources depend on it.
+//
+// It may be retrieved from returned errors using errors.As.
+type CSARInUseError struct {
+	CSARID string
+	// Usages are the resources depending on the archive
+	Usages []ComponentUsage
+}
+
+func (e *CSARInUseError) Error() string {
+	return fmt.Sprintf("CSAR %q is used by %d resource(s)", e.CSARID, len(e.Usages))
+}
+
+// SearchCSARs allows to list archives of the catalog corresponding to a given SearchRequest
+func (cs *catalogService) SearchCSARs(ctx context.Context, searchRequest SearchRequest) ([]CSAR, int, error) {
+	body, err := json.Marshal(paginate(searchRequest))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Cannot marshal a SearchRequest structure")
+	}
+
+	request, err := cs.client.NewRequest(ctx, "POST", fmt.Sprintf("%s/csars/search", a4CRestAPIPrefix), bytes.NewReader(body))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Cannot create a request in order to search CSARs")
+	}
+
+	var res struct {
+		Data struct {
+			Data []CSAR `json:"data"`
+			FacetedSearchResult
+		} `json:"data"`
+	}
+	response, err := cs.client.Do(request)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Cannot send a request in order to search CSARs")
+	}
+	err = ReadA4CResponse(response, &res)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Cannot search CSARs")
+	}
+	return res.Data.Data, res.Data.TotalResults, checkTruncated(searchRequest, len(res.Data.Data), res.Data.TotalResults)
+}
+
+// GetCSAR returns the definition of an archive of the catalog
+func (cs *catalogService) GetCSAR(ctx context.Context, name, version string) (CSAR, error) {
+	return cs.getCSAR(ctx, csarID(name, version))
+}
+
+// GetCSARDependents returns resources (topologies, applications or archives) depending on the given archive
+func (cs *catalogService) GetCSARDependents(ctx context.Context, name, version string) ([]ComponentUsage, error) {
+	return cs.getCSARUsages(ctx, csarID(name, version))
+}
+
+func (cs *catalogService) getCSARUsages(ctx context.Context, id string) ([]ComponentUsage, error) {
+	request, err := cs.client.NewRequest(ctx, "GET", fmt.Sprintf("%s/csars/%s", a4CRestAPIPrefix, url.PathEscape(id)), nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot create a request in order to get usages of CSAR %q", id)
+	}
+
+	var res struct {
+		Data struct {
+			RelatedResources []ComponentUsage `json:"relatedResources"`
+		} `json:"data"`
+	}
+	response, err := cs.client.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot send a request in order to get usages of CSAR %q", id)
+	}
+	err = ReadA4CResponse(response, &res)
+	return res.Data.RelatedResources, errors.Wrapf(err, "Cannot get usages of CSAR %q", id)
+}
+
+// DeleteCSAR deletes an archive from the catalog.
+//
+// Alien4Cloud refuses to delete archives used by other resources, a *CSARInUseError listing them is then returned.
+func (cs *catalogService) DeleteCSAR(ctx context.Context, name, version string) error {
+	id := csarID(name, version)
+	request, err := cs.client.NewRequest(ctx, "DELETE", fmt.Sprintf("%s/csars/%s", a4CRestAPIPrefix, url.PathEscape(id)), nil)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot create a request in order to delete CSAR %q", id)
+	}
+
+	var res struct {
+		// Data lists usages of the archive preventing its deletion
+		Data  []ComponentUsage `json:"data"`
+		Error *Error           `json:"error"`
+	}
+	response, err := cs.client.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot send a request in order to delete CSAR %q", id)
+	}
+	err = ReadA4CResponse(response, &res)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot delete CSAR %q", id)
+	}
+	if len(res.Data) > 0 {
+		return errors.WithStack(&CSARInUseError{CSARID: id, Usages: res.Data})
+	}
+	if res.Error != nil {
+		return errors.Errorf("Cannot delete CSAR %q: %s", id, res.Error.Message)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"gotest.tools/v3/assert"
+)
+
+func Test_catalogService_CSARManagement(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/rest/latest/csars/search" && r.Method == http.MethodPost:
+			var searchReq SearchRequest
+			assert.NilError(t, json.NewDecoder(r.Body).Decode(&searchReq))
+			assert.Equal(t, searchReq.Size, DefaultPageSize)
+			_, _ = w.Write([]byte(`{"data":{"data":[{"name":"types","version":"1.0.0"},{"name":"types","version":"2.0.0"}],"totalResults":3}}`))
+		case r.URL.Path == "/rest/latest/csars/types:1.0.0" && r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"data":{"csar":{"name":"types","version":"1.0.0","dependencies":[{"name":"normative","version":"1.0.0"}]},
+				"relatedResources":[{"resourceName":"app","resourceType":"application","resourceId":"app"}]}}`))
+		case r.URL.Path == "/rest/latest/csars/types:1.0.0" && r.Method == http.MethodDelete:
+			_, _ = w.Write([]byte(`{"data":[{"resourceName":"app","resourceType":"application","resourceId":"app"}],
+				"error":{"code":507,"message":"The csar is used"}}`))
+		case r.URL.Path == "/rest/latest/csars/types:2.0.0" && r.Method == http.MethodDelete:
+			_, _ = w.Write([]byte(`{"data":null}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":504,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	cs := &catalogService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	ctx := context.Background()
+
+	csars, total, err := cs.SearchCSARs(ctx, SearchRequest{})
+	assert.NilError(t, err)
+	assert.Equal(t, total, 3)
+	assert.Equal(t, len(csars), 2)
+	assert.Equal(t, csars[1].Version, "2.0.0")
+
+	csar, err := cs.GetCSAR(ctx, "types", "1.0.0")
+	assert.NilError(t, err)
+	assert.Equal(t, csar.Dependencies[0].Name, "normative")
+	_, err = cs.GetCSAR(ctx, "types", "3.0.0")
+	assert.ErrorContains(t, err, "not found")
+
+	dependents, err := cs.GetCSARDependents(ctx, "types", "1.0.0")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, dependents, []ComponentUsage{{ResourceName: "app", ResourceType: "application", ResourceID: "app"}})
+
+	err = cs.DeleteCSAR(ctx, "types", "1.0.0")
+	var inUseErr *CSARInUseError
+	assert.Assert(t, errors.As(err, &inUseErr), "unexpected error %v", err)
+	assert.Equal(t, inUseErr.CSARID, "types:1.0.0")
+	assert.Equal(t, len(inUseErr.Usages), 1)
+
+	assert.NilError(t, cs.DeleteCSAR(ctx, "types", "2.0.0"))
+	assert.ErrorContains(t, cs.DeleteCSAR(ctx, "types", "3.0.0"), `Cannot delete CSAR "types:3.0.0"`)
+}
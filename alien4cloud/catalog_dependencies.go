@@ -0,0 +1,137 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CSARDependencyGraph is the graph of dependencies of a CSAR.
+//
+// Archives are identified by their ID which is "<name>:<version>".
+type CSARDependencyGraph struct {
+	// Root is the ID of the archive the graph was computed for
+	Root string
+	// Archives maps archives IDs to archives definitions
+	Archives map[string]CSAR
+	// Dependencies maps archives IDs to the IDs of the archives they directly depend on
+	Dependencies map[string][]string
+}
+
+// Ordered returns the IDs of all archives of the graph, each archive being listed after its dependencies.
+// The root archive is the last one.
+func (g *CSARDependencyGraph) Ordered() []string {
+	var ordered []string
+	visited := make(map[string]bool, len(g.Archives))
+	var visit func(id string)
+	visit = func(id string) {
+		if visited[id] {
+			return
+		}
+		visited[id] = true
+		for _, dep := range g.Dependencies[id] {
+			visit(dep)
+		}
+		ordered = append(ordered, id)
+	}
+	visit(g.Root)
+	return ordered
+}
+
+// CSARDependencyCycleError is returned by CatalogService.GetDependencyGraph() when archives depend on each other.
+//
+// It may be retrieved from returned errors using errors.As.
+type CSARDependencyCycleError struct {
+	// Cycle holds the IDs of archives forming the cycle, the first archive is repeated at the end
+	Cycle []string
+}
+
+func (e *CSARDependencyCycleError) Error() string {
+	return fmt.Sprintf("cyclic CSAR dependencies: %s", strings.Join(e.Cycle, " -> "))
+}
+
+func csarID(name, version string) string {
+	return name + ":" + version
+}
+
+// getCSAR returns the definition of a CSAR given its ID
+func (cs *catalogService) getCSAR(ctx context.Context, csarID string) (CSAR, error) {
+	request, err := cs.client.NewRequest(ctx, "GET", fmt.Sprintf("%s/csars/%s", a4CRestAPIPrefix, url.PathEscape(csarID)), nil)
+	if err != nil {
+		return CSAR{}, errors.Wrapf(err, "Cannot create a request in order to get CSAR %q", csarID)
+	}
+
+	var res struct {
+		Data struct {
+			CSAR CSAR `json:"csar"`
+		} `json:"data"`
+	}
+	response, err := cs.client.Do(request)
+	if err != nil {
+		return CSAR{}, errors.Wrapf(err, "Cannot send a request in order to get CSAR %q", csarID)
+	}
+	err = ReadA4CResponse(response, &res)
+	return res.Data.CSAR, errors.Wrapf(err, "Cannot get CSAR %q", csarID)
+}
+
+// GetDependencyGraph recursively resolves dependencies of the given CSAR version
+func (cs *catalogService) GetDependencyGraph(ctx context.Context, csarName, csarVersion string) (*CSARDependencyGraph, error) {
+	g := &CSARDependencyGraph{
+		Root:         csarID(csarName, csarVersion),
+		Archives:     make(map[string]CSAR),
+		Dependencies: make(map[string][]string),
+	}
+
+	// path holds archives being resolved to detect cycles
+	var path []string
+	var resolve func(id string) error
+	resolve = func(id string) error {
+		for i, p := range path {
+			if p == id {
+				cycle := append(append([]string{}, path[i:]...), id)
+				return errors.WithStack(&CSARDependencyCycleError{Cycle: cycle})
+			}
+		}
+		if _, ok := g.Archives[id]; ok {
+			return nil
+		}
+		csar, err := cs.getCSAR(ctx, id)
+		if err != nil {
+			return err
+		}
+		g.Archives[id] = csar
+
+		path = append(path, id)
+		defer func() { path = path[:len(path)-1] }()
+		for _, dep := range csar.Dependencies {
+			depID := csarID(dep.Name, dep.Version)
+			g.Dependencies[id] = append(g.Dependencies[id], depID)
+			if err = resolve(depID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := resolve(g.Root); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
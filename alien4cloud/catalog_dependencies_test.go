@@ -0,0 +1,83 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func newHTTPServerTestCSARs(t *testing.T) *httptest.Server {
+	deps := map[string][]string{
+		"app:1.0":    {"db:2.0", "web:1.1"},
+		"web:1.1":    {"common:1.0"},
+		"db:2.0":     {"common:1.0"},
+		"common:1.0": nil,
+		"cycleA:1.0": {"cycleB:1.0"},
+		"cycleB:1.0": {"cycleA:1.0"},
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		csarDeps, ok := deps[id]
+		if !strings.Contains(r.URL.Path, "/csars/") || !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+			return
+		}
+		var res struct {
+			Data struct {
+				CSAR CSAR `json:"csar"`
+			} `json:"data"`
+		}
+		parts := strings.Split(id, ":")
+		res.Data.CSAR = CSAR{ID: id, Name: parts[0], Version: parts[1]}
+		for _, dep := range csarDeps {
+			parts := strings.Split(dep, ":")
+			res.Data.CSAR.Dependencies = append(res.Data.CSAR.Dependencies, CSARDependency{Name: parts[0], Version: parts[1]})
+		}
+		b, err := json.Marshal(&res)
+		assert.NilError(t, err)
+		_, _ = w.Write(b)
+	}))
+}
+
+func Test_catalogService_GetDependencyGraph(t *testing.T) {
+	ts := newHTTPServerTestCSARs(t)
+	defer ts.Close()
+
+	cs := &catalogService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+
+	g, err := cs.GetDependencyGraph(context.Background(), "app", "1.0")
+	assert.NilError(t, err)
+	assert.Equal(t, g.Root, "app:1.0")
+	assert.Equal(t, len(g.Archives), 4)
+	assert.DeepEqual(t, g.Dependencies["app:1.0"], []string{"db:2.0", "web:1.1"})
+	assert.DeepEqual(t, g.Ordered(), []string{"common:1.0", "db:2.0", "web:1.1", "app:1.0"})
+
+	_, err = cs.GetDependencyGraph(context.Background(), "cycleA", "1.0")
+	var cycleErr *CSARDependencyCycleError
+	assert.Assert(t, errors.As(err, &cycleErr))
+	assert.DeepEqual(t, cycleErr.Cycle, []string{"cycleA:1.0", "cycleB:1.0", "cycleA:1.0"})
+
+	_, err = cs.GetDependencyGraph(context.Background(), "unknown", "1.0")
+	assert.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,137 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// CreateCSARGitRepository registers a Git repository from which archives are imported into the catalog
+// and returns its ID
+func (cs *catalogService) CreateCSARGitRepository(ctx context.Context, createRequest CSARGitRepositoryCreateRequest) (string, error) {
+	body, err := json.Marshal(createRequest)
+	if err != nil {
+		return "", errors.Wrap(err, "Cannot marshal a CSARGitRepositoryCreateRequest structure")
+	}
+
+	request, err := cs.client.NewRequest(ctx, "POST", fmt.Sprintf("%s/csarsgit", a4CRestAPIPrefix), bytes.NewReader(body))
+	if err != nil {
+		return "", errors.Wrapf(err, "Cannot create a request in order to register Git repository %q", createRequest.RepositoryURL)
+	}
+
+	var res struct {
+		Data string `json:"data"`
+	}
+	response, err := cs.client.Do(request)
+	if err != nil {
+		return "", errors.Wrapf(err, "Cannot send a request in order to register Git repository %q", createRequest.RepositoryURL)
+	}
+	err = ReadA4CResponse(response, &res)
+	return res.Data, errors.Wrapf(err, "Cannot register Git repository %q", createRequest.RepositoryURL)
+}
+
+// SearchCSARGitRepositories allows to list Git repositories from which archives are imported
+// corresponding to a given SearchRequest
+func (cs *catalogService) SearchCSARGitRepositories(ctx context.Context, searchRequest SearchRequest) ([]CSARGitRepository, int, error) {
+	body, err := json.Marshal(paginate(searchRequest))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Cannot marshal a SearchRequest structure")
+	}
+
+	request, err := cs.client.NewRequest(ctx, "POST", fmt.Sprintf("%s/csarsgit/search", a4CRestAPIPrefix), bytes.NewReader(body))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Cannot create a request in order to search Git repositories")
+	}
+
+	var res struct {
+		Data struct {
+			Data []CSARGitRepository `json:"data"`
+			FacetedSearchResult
+		} `json:"data"`
+	}
+	response, err := cs.client.Do(request)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Cannot send a request in order to search Git repositories")
+	}
+	err = ReadA4CResponse(response, &res)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Cannot search Git repositories")
+	}
+	return res.Data.Data, res.Data.TotalResults, checkTruncated(searchRequest, len(res.Data.Data), res.Data.TotalResults)
+}
+
+// ImportCSARGitRepository triggers the import into the catalog of archives of a Git repository
+// and returns the imported archives
+func (cs *catalogService) ImportCSARGitRepository(ctx context.Context, repositoryID string) ([]CSAR, error) {
+	request, err := cs.client.NewRequest(ctx, "POST", fmt.Sprintf("%s/csarsgit/%s", a4CRestAPIPrefix, url.PathEscape(repositoryID)), nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot create a request in order to import Git repository %q", repositoryID)
+	}
+
+	var res struct {
+		Data []struct {
+			Result  CSAR `json:"result"`
+			Context struct {
+				FileName      string         `json:"fileName"`
+				ParsingErrors []ParsingError `json:"parsingErrors"`
+			} `json:"context"`
+		} `json:"data"`
+	}
+	response, err := cs.client.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot send a request in order to import Git repository %q", repositoryID)
+	}
+	err = ReadA4CResponse(response, &res)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot import Git repository %q", repositoryID)
+	}
+
+	csars := make([]CSAR, 0, len(res.Data))
+	parsingErrors := make(map[string][]ParsingError)
+	for _, result := range res.Data {
+		if result.Result.Name != "" {
+			csars = append(csars, result.Result)
+		}
+		if len(result.Context.ParsingErrors) > 0 {
+			parsingErrors[result.Context.FileName] = append(parsingErrors[result.Context.FileName], result.Context.ParsingErrors...)
+		}
+	}
+	if len(parsingErrors) > 0 {
+		err = &parsingErr{parsingErrors}
+	}
+	return csars, err
+}
+
+// DeleteCSARGitRepository unregisters a Git repository from which archives are imported,
+// previously imported archives are kept in the catalog
+func (cs *catalogService) DeleteCSARGitRepository(ctx context.Context, repositoryID string) error {
+	request, err := cs.client.NewRequest(ctx, "DELETE", fmt.Sprintf("%s/csarsgit/%s", a4CRestAPIPrefix, url.PathEscape(repositoryID)), nil)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot create a request in order to delete Git repository %q", repositoryID)
+	}
+
+	response, err := cs.client.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot send a request in order to delete Git repository %q", repositoryID)
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Cannot delete Git repository %q", repositoryID)
+}
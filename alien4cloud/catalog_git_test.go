@@ -0,0 +1,91 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"gotest.tools/v3/assert"
+)
+
+func Test_catalogService_CSARGitRepositories(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/rest/latest/csarsgit" && r.Method == http.MethodPost:
+			var createReq CSARGitRepositoryCreateRequest
+			assert.NilError(t, json.NewDecoder(r.Body).Decode(&createReq))
+			assert.DeepEqual(t, createReq, CSARGitRepositoryCreateRequest{
+				RepositoryURL:   "https://git.example.com/types.git",
+				Username:        "bot",
+				Password:        "secret",
+				ImportLocations: []CSARGitImportLocation{{BranchID: "main", SubPath: "tosca"}},
+			})
+			_, _ = w.Write([]byte(`{"data":"repo1"}`))
+		case r.URL.Path == "/rest/latest/csarsgit/search" && r.Method == http.MethodPost:
+			var searchReq SearchRequest
+			assert.NilError(t, json.NewDecoder(r.Body).Decode(&searchReq))
+			assert.Equal(t, searchReq.Size, DefaultPageSize)
+			_, _ = w.Write([]byte(`{"data":{"data":[{"id":"repo1","repositoryUrl":"https://git.example.com/types.git","importLocations":[{"branchId":"main","subPath":"tosca"}]}],"totalResults":1}}`))
+		case r.URL.Path == "/rest/latest/csarsgit/repo1" && r.Method == http.MethodPost:
+			_, _ = w.Write([]byte(`{"data":[
+				{"result":{"name":"types","version":"1.0.0"},"context":{"fileName":"tosca/types.yaml","parsingErrors":[]}},
+				{"result":null,"context":{"fileName":"tosca/broken.yaml","parsingErrors":[{"errorLevel":"ERROR","errorCode":"SYNTAX_ERROR","problem":"mapping values are not allowed"}]}}
+			]}`))
+		case r.URL.Path == "/rest/latest/csarsgit/repo1" && r.Method == http.MethodDelete:
+			_, _ = w.Write([]byte(`{"data":null}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":504,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	cs := &catalogService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	ctx := context.Background()
+
+	id, err := cs.CreateCSARGitRepository(ctx, CSARGitRepositoryCreateRequest{
+		RepositoryURL:   "https://git.example.com/types.git",
+		Username:        "bot",
+		Password:        "secret",
+		ImportLocations: []CSARGitImportLocation{{BranchID: "main", SubPath: "tosca"}},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, id, "repo1")
+
+	repos, total, err := cs.SearchCSARGitRepositories(ctx, SearchRequest{})
+	assert.NilError(t, err)
+	assert.Equal(t, total, 1)
+	assert.DeepEqual(t, repos, []CSARGitRepository{{
+		ID:              "repo1",
+		RepositoryURL:   "https://git.example.com/types.git",
+		ImportLocations: []CSARGitImportLocation{{BranchID: "main", SubPath: "tosca"}},
+	}})
+
+	csars, err := cs.ImportCSARGitRepository(ctx, id)
+	assert.Equal(t, len(csars), 1)
+	assert.Equal(t, csars[0].Name, "types")
+	parsingErr, ok := errors.Cause(err).(ParsingErr)
+	assert.Assert(t, ok, "unexpected error %v", err)
+	assert.Assert(t, parsingErr.HasCriticalErrors())
+	assert.Equal(t, len(parsingErr.ParsingErrors()["tosca/broken.yaml"]), 1)
+
+	assert.NilError(t, cs.DeleteCSARGitRepository(ctx, id))
+	assert.ErrorContains(t, cs.DeleteCSARGitRepository(ctx, "unknown"), "not found")
+}
@@ -0,0 +1,234 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+type sarifReport struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules,omitempty"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine,omitempty"`
+	StartColumn int `json:"startColumn,omitempty"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Content string `xml:",chardata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+// sortedParsingErrors returns the file names of the given parsing errors in a deterministic order
+func sortedParsingErrors(pe ParsingErr) []string {
+	fileNames := make([]string, 0, len(pe.ParsingErrors()))
+	for fileName := range pe.ParsingErrors() {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+	return fileNames
+}
+
+// sarifLevel maps an A4C parsing error level to a SARIF result level
+func sarifLevel(errorLevel string) string {
+	switch errorLevel {
+	case "ERROR":
+		return "error"
+	case "WARNING":
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// ParsingErrorsToSARIF converts the content of a ParsingErr into a SARIF 2.1.0 JSON document.
+//
+// Each parsing error is reported as a result whose rule is the A4C error code and whose location
+// is the file and position within the CSAR. toolName is used as the SARIF tool driver name and
+// defaults to "alien4cloud" if empty.
+func ParsingErrorsToSARIF(pe ParsingErr, toolName string) ([]byte, error) {
+	if pe == nil {
+		return nil, errors.New("Cannot convert nil parsing errors to SARIF")
+	}
+	if toolName == "" {
+		toolName = "alien4cloud"
+	}
+	run := sarifRun{
+		Tool:    sarifTool{Driver: sarifDriver{Name: toolName}},
+		Results: make([]sarifResult, 0),
+	}
+	rules := make(map[string]struct{})
+	for _, fileName := range sortedParsingErrors(pe) {
+		for _, parsingError := range pe.ParsingErrors()[fileName] {
+			if _, ok := rules[parsingError.ErrorCode]; !ok {
+				rules[parsingError.ErrorCode] = struct{}{}
+				run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{ID: parsingError.ErrorCode})
+			}
+			location := sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: fileName}}
+			if parsingError.StartMark.Line > 0 {
+				location.Region = &sarifRegion{
+					StartLine:   parsingError.StartMark.Line,
+					StartColumn: parsingError.StartMark.Column,
+					EndLine:     parsingError.EndMark.Line,
+					EndColumn:   parsingError.EndMark.Column,
+				}
+			}
+			run.Results = append(run.Results, sarifResult{
+				RuleID:    parsingError.ErrorCode,
+				Level:     sarifLevel(parsingError.ErrorLevel),
+				Message:   sarifMessage{Text: parsingError.String()},
+				Locations: []sarifLocation{{PhysicalLocation: location}},
+			})
+		}
+	}
+	sort.Slice(run.Tool.Driver.Rules, func(i, j int) bool {
+		return run.Tool.Driver.Rules[i].ID < run.Tool.Driver.Rules[j].ID
+	})
+
+	b, err := json.MarshalIndent(sarifReport{Schema: sarifSchema, Version: sarifVersion, Runs: []sarifRun{run}}, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to marshal parsing errors as SARIF")
+	}
+	return b, nil
+}
+
+// ParsingErrorsToJUnit converts the content of a ParsingErr into a JUnit XML document.
+//
+// A test suite is generated for each file of the CSAR and a test case for each parsing error.
+// Errors with an ERROR level are reported as failures, other levels as skipped test cases
+// so they remain visible without failing the report.
+func ParsingErrorsToJUnit(pe ParsingErr, suiteName string) ([]byte, error) {
+	if pe == nil {
+		return nil, errors.New("Cannot convert nil parsing errors to JUnit")
+	}
+	report := junitTestSuites{Name: suiteName}
+	for _, fileName := range sortedParsingErrors(pe) {
+		suite := junitTestSuite{Name: fileName}
+		for _, parsingError := range pe.ParsingErrors()[fileName] {
+			testCase := junitTestCase{
+				Name:      fmt.Sprintf("%s:%d:%d %s", fileName, parsingError.StartMark.Line, parsingError.StartMark.Column, parsingError.ErrorCode),
+				ClassName: fileName,
+			}
+			if parsingError.ErrorLevel == "ERROR" {
+				testCase.Failure = &junitFailure{
+					Message: parsingError.Problem,
+					Type:    parsingError.ErrorCode,
+					Content: parsingError.String(),
+				}
+				suite.Failures++
+			} else {
+				testCase.Skipped = &junitSkipped{Message: parsingError.String()}
+				suite.Skipped++
+			}
+			suite.TestCases = append(suite.TestCases, testCase)
+			suite.Tests++
+		}
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Suites = append(report.Suites, suite)
+	}
+
+	b, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to marshal parsing errors as JUnit")
+	}
+	return append([]byte(xml.Header), b...), nil
+}
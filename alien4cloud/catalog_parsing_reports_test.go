@@ -0,0 +1,88 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func testParsingErr() ParsingErr {
+	return &parsingErr{parsingErrors: map[string][]ParsingError{
+		"types.yml": {
+			{ErrorLevel: "ERROR", ErrorCode: "TYPE_NOT_FOUND", Problem: "Type not found", StartMark: SimpleMark{Line: 12, Column: 5}, EndMark: SimpleMark{Line: 12, Column: 20}},
+			{ErrorLevel: "WARNING", ErrorCode: "UNKNOWN_ARTIFACT_KEY", Problem: "Unknown artifact"},
+		},
+		"topology.yml": {
+			{ErrorLevel: "INFO", ErrorCode: "TOPOLOGY_DETECTED", Problem: "Topology detected", StartMark: SimpleMark{Line: 1, Column: 1}},
+		},
+	}}
+}
+
+func TestParsingErrorsToSARIF(t *testing.T) {
+	_, err := ParsingErrorsToSARIF(nil, "")
+	assert.ErrorContains(t, err, "nil parsing errors")
+
+	b, err := ParsingErrorsToSARIF(testParsingErr(), "")
+	assert.NilError(t, err)
+
+	var report sarifReport
+	assert.NilError(t, json.Unmarshal(b, &report))
+	assert.Equal(t, report.Version, "2.1.0")
+	assert.Equal(t, len(report.Runs), 1)
+	run := report.Runs[0]
+	assert.Equal(t, run.Tool.Driver.Name, "alien4cloud")
+	assert.DeepEqual(t, run.Tool.Driver.Rules, []sarifRule{{ID: "TOPOLOGY_DETECTED"}, {ID: "TYPE_NOT_FOUND"}, {ID: "UNKNOWN_ARTIFACT_KEY"}})
+	assert.Equal(t, len(run.Results), 3)
+
+	assert.Equal(t, run.Results[0].Level, "note")
+	assert.Equal(t, run.Results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI, "topology.yml")
+	assert.Equal(t, run.Results[1].RuleID, "TYPE_NOT_FOUND")
+	assert.Equal(t, run.Results[1].Level, "error")
+	assert.DeepEqual(t, run.Results[1].Locations[0].PhysicalLocation.Region, &sarifRegion{StartLine: 12, StartColumn: 5, EndLine: 12, EndColumn: 20})
+	assert.Equal(t, run.Results[2].Level, "warning")
+	assert.Assert(t, run.Results[2].Locations[0].PhysicalLocation.Region == nil)
+}
+
+func TestParsingErrorsToJUnit(t *testing.T) {
+	_, err := ParsingErrorsToJUnit(nil, "csar")
+	assert.ErrorContains(t, err, "nil parsing errors")
+
+	b, err := ParsingErrorsToJUnit(testParsingErr(), "csar")
+	assert.NilError(t, err)
+	assert.Assert(t, strings.HasPrefix(string(b), xml.Header))
+
+	var report junitTestSuites
+	assert.NilError(t, xml.Unmarshal(b, &report))
+	assert.Equal(t, report.Name, "csar")
+	assert.Equal(t, report.Tests, 3)
+	assert.Equal(t, report.Failures, 1)
+	assert.Equal(t, len(report.Suites), 2)
+	assert.Equal(t, report.Suites[0].Name, "topology.yml")
+	assert.Equal(t, report.Suites[0].Skipped, 1)
+
+	suite := report.Suites[1]
+	assert.Equal(t, suite.Name, "types.yml")
+	assert.Equal(t, suite.Tests, 2)
+	assert.Equal(t, suite.Failures, 1)
+	assert.Assert(t, suite.TestCases[0].Failure != nil)
+	assert.Equal(t, suite.TestCases[0].Failure.Type, "TYPE_NOT_FOUND")
+	assert.Equal(t, suite.TestCases[0].Name, "types.yml:12:5 TYPE_NOT_FOUND")
+	assert.Assert(t, suite.TestCases[1].Skipped != nil)
+}
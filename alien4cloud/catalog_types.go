@@ -0,0 +1,60 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+type complexTOSCATypeRequest struct {
+	Dependencies       []CSARDependency   `json:"dependencies"`
+	PropertyDefinition PropertyDefinition `json:"propertyDefinition"`
+}
+
+// GetComplexTOSCAType returns the description of a TOSCA type resolved against the given archives dependencies
+func (cs *catalogService) GetComplexTOSCAType(ctx context.Context, dependencies []CSARDependency, typeName string) (ToscaTypeDescriptor, error) {
+	if dependencies == nil {
+		dependencies = []CSARDependency{}
+	}
+	body, err := json.Marshal(complexTOSCATypeRequest{
+		Dependencies:       dependencies,
+		PropertyDefinition: PropertyDefinition{Type: typeName},
+	})
+	if err != nil {
+		return ToscaTypeDescriptor{}, errors.Wrap(err, "Cannot marshal a request in order to get a TOSCA type description")
+	}
+
+	request, err := cs.client.NewRequest(ctx, http.MethodPost,
+		fmt.Sprintf("%s/formdescriptor/complex-tosca-type", a4CRestAPIPrefix), bytes.NewReader(body))
+	if err != nil {
+		return ToscaTypeDescriptor{}, errors.Wrapf(err, "Cannot create a request in order to get description of TOSCA type %q", typeName)
+	}
+
+	response, err := cs.client.Do(request)
+	if err != nil {
+		return ToscaTypeDescriptor{}, errors.Wrapf(err, "Cannot send a request in order to get description of TOSCA type %q", typeName)
+	}
+	var res struct {
+		Data ToscaTypeDescriptor `json:"data"`
+	}
+	err = ReadA4CResponse(response, &res)
+	return res.Data, errors.Wrapf(err, "Cannot get description of TOSCA type %q", typeName)
+}
@@ -0,0 +1,58 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// getNodeType returns the definition of a node type given its element ID and version
+func (cs *catalogService) getNodeType(ctx context.Context, elementID, version string) (nodeType, error) {
+	request, err := cs.client.NewRequest(ctx,
+		"GET",
+		fmt.Sprintf("%s/components/element/%s/version/%s?toscaType=NODE_TYPE", a4CRestAPIPrefix, url.PathEscape(elementID), url.PathEscape(version)),
+		nil)
+	if err != nil {
+		return nodeType{}, errors.Wrapf(err, "Cannot create a request in order to get node type %q version %q", elementID, version)
+	}
+
+	var res struct {
+		Data nodeType `json:"data"`
+	}
+	response, err := cs.client.Do(request)
+	if err != nil {
+		return nodeType{}, errors.Wrapf(err, "Cannot send a request in order to get node type %q version %q", elementID, version)
+	}
+	err = ReadA4CResponse(response, &res)
+	return res.Data, errors.Wrapf(err, "Cannot get node type %q version %q", elementID, version)
+}
+
+// GetComponentUsage returns resources using the archive defining the given node type version.
+//
+// Alien4Cloud tracks usages at the archive level, so returned resources are topologies, applications and archives
+// depending on the archive defining the node type, they may not reference this very node type.
+func (cs *catalogService) GetComponentUsage(ctx context.Context, elementID, version string) ([]ComponentUsage, error) {
+	component, err := cs.getNodeType(ctx, elementID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	usages, err := cs.getCSARUsages(ctx, csarID(component.ArchiveName, component.ArchiveVersion))
+	return usages, errors.Wrapf(err, "Cannot get usages of node type %q version %q", elementID, version)
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_catalogService_GetComponentUsage(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/components/element/org.ystia.Web/version/1.1.0$`).Match([]byte(r.URL.Path)):
+			assert.Equal(t, r.URL.Query().Get("toscaType"), "NODE_TYPE")
+			_, _ = w.Write([]byte(`{"data":{"elementId":"org.ystia.Web","archiveName":"org.ystia.web","archiveVersion":"1.1.0"}}`))
+		case regexp.MustCompile(`.*/csars/org.ystia.web:1.1.0$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":{"csar":{"id":"org.ystia.web:1.1.0"},"relatedResources":[
+				{"resourceName":"shop","resourceType":"application","resourceId":"shop"},
+				{"resourceName":"webTemplate","resourceType":"topologytemplate","resourceId":"webTemplate:0.1.0-SNAPSHOT","workspace":"ALIEN_GLOBAL_WORKSPACE"}]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	cs := &catalogService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	usages, err := cs.GetComponentUsage(context.Background(), "org.ystia.Web", "1.1.0")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, usages, []ComponentUsage{
+		{ResourceName: "shop", ResourceType: "application", ResourceID: "shop"},
+		{ResourceName: "webTemplate", ResourceType: "topologytemplate", ResourceID: "webTemplate:0.1.0-SNAPSHOT", Workspace: "ALIEN_GLOBAL_WORKSPACE"},
+	})
+
+	_, err = cs.GetComponentUsage(context.Background(), "org.ystia.Unknown", "1.1.0")
+	assert.ErrorContains(t, err, `Cannot get node type "org.ystia.Unknown" version "1.1.0"`)
+}
@@ -0,0 +1,68 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// GetWorkspaces returns the workspaces in which the logged in user is allowed to upload archives
+func (cs *catalogService) GetWorkspaces(ctx context.Context) ([]Workspace, error) {
+	request, err := cs.client.NewRequest(ctx, "GET", fmt.Sprintf("%s/workspaces/upload", a4CRestAPIPrefix), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot create a request to get workspaces")
+	}
+	response, err := cs.client.Do(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot send a request to get workspaces")
+	}
+	var res struct {
+		Data []Workspace `json:"data"`
+	}
+	err = ReadA4CResponse(response, &res)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot get workspaces")
+	}
+	return res.Data, nil
+}
+
+// PromoteCSAR requests the promotion of an archive to another workspace
+func (cs *catalogService) PromoteCSAR(ctx context.Context, csarName, csarVersion, targetWorkspace string) (*PromotionRequest, error) {
+	body, err := json.Marshal(PromotionRequest{CSARName: csarName, CSARVersion: csarVersion, TargetWorkspace: targetWorkspace})
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot marshal a promotion request")
+	}
+	request, err := cs.client.NewRequest(ctx, "POST", fmt.Sprintf("%s/workspaces/promotions", a4CRestAPIPrefix), bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot create a request to promote archive %s:%s to workspace %s", csarName, csarVersion, targetWorkspace)
+	}
+	response, err := cs.client.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot send a request to promote archive %s:%s to workspace %s", csarName, csarVersion, targetWorkspace)
+	}
+	var res struct {
+		Data PromotionRequest `json:"data"`
+	}
+	err = ReadA4CResponse(response, &res)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot promote archive %s:%s to workspace %s", csarName, csarVersion, targetWorkspace)
+	}
+	return &res.Data, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_catalogService_Workspaces(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/rest/latest/workspaces/upload":
+			_, _ = w.Write([]byte(`{"data":[{"id":"ALIEN_GLOBAL_WORKSPACE","name":"Global","scope":"ALIEN_GLOBAL_WORKSPACE","roles":["COMPONENTS_MANAGER"]},
+				{"id":"team:dev","name":"dev","scope":"team","roles":["MANAGER"]}]}`))
+		case r.Method == "POST" && r.URL.Path == "/rest/latest/workspaces/promotions":
+			var req PromotionRequest
+			assert.NilError(t, json.NewDecoder(r.Body).Decode(&req))
+			if req.TargetWorkspace == "unknown" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":{"code":504,"message":"workspace not found"}}`))
+				return
+			}
+			assert.Equal(t, req.CSARName, "webTemplate")
+			assert.Equal(t, req.CSARVersion, "1.0.0")
+			_, _ = w.Write([]byte(`{"data":{"id":"p1","csarName":"webTemplate","csarVersion":"1.0.0","currentWorkspace":"team:dev",
+				"targetWorkspace":"` + req.TargetWorkspace + `","requestUser":"admin","status":"INIT"}}`))
+		default:
+			t.Errorf("Unexpected call for request %+v", r)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL, "", "", "", false)
+	assert.NilError(t, err)
+	ctx := context.Background()
+
+	workspaces, err := client.CatalogService().GetWorkspaces(ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, workspaces, []Workspace{
+		{ID: "ALIEN_GLOBAL_WORKSPACE", Name: "Global", Scope: "ALIEN_GLOBAL_WORKSPACE", Roles: []string{"COMPONENTS_MANAGER"}},
+		{ID: "team:dev", Name: "dev", Scope: "team", Roles: []string{"MANAGER"}},
+	})
+
+	promotion, err := client.CatalogService().PromoteCSAR(ctx, "webTemplate", "1.0.0", "ALIEN_GLOBAL_WORKSPACE")
+	assert.NilError(t, err)
+	assert.Equal(t, promotion.ID, "p1")
+	assert.Equal(t, promotion.CurrentWorkspace, "team:dev")
+	assert.Equal(t, promotion.Status, PromotionStatusInit)
+
+	promotion, err = client.ApplicationService().PromoteApplicationVersion(ctx, "webTemplate", "1.0.0", "team:prod")
+	assert.NilError(t, err)
+	assert.Equal(t, promotion.TargetWorkspace, "team:prod")
+
+	_, err = client.CatalogService().PromoteCSAR(ctx, "webTemplate", "1.0.0", "unknown")
+	assert.ErrorContains(t, err, "workspace not found")
+}
@@ -0,0 +1,65 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// Environment variables read by NewClientFromEnv
+const (
+	// EnvURL is the name of the environment variable holding the Alien4Cloud URL, it is required
+	EnvURL = "A4C_URL"
+	// EnvUser is the name of the environment variable holding the user name
+	EnvUser = "A4C_USER"
+	// EnvPassword is the name of the environment variable holding the user password
+	EnvPassword = "A4C_PASSWORD"
+	// EnvToken is the name of the environment variable holding an API token used instead of user and password
+	EnvToken = "A4C_TOKEN"
+	// EnvCACert is the name of the environment variable holding the path of the certificate authority file
+	EnvCACert = "A4C_CA_CERT"
+	// EnvSkipTLSVerify is the name of the environment variable disabling the TLS certificate verification when set to true
+	EnvSkipTLSVerify = "A4C_SKIP_TLS_VERIFY"
+)
+
+// NewClientFromEnv instanciates and returns a Client configured from environment variables.
+//
+// The Alien4Cloud URL is read from A4C_URL, credentials from A4C_USER and A4C_PASSWORD or from A4C_TOKEN
+// which then takes precedence (see WithToken), the certificate authority file path from A4C_CA_CERT and
+// A4C_SKIP_TLS_VERIFY disables the TLS certificate verification when set to a true boolean value.
+// Options are given to NewClient and are applied after the token option.
+func NewClientFromEnv(opts ...ClientOption) (Client, error) {
+	address := os.Getenv(EnvURL)
+	if address == "" {
+		return nil, errors.Errorf("environment variable %s is not set", EnvURL)
+	}
+
+	var skipSecure bool
+	if v := os.Getenv(EnvSkipTLSVerify); v != "" {
+		var err error
+		skipSecure, err = strconv.ParseBool(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value %q for environment variable %s", v, EnvSkipTLSVerify)
+		}
+	}
+
+	if token := os.Getenv(EnvToken); token != "" {
+		opts = append([]ClientOption{WithToken(token)}, opts...)
+	}
+	return NewClient(address, os.Getenv(EnvUser), os.Getenv(EnvPassword), os.Getenv(EnvCACert), skipSecure, opts...)
+}
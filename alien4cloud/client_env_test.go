@@ -0,0 +1,86 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+// setEnv sets environment variables and returns a function restoring their previous values
+func setEnv(t *testing.T, env map[string]string) func() {
+	previous := make(map[string]*string, len(env))
+	for k, v := range env {
+		if old, ok := os.LookupEnv(k); ok {
+			previous[k] = &old
+		} else {
+			previous[k] = nil
+		}
+		assert.NilError(t, os.Setenv(k, v))
+	}
+	return func() {
+		for k, v := range previous {
+			if v == nil {
+				os.Unsetenv(k)
+			} else {
+				os.Setenv(k, *v)
+			}
+		}
+	}
+}
+
+func TestNewClientFromEnv(t *testing.T) {
+	var authorizations []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	restore := setEnv(t, map[string]string{EnvURL: ts.URL, EnvToken: "mytoken", EnvSkipTLSVerify: "", EnvUser: "", EnvPassword: "", EnvCACert: ""})
+	defer restore()
+
+	client, err := NewClientFromEnv()
+	assert.NilError(t, err)
+	ctx := context.Background()
+	request, err := client.NewRequest(ctx, "GET", "/rest/latest/applications/app", nil)
+	assert.NilError(t, err)
+	response, err := client.Do(request)
+	assert.NilError(t, err)
+	assert.NilError(t, ReadA4CResponse(response, nil))
+	assert.DeepEqual(t, authorizations, []string{"Bearer mytoken"})
+
+	os.Setenv(EnvSkipTLSVerify, "maybe")
+	_, err = NewClientFromEnv()
+	assert.ErrorContains(t, err, "invalid value \"maybe\" for environment variable A4C_SKIP_TLS_VERIFY")
+
+	os.Setenv(EnvSkipTLSVerify, "true")
+	os.Setenv(EnvURL, "https://a4c.example.com:8088")
+	_, err = NewClientFromEnv()
+	assert.NilError(t, err)
+
+	os.Setenv(EnvSkipTLSVerify, "false")
+	_, err = NewClientFromEnv()
+	assert.ErrorContains(t, err, "You must provide a certificate authority file in TLS verify mode")
+
+	os.Unsetenv(EnvURL)
+	_, err = NewClientFromEnv()
+	assert.ErrorContains(t, err, "environment variable A4C_URL is not set")
+}
@@ -0,0 +1,89 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import "net/http"
+
+// ClientOption is a function allowing to configure a Client created by NewClient
+type ClientOption func(*a4cClient)
+
+// WithToken configures the client to authenticate requests using the given API token
+// sent as a bearer token in the Authorization header, instead of logging in with a user
+// and a password through the form-based login endpoint.
+//
+// This is typically used with Alien4Cloud premium deployments behind a SSO where form-based
+// login is not available. User and password given to NewClient are then ignored.
+// Requests rejected with a 403 Forbidden status, for instance because the token expired,
+// are not retried and fail with an error matching ErrForbidden.
+func WithToken(token string) ClientOption {
+	return func(c *a4cClient) {
+		c.token = token
+	}
+}
+
+// WithDeletionProtection configures the client to refuse to delete or undeploy applications carrying
+// the tag tagKey with the value tagValue (compared case-insensitively), a *ProtectedResourceError is then returned.
+//
+// An empty tagKey stands for DefaultProtectionTagKey and an empty tagValue for "true".
+// The application is retrieved before each deletion or undeployment to check its tags.
+func WithDeletionProtection(tagKey, tagValue string) ClientOption {
+	if tagKey == "" {
+		tagKey = DefaultProtectionTagKey
+	}
+	if tagValue == "" {
+		tagValue = "true"
+	}
+	return func(c *a4cClient) {
+		c.protectionTag = &Tag{Key: tagKey, Value: tagValue}
+	}
+}
+
+// WithInputSecretProvider configures the client to store values of password inputs given to
+// DeploymentService.UpdateDeploymentTopology using the given provider, and to send the references
+// it returns to Alien4Cloud instead of clear-text values.
+//
+// The deployment topology is retrieved before each update to know which inputs are passwords.
+func WithInputSecretProvider(provider InputSecretProvider) ClientOption {
+	return func(c *a4cClient) {
+		c.inputSecretProvider = provider
+	}
+}
+
+// WithCookieJar configures the client to use the given cookie jar to store session cookies
+// instead of its default in-memory one.
+//
+// This allows to share a session with other HTTP clients, for instance with a client which
+// went through a SAML or OIDC authentication flow on a SSO proxy fronting Alien4Cloud.
+// A nil jar keeps the default one, as the client needs a jar to store session cookies.
+func WithCookieJar(jar http.CookieJar) ClientOption {
+	return func(c *a4cClient) {
+		if jar != nil {
+			c.client.Jar = jar
+		}
+	}
+}
+
+// WithSessionCookie configures the client to send the given pre-established session cookie,
+// for instance a cookie issued by a SSO proxy fronting Alien4Cloud. This option may be given
+// several times to send several cookies.
+//
+// Cookies are stored in the cookie jar of the client for the Alien4Cloud URL. As the form-based
+// login is not possible in this case, Login does nothing and requests rejected with a 403 Forbidden
+// status are not retried: a new session cookie should be given to a new client once the session expires.
+func WithSessionCookie(cookie *http.Cookie) ClientOption {
+	return func(c *a4cClient) {
+		c.sessionCookies = append(c.sessionCookies, cookie)
+	}
+}
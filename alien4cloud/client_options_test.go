@@ -0,0 +1,122 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestWithToken(t *testing.T) {
+	var paths, authorizations []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL, "", "", "", false, WithToken("mytoken"))
+	assert.NilError(t, err)
+
+	ctx := context.Background()
+	assert.NilError(t, client.Login(ctx))
+	request, err := client.NewRequest(ctx, "GET", "/rest/latest/applications/app", nil)
+	assert.NilError(t, err)
+	response, err := client.Do(request)
+	assert.NilError(t, err)
+	assert.NilError(t, ReadA4CResponse(response, nil))
+
+	assert.DeepEqual(t, paths, []string{"/rest/latest/applications/app"})
+	assert.DeepEqual(t, authorizations, []string{"Bearer mytoken"})
+}
+
+func TestWithSessionCookie(t *testing.T) {
+	var paths, cookies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		var values []string
+		for _, c := range r.Cookies() {
+			values = append(values, c.Name+"="+c.Value)
+		}
+		cookies = append(cookies, strings.Join(values, ";"))
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL, "", "", "", false,
+		WithSessionCookie(&http.Cookie{Name: "mod_auth_openidc_session", Value: "sso"}),
+		WithSessionCookie(&http.Cookie{Name: "JSESSIONID", Value: "a4c"}),
+	)
+	assert.NilError(t, err)
+
+	ctx := context.Background()
+	assert.NilError(t, client.Login(ctx))
+	request, err := client.NewRequest(ctx, "GET", "/rest/latest/applications/app", nil)
+	assert.NilError(t, err)
+	response, err := client.Do(request)
+	assert.NilError(t, err)
+	assert.NilError(t, ReadA4CResponse(response, nil))
+
+	assert.DeepEqual(t, paths, []string{"/rest/latest/applications/app"})
+	assert.DeepEqual(t, cookies, []string{"mod_auth_openidc_session=sso;JSESSIONID=a4c"})
+}
+
+func TestWithCookieJar(t *testing.T) {
+	jar, err := cookiejar.New(nil)
+	assert.NilError(t, err)
+	client, err := NewClient("http://a4c.example.com", "", "", "", false, WithCookieJar(jar))
+	assert.NilError(t, err)
+	assert.Equal(t, client.(*a4cClient).client.Jar, http.CookieJar(jar))
+
+	// A nil jar keeps the default one so that session cookies could still be stored
+	client, err = NewClient("http://a4c.example.com", "", "", "", false, WithCookieJar(nil), WithSessionCookie(&http.Cookie{Name: "JSESSIONID", Value: "a4c"}))
+	assert.NilError(t, err)
+	assert.Assert(t, client.(*a4cClient).client.Jar != nil)
+}
+
+func TestWithToken_Forbidden(t *testing.T) {
+	var nbCalls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nbCalls++
+		assert.Assert(t, r.URL.Path != "/login", "unexpected login")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error":{"code":102,"message":"token expired"}}`))
+	}))
+	defer ts.Close()
+
+	for _, opt := range []ClientOption{WithToken("expired"), WithSessionCookie(&http.Cookie{Name: "JSESSIONID", Value: "expired"})} {
+		nbCalls = 0
+		client, err := NewClient(ts.URL, "", "", "", false, opt)
+		assert.NilError(t, err)
+
+		ctx := context.Background()
+		request, err := client.NewRequest(ctx, "GET", "/rest/latest/applications/app", nil)
+		assert.NilError(t, err)
+		response, err := client.Do(request)
+		assert.NilError(t, err)
+		err = ReadA4CResponse(response, nil)
+		assert.Assert(t, errors.Is(err, ErrForbidden))
+		assert.ErrorContains(t, err, "token expired")
+		assert.Equal(t, nbCalls, 1)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultProtectionTagKey is the default key of the tag protecting applications configured by WithDeletionProtection
+const DefaultProtectionTagKey = "a4c.protected"
+
+// ErrProtectedResource matches errors returned when an operation is refused on an application protected
+// by the tag configured by WithDeletionProtection, it may be checked using errors.Is.
+var ErrProtectedResource = errors.New("protected resource")
+
+// ProtectedResourceError is returned when an operation is refused on an application protected by the tag
+// configured by WithDeletionProtection.
+//
+// It matches ErrProtectedResource using errors.Is and may be retrieved from returned errors using errors.As.
+type ProtectedResourceError struct {
+	ApplicationID string
+	// Operation is the refused operation, either "delete" or "undeploy"
+	Operation string
+	// Tag is the protection tag carried by the application
+	Tag Tag
+}
+
+func (e *ProtectedResourceError) Error() string {
+	return fmt.Sprintf("refusing to %s application %q protected by tag %s=%s", e.Operation, e.ApplicationID, e.Tag.Key, e.Tag.Value)
+}
+
+// Is returns true if target is ErrProtectedResource
+func (e *ProtectedResourceError) Is(target error) bool {
+	return target == ErrProtectedResource
+}
+
+// checkDeletionProtection returns a *ProtectedResourceError if deletion protection is enabled
+// and the application carries the protection tag
+func (c *a4cClient) checkDeletionProtection(ctx context.Context, appID, operation string) error {
+	if c.protectionTag == nil {
+		return nil
+	}
+	application, err := c.applicationService.GetApplicationByID(ctx, appID)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to check deletion protection of application %q", appID)
+	}
+	for _, tag := range application.Tags {
+		if tag.Key == c.protectionTag.Key && strings.EqualFold(tag.Value, c.protectionTag.Value) {
+			return errors.WithStack(&ProtectedResourceError{ApplicationID: appID, Operation: operation, Tag: tag})
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestWithDeletionProtection(t *testing.T) {
+	var deletions []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m := regexp.MustCompile(`.*/applications/([^/]*)(/environments/env/deployment)?$`).FindStringSubmatch(r.URL.Path)
+		switch {
+		case m == nil:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		case r.Method == http.MethodGet:
+			tags := `[{"name":"owner","value":"team"}]`
+			if m[1] == "prod" {
+				tags = `[{"name":"a4c.protected","value":"TRUE"}]`
+			}
+			_, _ = w.Write([]byte(`{"data":{"id":"` + m[1] + `","tags":` + tags + `}}`))
+		case r.Method == http.MethodDelete:
+			deletions = append(deletions, m[0][len("/rest/latest"):])
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL, "", "", "", false, WithDeletionProtection("", ""))
+	assert.NilError(t, err)
+	ctx := context.Background()
+
+	err = client.ApplicationService().DeleteApplication(ctx, "prod")
+	assert.Assert(t, errors.Is(err, ErrProtectedResource))
+	var protectedErr *ProtectedResourceError
+	assert.Assert(t, errors.As(err, &protectedErr))
+	assert.DeepEqual(t, *protectedErr, ProtectedResourceError{ApplicationID: "prod", Operation: "delete", Tag: Tag{Key: "a4c.protected", Value: "TRUE"}})
+	err = client.DeploymentService().UndeployApplication(ctx, "prod", "env")
+	assert.Assert(t, errors.Is(err, ErrProtectedResource))
+
+	assert.NilError(t, client.DeploymentService().UndeployApplication(ctx, "dev", "env"))
+	assert.NilError(t, client.ApplicationService().DeleteApplication(ctx, "dev"))
+	assert.DeepEqual(t, deletions, []string{"/applications/dev/environments/env/deployment", "/applications/dev"})
+
+	// Protection is opt-in
+	client, err = NewClient(ts.URL, "", "", "", false)
+	assert.NilError(t, err)
+	assert.NilError(t, client.ApplicationService().DeleteApplication(ctx, "prod"))
+}
@@ -0,0 +1,173 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ApplicationDeployment describes an application to create and deploy with a Deployer
+type ApplicationDeployment struct {
+	AppName string
+	// Template is the name of the topology template the application is created from
+	Template string
+	// EnvName is the name of the environment to deploy, defaults to DefaultEnvironmentName
+	EnvName string
+	// Location is the name of the location to deploy the application on
+	Location string
+	// Inputs are deployment topology inputs values
+	Inputs map[string]interface{}
+}
+
+// ApplicationDeploymentResult is the result of the deployment of an application by a Deployer
+type ApplicationDeploymentResult struct {
+	AppID string
+	EnvID string
+	// Status is the deployment status of the environment once deployed, it is empty if the
+	// deployment could not be started or if the Deployer does not wait for deployments
+	Status string
+	// Steps are the timings of the steps of the deployment, see OperationBudget
+	Steps []BudgetStep
+}
+
+// Deployer creates, configures and deploys many applications concurrently.
+//
+// Each application is created from its template, its deployment topology inputs are set and it is deployed
+// on its location. Applications are identified by their name, which is also their ID in Alien4Cloud.
+type Deployer struct {
+	// Concurrency is the maximum number of applications processed concurrently, defaults to 8
+	Concurrency int
+	// Wait makes the Deployer wait for deployments to reach ApplicationDeployed, a deployment reaching
+	// ApplicationError is reported as a failure
+	Wait bool
+
+	applications ApplicationService
+	deployments  DeploymentService
+}
+
+// NewDeployer returns a Deployer creating and deploying applications using the given client
+func NewDeployer(client Client) *Deployer {
+	return &Deployer{
+		applications: client.ApplicationService(),
+		deployments:  client.DeploymentService(),
+	}
+}
+
+// Deploy creates, configures and deploys the given applications.
+//
+// Results are indexed by application name, they are available for applications that were created even when
+// their deployment failed. A *BulkError indexed by application name is returned holding errors of applications
+// that could not be deployed.
+// Remaining applications are not processed once the context is cancelled, the context error is reported for them.
+func (d *Deployer) Deploy(ctx context.Context, apps []ApplicationDeployment) (map[string]ApplicationDeploymentResult, error) {
+	byName := make(map[string]ApplicationDeployment, len(apps))
+	names := make([]string, 0, len(apps))
+	for _, app := range apps {
+		if _, ok := byName[app.AppName]; ok {
+			return nil, errors.Errorf("application %q is listed several times", app.AppName)
+		}
+		byName[app.AppName] = app
+		names = append(names, app.AppName)
+	}
+
+	concurrency := d.Concurrency
+	if concurrency <= 0 {
+		concurrency = bulkConcurrency
+	}
+	var lock sync.Mutex
+	results := make(map[string]ApplicationDeploymentResult, len(apps))
+	err := runBulkWithConcurrency(ctx, concurrency, names, func(ctx context.Context, name string) error {
+		result, err := d.deploy(ctx, byName[name])
+		if result.AppID != "" {
+			lock.Lock()
+			results[name] = result
+			lock.Unlock()
+		}
+		return err
+	})
+	return results, err
+}
+
+// Fractions of the remaining time allotted to the steps of an application deployment when the context has a deadline,
+// waiting for the deployment gets the remainder
+const (
+	deployerCreateBudget  = 0.1
+	deployerResolveBudget = 0.1
+	deployerInputsBudget  = 0.1
+	deployerDeployBudget  = 0.25
+)
+
+// deploy creates, configures and deploys an application, the returned result is partially filled on errors.
+//
+// When the context has a deadline it is split across the steps of the deployment using an OperationBudget.
+func (d *Deployer) deploy(ctx context.Context, app ApplicationDeployment) (result ApplicationDeploymentResult, err error) {
+	budget := NewOperationBudget(ctx)
+	defer func() {
+		result.Steps = budget.Steps()
+	}()
+
+	err = budget.Run(ctx, "create", deployerCreateBudget, func(ctx context.Context) error {
+		var err error
+		result.AppID, err = d.applications.CreateAppli(ctx, app.AppName, app.Template)
+		return err
+	})
+	if err != nil {
+		return result, err
+	}
+
+	envName := app.EnvName
+	if envName == "" {
+		envName = DefaultEnvironmentName
+	}
+	err = budget.Run(ctx, "resolve environment", deployerResolveBudget, func(ctx context.Context) error {
+		var err error
+		result.EnvID, err = d.applications.GetEnvironmentIDbyName(ctx, result.AppID, envName)
+		return err
+	})
+	if err != nil {
+		return result, err
+	}
+
+	if len(app.Inputs) > 0 {
+		err = budget.Run(ctx, "update inputs", deployerInputsBudget, func(ctx context.Context) error {
+			return d.deployments.UpdateDeploymentTopology(ctx, result.AppID, result.EnvID, UpdateDeploymentTopologyRequest{InputProperties: app.Inputs})
+		})
+		if err != nil {
+			return result, err
+		}
+	}
+
+	deployBudget := 1.0
+	if d.Wait {
+		deployBudget = deployerDeployBudget
+	}
+	err = budget.Run(ctx, "deploy", deployBudget, func(ctx context.Context) error {
+		return d.deployments.DeployApplication(ctx, result.AppID, result.EnvID, app.Location)
+	})
+	if err != nil || !d.Wait {
+		return result, err
+	}
+
+	err = budget.Run(ctx, "wait", 1, func(ctx context.Context) error {
+		var err error
+		result.Status, err = d.deployments.WaitUntilStateIsWithOptions(ctx, result.AppID, result.EnvID,
+			WaitUntilStateOptions{FailureStatuses: []string{ApplicationError}}, ApplicationDeployed)
+		return err
+	})
+	return result, err
+}
@@ -0,0 +1,109 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+type fakeDeployerApplications struct {
+	ApplicationService
+}
+
+func (f *fakeDeployerApplications) CreateAppli(ctx context.Context, appName string, appTemplate string) (string, error) {
+	if appTemplate == "unknown" {
+		return "", errors.New("unknown template")
+	}
+	return appName, nil
+}
+
+func (f *fakeDeployerApplications) GetEnvironmentIDbyName(ctx context.Context, appID string, envName string) (string, error) {
+	return appID + "-" + envName, nil
+}
+
+type fakeDeployerDeployments struct {
+	DeploymentService
+	lock   sync.Mutex
+	inputs map[string]map[string]interface{}
+}
+
+func (f *fakeDeployerDeployments) UpdateDeploymentTopology(ctx context.Context, appID, envID string, request UpdateDeploymentTopologyRequest) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.inputs[envID] = request.InputProperties
+	return nil
+}
+
+func (f *fakeDeployerDeployments) DeployApplication(ctx context.Context, appID string, envID string, location string) error {
+	if location == "" {
+		return errors.New("no location")
+	}
+	return nil
+}
+
+func (f *fakeDeployerDeployments) WaitUntilStateIsWithOptions(ctx context.Context, appID string, envID string, opts WaitUntilStateOptions, statuses ...string) (string, error) {
+	if appID == "failing" {
+		return ApplicationError, &UnexpectedStatusError{Status: ApplicationError}
+	}
+	return statuses[0], nil
+}
+
+func TestDeployer_Deploy(t *testing.T) {
+	deployments := &fakeDeployerDeployments{inputs: make(map[string]map[string]interface{})}
+	d := &Deployer{Concurrency: 2, Wait: true, applications: &fakeDeployerApplications{}, deployments: deployments}
+
+	results, err := d.Deploy(context.Background(), []ApplicationDeployment{
+		{AppName: "bench1", Template: "bench", Location: "openstack", Inputs: map[string]interface{}{"size": 1}},
+		{AppName: "bench2", Template: "bench", EnvName: "prod", Location: "openstack"},
+		{AppName: "failing", Template: "bench", Location: "openstack"},
+		{AppName: "noLocation", Template: "bench"},
+		{AppName: "noTemplate", Template: "unknown", Location: "openstack"},
+	})
+	// Steps timings are checked separately as they are not deterministic
+	steps := make(map[string][]string)
+	for name, result := range results {
+		for _, step := range result.Steps {
+			assert.Equal(t, step.Allotted, time.Duration(0), "context has no deadline")
+			steps[name] = append(steps[name], step.Name)
+		}
+		result.Steps = nil
+		results[name] = result
+	}
+	assert.DeepEqual(t, steps["bench1"], []string{"create", "resolve environment", "update inputs", "deploy", "wait"})
+	assert.DeepEqual(t, steps["noLocation"], []string{"create", "resolve environment", "deploy"})
+	assert.DeepEqual(t, results, map[string]ApplicationDeploymentResult{
+		"bench1":     {AppID: "bench1", EnvID: "bench1-Environment", Status: ApplicationDeployed},
+		"bench2":     {AppID: "bench2", EnvID: "bench2-prod", Status: ApplicationDeployed},
+		"failing":    {AppID: "failing", EnvID: "failing-Environment", Status: ApplicationError},
+		"noLocation": {AppID: "noLocation", EnvID: "noLocation-Environment"},
+	})
+	assert.DeepEqual(t, deployments.inputs, map[string]map[string]interface{}{"bench1-Environment": {"size": 1}})
+
+	var bulkErr *BulkError
+	assert.Assert(t, errors.As(err, &bulkErr))
+	assert.Equal(t, len(bulkErr.Errors), 3)
+	assert.ErrorContains(t, bulkErr.Errors["failing"], ApplicationError)
+	assert.ErrorContains(t, bulkErr.Errors["noLocation"], "no location")
+	assert.ErrorContains(t, bulkErr.Errors["noTemplate"], "unknown template")
+
+	_, err = d.Deploy(context.Background(), []ApplicationDeployment{{AppName: "bench1"}, {AppName: "bench1"}})
+	assert.ErrorContains(t, err, `application "bench1" is listed several times`)
+}
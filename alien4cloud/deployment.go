@@ -20,7 +20,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"mime/multipart"
 	"os"
 	"path/filepath"
 	"time"
@@ -41,20 +40,78 @@ type DeploymentService interface {
 	UpdateApplication(ctx context.Context, appID, envID string) error
 	// Updates inputs of a deployment topology
 	UpdateDeploymentTopology(ctx context.Context, appID, envID string, request UpdateDeploymentTopologyRequest) error
+	// Returns changes that would be applied to inputs of a deployment topology by updating it with the desired input values
+	ComputeInputsDiff(ctx context.Context, appID, envID string, desired map[string]interface{}) ([]InputChange, error)
+	// Copies default values of topology inputs not yet set into the deployment topology and returns names of updated inputs
+	ApplyInputsDefaults(ctx context.Context, appID, envID string) ([]string, error)
 	// Uploads an input artifact
 	UploadDeploymentInputArtifact(ctx context.Context, appID, envID, inputArtifact, filePath string) error
+	// Returns the artifacts currently bound to input artifacts of a deployment topology indexed by input artifact name
+	GetDeploymentInputArtifacts(ctx context.Context, appID, envID string) (map[string]InputArtifactBinding, error)
+	// Resets an uploaded input artifact of a deployment topology to its default artifact
+	ResetDeploymentInputArtifact(ctx context.Context, appID, envID, inputArtifact string) error
+	// Compares location resources requested by the deployment topology to the given numbers of available instances
+	// of location resources indexed by resource ID and returns a report
+	CheckResourceQuotas(ctx context.Context, appID, envID string, available map[string]int) (QuotaReport, error)
+	// Returns tasks of an execution
+	GetExecutionTasks(ctx context.Context, executionID string) ([]Task, error)
+	// Returns statuses of steps of an execution indexed by step name, only available for the last execution of a deployment
+	GetExecutionStepStatuses(ctx context.Context, executionID string) (map[string]string, error)
+	// Enables or disables the maintenance mode of a deployment
+	SetMaintenanceMode(ctx context.Context, appID, envID string, enabled bool) error
+	// Enables or disables the maintenance mode of a node instance of a deployment
+	SetInstanceMaintenanceMode(ctx context.Context, appID, envID, nodeName, instanceName string, enabled bool) error
+	// Returns a report attributing failed tasks of an execution to node templates and operations
+	GetExecutionFailureReport(ctx context.Context, appID, envID, executionID string) (FailureReport, error)
 	// Returns the deployment list for the given appID and envID
+	//
+	// All deployments of the environment are returned, paging through search results as needed.
 	GetDeploymentList(ctx context.Context, appID string, envID string) ([]Deployment, error)
+	// Searches deployments matching the given request
+	//
+	// It returns a page of deployments and the total number of deployments matching the request filters.
+	// That means that this number can be used to control pagination processing along with the From and Size fields
+	// of the DeploymentSearchRequest. The size defaults to DefaultPageSize and is capped to MaxPageSize.
+	// A TruncatedResultsError is then returned along with results if more resources match the request.
+	GetDeployments(ctx context.Context, searchRequest DeploymentSearchRequest) ([]Deployment, int, error)
+	// Returns an iterator over all deployments matching the given search request
+	DeploymentsIterator(ctx context.Context, searchRequest DeploymentSearchRequest) *DeploymentsIterator
+	// Returns application environments having an active deployment on the given orchestrator and optionally on the given location
+	GetEnvironmentsDeployedOn(ctx context.Context, orchestratorID, locationID string) ([]EnvironmentRef, error)
 	// Returns a deployment given its ID
 	GetDeployment(ctx context.Context, deploymentID string) (Deployment, error)
+	// Returns orchestrator-side identifiers of the active deployment of an application environment
+	GetOrchestratorDeploymentInfo(ctx context.Context, appID, envID string) (OrchestratorDeploymentInfo, error)
 	// Undeploys an application
 	UndeployApplication(ctx context.Context, appID string, envID string) error
+	// UndeployAllEnvironments undeploys all deployed environments of an application concurrently and waits until they are undeployed,
+	// returning results per environment
+	//
+	// A *BulkError indexed by environment ID is returned along with results if some environments could not be undeployed.
+	UndeployAllEnvironments(ctx context.Context, appID string, opts UndeployAllOptions) ([]UndeployResult, error)
+	// Deploys an application and waits until it is deployed or failed, returns the reached status
+	//
+	// An *UnexpectedStatusError is returned if the deployment failed.
+	DeployApplicationAndWait(ctx context.Context, appID, envID, location string, opts DeployAndWaitOptions) (string, error)
 	// WaitUntilStateIs Waits until the state of an Alien4Cloud application is one of the given statuses as parameter and returns the actual status.
 	WaitUntilStateIs(ctx context.Context, appID string, envID string, statuses ...string) (string, error)
+	// WaitUntilStateIsWithOptions is like WaitUntilStateIs but allows to fail fast on unexpected statuses,
+	// to bound the waiting duration and to be notified of observed statuses.
+	WaitUntilStateIsWithOptions(ctx context.Context, appID string, envID string, opts WaitUntilStateOptions, statuses ...string) (string, error)
 	// Returns current deployment status for the given applicationID and environmentID
 	GetDeploymentStatus(ctx context.Context, applicationID string, environmentID string) (string, error)
+	// Returns the status of a deployment given its ID
+	GetDeploymentStatusByID(ctx context.Context, deploymentID string) (string, error)
 	// Returns current deployment ID for the given applicationID and environmentID
 	GetCurrentDeploymentID(ctx context.Context, applicationID string, environmentID string) (string, error)
+	// Attaches annotations such as a commit SHA or a pipeline URL to a deployment, existing annotations with the same keys are replaced
+	//
+	// Annotations are stored as tags of the deployed application prefixed by DeploymentAnnotationTagPrefix and the deployment ID.
+	SetDeploymentAnnotations(ctx context.Context, deploymentID string, annotations map[string]string) error
+	// Returns annotations attached to a deployment using SetDeploymentAnnotations
+	GetDeploymentAnnotations(ctx context.Context, deploymentID string) (map[string]string, error)
+	// Removes the given annotations from a deployment, all annotations of the deployment are removed if no key is given
+	DeleteDeploymentAnnotations(ctx context.Context, deploymentID string, keys ...string) error
 	// Returns the node status for the given applicationID and environmentID and nodeName
 	GetNodeStatus(ctx context.Context, applicationID string, environmentID string, nodeName string) (string, error)
 	// Returns the output attributes of nodes in the given applicationID and environmentID
@@ -63,6 +120,11 @@ type DeploymentService interface {
 	GetAttributesValue(ctx context.Context, applicationID string, environmentID string, nodeName string, requestedAttributesName []string) (map[string]string, error)
 	// Returns the application deployment attributes for the specified instance of a node name
 	GetInstanceAttributesValue(ctx context.Context, applicationID string, environmentID string, nodeName, instanceName string, requestedAttributesName []string) (map[string]string, error)
+	// Calls the given callback each time the value of one of the given attributes changes on an instance of a node,
+	// until the given context is cancelled
+	//
+	// Attributes are polled at the given interval, or DefaultAttributesPollInterval if interval is zero.
+	WatchAttributes(ctx context.Context, appID, envID, nodeName string, attributeNames []string, interval time.Duration, callback AttributeChangeCallback) error
 
 	// Runs Alien4Cloud workflowName workflow for the given a4cAppID and a4cEnvID with input parameters
 	RunWorkflowWithParameters(ctx context.Context, a4cAppID string, a4cEnvID string, workflowName string, parameters map[string]interface{}, timeout time.Duration) (*Execution, error)
@@ -74,6 +136,10 @@ type DeploymentService interface {
 	// Runs a workflow asynchronously returning the execution id, results will be notified using the ExecutionCallback function.
 	// Cancelling the context cancels the function that monitor the execution
 	RunWorkflowAsync(ctx context.Context, a4cAppID string, a4cEnvID string, workflowName string, callback ExecutionCallback) (string, error)
+	// Runs a workflow asynchronously with input parameters returning the execution id, status transitions of workflow steps
+	// are notified using the WorkflowStepCallback function and results using the ExecutionCallback function.
+	// Cancelling the context cancels the function that monitor the execution
+	RunWorkflowWatch(ctx context.Context, a4cAppID string, a4cEnvID string, workflowName string, parameters map[string]interface{}, stepCallback WorkflowStepCallback, callback ExecutionCallback) (string, error)
 	// Returns the workflow execution for the given applicationID and environmentID
 	GetLastWorkflowExecution(ctx context.Context, applicationID string, environmentID string) (*WorkflowExecution, error)
 
@@ -83,6 +149,11 @@ type DeploymentService interface {
 	// - query allows to search a specific execution but may be empty
 	// - from and size allows to paginate results
 	GetExecutions(ctx context.Context, deploymentID, query string, from, size int) ([]Execution, FacetedSearchResult, error)
+	// Returns an iterator over executions in chronological order of their start date
+	//
+	// - deploymentID allows to iterate over executions of a specific deployment but may be empty
+	// - opts allows to filter executions, set the page size and resume from a checkpoint
+	ExecutionsIterator(ctx context.Context, deploymentID string, opts ExecutionsIteratorOptions) (*ExecutionsIterator, error)
 
 	// GetExecutionByID returns details of a given execution
 	// Returns an error if no execution with such ID was found
@@ -95,6 +166,33 @@ type DeploymentService interface {
 
 	// Cancels execution for given environmentID and executionID
 	CancelExecution(ctx context.Context, environmentID string, executionID string) error
+	// Resumes a failed execution from its failed steps
+	ResumeExecution(ctx context.Context, environmentID string, executionID string) error
+	// Runs again the workflow of an execution with the same parameters and returns the ID of the new execution
+	RelaunchExecution(ctx context.Context, environmentID string, executionID string) (string, error)
+	// Writes a zip archive gathering diagnostics of an execution (execution, step statuses, logs and deployment topology) to w
+	ExportExecutionDiagnostics(ctx context.Context, appID, envID, executionID string, w io.Writer) error
+
+	// Returns services that could be bound to the given node of a deployment topology
+	GetMatchingServices(ctx context.Context, appID, envID, nodeName string) ([]LocationResourceTemplate, error)
+	// Returns a map of node names to the location resources that could substitute them in the deployment topology
+	GetSubstitutionCandidates(ctx context.Context, appID, envID string) (map[string][]LocationResourceTemplate, error)
+	// Selects the location resource substituting the given node of a deployment topology
+	SetNodeSubstitution(ctx context.Context, appID, envID, nodeName, resourceID string) error
+	// Binds the given node of a deployment topology to an existing service
+	BindNodeToService(ctx context.Context, appID, envID, nodeName, serviceResourceID string) error
+	// Unbinds the given node of a deployment topology from the service it is bound to
+	UnbindNodeFromService(ctx context.Context, appID, envID, nodeName string) error
+	// Returns a map of node names to the location resource template currently matched by this node in the deployment topology
+	GetMatchedResources(ctx context.Context, appID, envID string) (map[string]LocationResourceTemplate, error)
+
+	// Returns custom commands (operations of non-standard interfaces) available on a deployed application
+	GetCustomCommands(ctx context.Context, appID, envID string) ([]CustomCommand, error)
+	// Returns workflows of the topology deployed on an application environment, including workflows injected at deployment time,
+	// telling which ones could be run right now
+	GetRuntimeWorkflows(ctx context.Context, appID, envID string) ([]RuntimeWorkflow, error)
+	// Runs a custom command on a deployed application and returns results per node instance
+	RunCustomCommand(ctx context.Context, appID, envID string, command CustomCommandRequest) (map[string]interface{}, error)
 }
 
 // ExecutionCallback is a function call by asynchronous operations when an execution reaches a terminal state
@@ -180,10 +278,14 @@ func (d *deploymentService) DeployApplication(ctx context.Context, appID string,
 		}
 	}
 	if locationID == "" {
-		// Return the list of possible locations names
+		// Return the list of possible locations names and why they are not ready
 		var locationNames []string
 		for _, locationMatch := range locationsMatch {
-			locationNames = append(locationNames, locationMatch.Location.Name)
+			name := locationMatch.Location.Name
+			if reasons := locationMatch.NotReadyReasons(); !locationMatch.Ready && !reasons.IsEmpty() {
+				name = fmt.Sprintf("%s (not ready: %s)", name, reasons)
+			}
+			locationNames = append(locationNames, name)
 		}
 		return errors.Errorf("Location %q not found in list of matching locations: %+v", location, locationNames)
 	}
@@ -218,8 +320,8 @@ func (d *deploymentService) DeployApplication(ctx context.Context, appID string,
 	// Deploy the application a4cApplicationDeployhRequestIn
 	appDeployBody, err := json.Marshal(
 		ApplicationDeployRequest{
-			envID,
-			appID,
+			ApplicationEnvironmentID: envID,
+			ApplicationID:            appID,
 		},
 	)
 	if err != nil {
@@ -235,12 +337,13 @@ func (d *deploymentService) DeployApplication(ctx context.Context, appID string,
 	if err != nil {
 		return errors.Wrap(err, "Unable to send a request to deploy the application")
 	}
+	setIdempotencyKeyHeader(request)
 	response, err = d.client.Do(request)
 	if err != nil {
-		return errors.Wrap(err, "Unable to send a request to deploy the application")
+		return wrapIdempotencyKey(ctx, errors.Wrap(err, "Unable to send a request to deploy the application"))
 	}
 	err = ReadA4CResponse(response, nil)
-	return errors.Wrap(err, "Unable to deploy the application")
+	return wrapIdempotencyKey(ctx, errors.Wrap(err, "Unable to deploy the application"))
 }
 
 // UpdateApplication updates an application with the latest topology version
@@ -267,6 +370,11 @@ func (d *deploymentService) UpdateApplication(ctx context.Context, appID, envID
 func (d *deploymentService) UpdateDeploymentTopology(ctx context.Context, appID, envID string,
 	upDepTopoRequest UpdateDeploymentTopologyRequest) error {
 
+	var err error
+	upDepTopoRequest.InputProperties, err = d.client.protectInputSecrets(ctx, appID, envID, upDepTopoRequest.InputProperties)
+	if err != nil {
+		return err
+	}
 	requestBody, _ := json.Marshal(upDepTopoRequest)
 	request, err := d.client.NewRequest(ctx, "PUT",
 		fmt.Sprintf("%s/applications/%s/environments/%s/deployment-topology", a4CRestAPIPrefix, appID, envID),
@@ -296,33 +404,11 @@ func (d *deploymentService) UploadDeploymentInputArtifact(ctx context.Context,
 	}
 	defer f.Close()
 
-	// TODO(loicalbertin) we may have an issue on large files as it will load the whole file in memory.
-	// We should consider using io.Pipe() to create a synchronous in-memory pipe.
-	// The tricky part will be to make it work with an expected io.ReadSeeker.
-	body := new(bytes.Buffer)
-	writer := multipart.NewWriter(body)
-	fName := filepath.Base(filePath)
-	part, err := writer.CreateFormFile("file", fName)
-	if err != nil {
-		return errors.Wrapf(err, "Failed to create from file for %s", fName)
-	}
-	_, err = io.Copy(part, f)
-	if err != nil {
-		return err
-	}
-
-	err = writer.Close()
-	if err != nil {
-		return err
-	}
-
-	request, err := d.client.NewRequest(ctx, "POST",
+	request, err := d.client.newMultipartStreamRequest(ctx,
 		fmt.Sprintf("%s/applications/%s/environments/%s/deployment-topology/inputArtifacts/%s/upload",
 			a4CRestAPIPrefix, appID, envID, inputArtifact),
-		bytes.NewReader(body.Bytes()),
+		filepath.Base(filePath), f, nil,
 	)
-	request.Header.Set("Content-Type", writer.FormDataContentType())
-
 	if err != nil {
 		return errors.Wrapf(err, "Unable to send a request to deployment topology for application %s", appID)
 	}
@@ -337,45 +423,19 @@ func (d *deploymentService) UploadDeploymentInputArtifact(ctx context.Context,
 
 // GetDeploymentList returns the deployment list for the given appID and envID
 func (d *deploymentService) GetDeploymentList(ctx context.Context, appID string, envID string) ([]Deployment, error) {
-
-	request, err := d.client.NewRequest(ctx,
-		"GET",
-		fmt.Sprintf("%s/deployments/search?environmentId=%s&from=0&query=", a4CRestAPIPrefix, envID),
-		nil,
-	)
-
-	if err != nil {
-		return nil, errors.Wrap(err, "Unable to send request to get deployment list")
-	}
-
-	var deploymentListResponse struct {
-		Data struct {
-			Data []struct {
-				Deployment Deployment
-			}
-			TotalResults int `json:"totalResults"`
-		} `json:"data"`
-	}
-	response, err := d.client.Do(request)
-	if err != nil {
-		return nil, errors.Wrapf(err, "Unable to get deployment list response for application %q environment %q", appID, envID)
-	}
-
-	err = ReadA4CResponse(response, &deploymentListResponse)
-	if err != nil {
-		return nil, errors.Wrapf(err, "Unable to get deployment list for application %q environment %q", appID, envID)
-	}
 	var deploymentList []Deployment
-
-	for _, dListData := range deploymentListResponse.Data.Data {
-		deploymentList = append(deploymentList, dListData.Deployment)
+	it := d.DeploymentsIterator(ctx, DeploymentSearchRequest{EnvironmentID: envID, Size: MaxPageSize})
+	for it.Next() {
+		deploymentList = append(deploymentList, it.Deployment())
 	}
-
-	return deploymentList, nil
+	return deploymentList, errors.Wrapf(it.Err(), "Unable to get deployment list for application %q environment %q", appID, envID)
 }
 
 // UndeployApplication Undeploy an application
 func (d *deploymentService) UndeployApplication(ctx context.Context, appID string, envID string) error {
+	if err := d.client.checkDeletionProtection(ctx, appID, "undeploy"); err != nil {
+		return err
+	}
 
 	request, err := d.client.NewRequest(ctx,
 		"DELETE",
@@ -386,38 +446,18 @@ func (d *deploymentService) UndeployApplication(ctx context.Context, appID strin
 	if err != nil {
 		return errors.Wrap(err, "Unable to send request to undeploy A4C application")
 	}
+	setIdempotencyKeyHeader(request)
 	response, err := d.client.Do(request)
 	if err != nil {
-		return errors.Wrap(err, "Unable to send request to undeploy A4C application")
+		return wrapIdempotencyKey(ctx, errors.Wrap(err, "Unable to send request to undeploy A4C application"))
 	}
 	err = ReadA4CResponse(response, nil)
-	return errors.Wrap(err, "Unable to undeploy A4C application")
+	return wrapIdempotencyKey(ctx, errors.Wrap(err, "Unable to undeploy A4C application"))
 }
 
 // WaitUntilStateIs Waits until the state of an Alien4Cloud application is one of the given statuses as parameter and returns the actual status.
 func (d *deploymentService) WaitUntilStateIs(ctx context.Context, appID string, envID string, statuses ...string) (string, error) {
-	if len(statuses) == 0 {
-		return "", errors.New("at least one status should be given")
-	}
-	for {
-		a4cStatus, err := d.GetDeploymentStatus(ctx, appID, envID)
-
-		if err != nil {
-			return "", errors.Wrapf(err, "Unable to get status from application %s", appID)
-		}
-
-		for _, status := range statuses {
-			if a4cStatus == status {
-				return a4cStatus, nil
-			}
-		}
-
-		select {
-		case <-ctx.Done():
-			return "", errors.Wrapf(ctx.Err(), "Unable to get status from application %s", appID)
-		case <-time.After(time.Second):
-		}
-	}
+	return d.WaitUntilStateIsWithOptions(ctx, appID, envID, WaitUntilStateOptions{}, statuses...)
 }
 
 // GetDeploymentStatus returns current deployment status for the given applicationID and environmentID
@@ -433,6 +473,12 @@ func (d *deploymentService) GetDeploymentStatus(ctx context.Context, application
 		return ApplicationUndeployed, err
 	}
 
+	status, err := d.GetDeploymentStatusByID(ctx, deploymentID)
+	return status, errors.Wrapf(err, "Unable to get deployment status for application %q environment %q", applicationID, environmentID)
+}
+
+// GetDeploymentStatusByID returns the status of a deployment given its ID
+func (d *deploymentService) GetDeploymentStatusByID(ctx context.Context, deploymentID string) (string, error) {
 	request, err := d.client.NewRequest(ctx,
 		"GET",
 		fmt.Sprintf("%s/deployments/%s/status", a4CRestAPIPrefix, deploymentID),
@@ -454,8 +500,7 @@ func (d *deploymentService) GetDeploymentStatus(ctx context.Context, application
 	}
 
 	err = ReadA4CResponse(response, &statusResponse)
-	return statusResponse.Data, errors.Wrapf(err, "Unable to get deployment status for application %q environment %q", applicationID, environmentID)
-
+	return statusResponse.Data, errors.Wrapf(err, "Unable to get status of deployment %q", deploymentID)
 }
 
 // GetCurrentDeploymentID returns current deployment ID for the given applicationID and environmentID
@@ -636,16 +681,17 @@ func (d *deploymentService) RunWorkflowAsyncWithParameters(ctx context.Context,
 	if err != nil {
 		return "", errors.Wrapf(err, "failed to run workflow %q on application %q, environment %q", workflowName, a4cAppID, a4cEnvID)
 	}
+	setIdempotencyKeyHeader(request)
 	var res struct {
 		Data string `json:"data"`
 	}
 	response, err := d.client.Do(request)
 	if err != nil {
-		return "", errors.Wrapf(err, "failed to read response on running workflow %q on application %q, environment %q", workflowName, a4cAppID, a4cEnvID)
+		return "", wrapIdempotencyKey(ctx, errors.Wrapf(err, "failed to read response on running workflow %q on application %q, environment %q", workflowName, a4cAppID, a4cEnvID))
 	}
 	err = ReadA4CResponse(response, &res)
 	if err != nil {
-		return "", errors.Wrapf(err, "failed to run workflow %q on application %q, environment %q", workflowName, a4cAppID, a4cEnvID)
+		return "", wrapIdempotencyKey(ctx, errors.Wrapf(err, "failed to run workflow %q on application %q, environment %q", workflowName, a4cAppID, a4cEnvID))
 	}
 
 	if res.Data == "" {
@@ -656,6 +702,10 @@ func (d *deploymentService) RunWorkflowAsyncWithParameters(ctx context.Context,
 	// now monitor workflow execution
 	go func() {
 		for {
+			if err := d.client.waitLeadership(ctx); err != nil {
+				callback(nil, classifyTimeout(fmt.Sprintf("wait for the end of execution %s", res.Data), err))
+				return
+			}
 			exec, err := d.GetExecutionByID(ctx, res.Data)
 			if err != nil {
 				callback(nil, err)
@@ -671,7 +721,7 @@ func (d *deploymentService) RunWorkflowAsyncWithParameters(ctx context.Context,
 
 			select {
 			case <-ctx.Done():
-				callback(nil, ctx.Err())
+				callback(nil, classifyTimeout(fmt.Sprintf("wait for the end of execution %s", res.Data), ctx.Err()))
 				return
 			case <-time.After(5 * time.Second):
 			}
@@ -716,6 +766,15 @@ func (d *deploymentService) GetLastWorkflowExecution(ctx context.Context, applic
 		return nil, errors.Wrap(err, "Unable to get current deployment ID")
 	}
 
+	wfExec, err := d.getWorkflowExecution(ctx, deploymentID)
+	if err != nil {
+		return wfExec, errors.Wrapf(err, "Unable to get workflow status of application '%s'", applicationID)
+	}
+	return wfExec, nil
+}
+
+// getWorkflowExecution returns the last workflow execution of a deployment
+func (d *deploymentService) getWorkflowExecution(ctx context.Context, deploymentID string) (*WorkflowExecution, error) {
 	request, err := d.client.NewRequest(ctx,
 		"GET",
 		fmt.Sprintf("%s/workflow_execution/%s", a4CRestAPIPrefix, deploymentID),
@@ -723,7 +782,7 @@ func (d *deploymentService) GetLastWorkflowExecution(ctx context.Context, applic
 	)
 
 	if err != nil {
-		return nil, errors.Wrapf(err, "Unable to get workflow status of application '%s'", applicationID)
+		return nil, errors.Wrapf(err, "Unable to create a request to get workflow execution of deployment '%s'", deploymentID)
 	}
 
 	var res struct {
@@ -0,0 +1,122 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DeploymentAnnotationTagPrefix is the prefix of application tags holding deployment annotations.
+//
+// Alien4Cloud does not support annotations on deployments so they are stored as tags of the deployed
+// application with a key made of this prefix, the deployment ID, a dot and the annotation key.
+const DeploymentAnnotationTagPrefix = "a4c-deployment-annotation."
+
+func deploymentAnnotationTagPrefix(deploymentID string) string {
+	return DeploymentAnnotationTagPrefix + deploymentID + "."
+}
+
+// deploymentApplicationID returns the ID of the application deployed by the given deployment
+func (d *deploymentService) deploymentApplicationID(ctx context.Context, deploymentID string) (string, error) {
+	deployment, err := d.GetDeployment(ctx, deploymentID)
+	if err != nil {
+		return "", err
+	}
+	if deployment.SourceID == "" {
+		return "", errors.Errorf("deployment %q is not related to an application", deploymentID)
+	}
+	return deployment.SourceID, nil
+}
+
+// SetDeploymentAnnotations attaches annotations to a deployment, existing annotations with the same keys are replaced
+func (d *deploymentService) SetDeploymentAnnotations(ctx context.Context, deploymentID string, annotations map[string]string) error {
+	keys := make([]string, 0, len(annotations))
+	for key := range annotations {
+		if key == "" {
+			return errors.Errorf("Unable to annotate deployment %q: annotation keys can't be empty", deploymentID)
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	appID, err := d.deploymentApplicationID(ctx, deploymentID)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to annotate deployment %q", deploymentID)
+	}
+	prefix := deploymentAnnotationTagPrefix(deploymentID)
+	for _, key := range keys {
+		err = d.client.applicationService.SetTagToApplication(ctx, appID, prefix+key, annotations[key])
+		if err != nil {
+			return errors.Wrapf(err, "Unable to set annotation %q on deployment %q", key, deploymentID)
+		}
+	}
+	return nil
+}
+
+// GetDeploymentAnnotations returns annotations attached to a deployment
+func (d *deploymentService) GetDeploymentAnnotations(ctx context.Context, deploymentID string) (map[string]string, error) {
+	appID, err := d.deploymentApplicationID(ctx, deploymentID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get annotations of deployment %q", deploymentID)
+	}
+	application, err := d.client.applicationService.GetApplicationByID(ctx, appID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get annotations of deployment %q", deploymentID)
+	}
+	if application == nil {
+		return nil, errors.Errorf("Unable to get annotations of deployment %q: application %q not found", deploymentID, appID)
+	}
+
+	prefix := deploymentAnnotationTagPrefix(deploymentID)
+	annotations := make(map[string]string)
+	for _, tag := range application.Tags {
+		if strings.HasPrefix(tag.Key, prefix) {
+			annotations[strings.TrimPrefix(tag.Key, prefix)] = tag.Value
+		}
+	}
+	return annotations, nil
+}
+
+// DeleteDeploymentAnnotations removes the given annotations from a deployment, all annotations of the deployment
+// are removed if no key is given
+func (d *deploymentService) DeleteDeploymentAnnotations(ctx context.Context, deploymentID string, keys ...string) error {
+	appID, err := d.deploymentApplicationID(ctx, deploymentID)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to delete annotations of deployment %q", deploymentID)
+	}
+	if len(keys) == 0 {
+		annotations, err := d.GetDeploymentAnnotations(ctx, deploymentID)
+		if err != nil {
+			return err
+		}
+		for key := range annotations {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+	}
+
+	prefix := deploymentAnnotationTagPrefix(deploymentID)
+	for _, key := range keys {
+		err = d.client.applicationService.DeleteTagFromApplication(ctx, appID, prefix+key)
+		if err != nil {
+			return errors.Wrapf(err, "Unable to delete annotation %q of deployment %q", key, deploymentID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_deploymentService_DeploymentAnnotations(t *testing.T) {
+	tags := map[string]string{"owner": "team-a", "a4c-deployment-annotation.other.sha": "0000"}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/rest/latest/deployments/dep1":
+			_, _ = w.Write([]byte(`{"data":{"deployment":{"id":"dep1","sourceId":"app","sourceType":"APPLICATION"}}}`))
+		case r.Method == "GET" && r.URL.Path == "/rest/latest/deployments/dep2":
+			_, _ = w.Write([]byte(`{"data":{"deployment":{"id":"dep2"}}}`))
+		case r.Method == "POST" && r.URL.Path == "/rest/latest/applications/app/tags":
+			var tag struct {
+				Key   string `json:"tagKey"`
+				Value string `json:"tagValue"`
+			}
+			assert.NilError(t, json.NewDecoder(r.Body).Decode(&tag))
+			tags[tag.Key] = tag.Value
+			_, _ = w.Write([]byte(`{}`))
+		case r.Method == "DELETE" && strings.HasPrefix(r.URL.Path, "/rest/latest/applications/app/tags/"):
+			delete(tags, strings.TrimPrefix(r.URL.Path, "/rest/latest/applications/app/tags/"))
+			_, _ = w.Write([]byte(`{}`))
+		case r.Method == "GET" && r.URL.Path == "/rest/latest/applications/app":
+			app := Application{ID: "app"}
+			for k, v := range tags {
+				app.Tags = append(app.Tags, Tag{Key: k, Value: v})
+			}
+			b, err := json.Marshal(struct {
+				Data Application `json:"data"`
+			}{app})
+			assert.NilError(t, err)
+			_, _ = w.Write(b)
+		default:
+			t.Errorf("Unexpected call for request %+v", r)
+		}
+	}))
+	defer ts.Close()
+
+	client := &a4cClient{client: http.DefaultClient, baseURL: ts.URL}
+	client.applicationService = &applicationService{client}
+	deploymentService := &deploymentService{client}
+	ctx := context.Background()
+
+	err := deploymentService.SetDeploymentAnnotations(ctx, "dep1", map[string]string{"sha": "abc123", "pipeline": "https://ci.example.com/1"})
+	assert.NilError(t, err)
+	assert.Equal(t, tags["a4c-deployment-annotation.dep1.sha"], "abc123")
+
+	annotations, err := deploymentService.GetDeploymentAnnotations(ctx, "dep1")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, annotations, map[string]string{"sha": "abc123", "pipeline": "https://ci.example.com/1"})
+
+	assert.NilError(t, deploymentService.DeleteDeploymentAnnotations(ctx, "dep1", "pipeline"))
+	annotations, err = deploymentService.GetDeploymentAnnotations(ctx, "dep1")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, annotations, map[string]string{"sha": "abc123"})
+
+	assert.NilError(t, deploymentService.DeleteDeploymentAnnotations(ctx, "dep1"))
+	annotations, err = deploymentService.GetDeploymentAnnotations(ctx, "dep1")
+	assert.NilError(t, err)
+	assert.Equal(t, len(annotations), 0)
+	assert.Equal(t, tags["owner"], "team-a")
+	assert.Equal(t, tags["a4c-deployment-annotation.other.sha"], "0000")
+
+	err = deploymentService.SetDeploymentAnnotations(ctx, "dep1", map[string]string{"": "value"})
+	assert.ErrorContains(t, err, "annotation keys can't be empty")
+
+	err = deploymentService.SetDeploymentAnnotations(ctx, "dep2", map[string]string{"sha": "abc123"})
+	assert.ErrorContains(t, err, "not related to an application")
+}
@@ -0,0 +1,158 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AttributeChange describes the change of the value of an attribute of a node instance
+type AttributeChange struct {
+	NodeName      string
+	InstanceName  string
+	AttributeName string
+	// Previous is the previous value of the attribute, empty when the attribute is seen for the first time
+	Previous string
+	// Current is the new value of the attribute, empty when the attribute or the instance disappeared
+	Current string
+}
+
+// AttributeChangeCallback is a function called when the value of a watched attribute changes.
+// If attributes could not be retrieved, the change is nil and err is not nil.
+type AttributeChangeCallback func(change *AttributeChange, err error)
+
+// DefaultAttributesPollInterval is the period at which attributes are retrieved by WatchAttributes
+// when no interval is given
+const DefaultAttributesPollInterval = 5 * time.Second
+
+// WatchAttributes calls the given callback each time the value of one of the given attributes changes
+// on an instance of a node, until the given context is cancelled.
+//
+// Attributes are retrieved by polling Alien4Cloud at the given interval, or DefaultAttributesPollInterval if
+// interval is zero. Attributes already set when the watch starts are notified with an empty previous value.
+// An error is returned only if the current attributes could not be retrieved, errors occurring afterwards
+// are given to the callback and polling continues.
+func (d *deploymentService) WatchAttributes(ctx context.Context, appID, envID, nodeName string, attributeNames []string,
+	interval time.Duration, callback AttributeChangeCallback) error {
+
+	if interval <= 0 {
+		interval = DefaultAttributesPollInterval
+	}
+	current, err := d.getNodeInstancesAttributes(ctx, appID, envID, nodeName, attributeNames)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		known := make(map[[2]string]string)
+		notifyAttributeChanges(nodeName, known, current, callback)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+			if d.client.waitLeadership(ctx) != nil {
+				return
+			}
+			current, err := d.getNodeInstancesAttributes(ctx, appID, envID, nodeName, attributeNames)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				callback(nil, err)
+				continue
+			}
+			notifyAttributeChanges(nodeName, known, current, callback)
+		}
+	}()
+	return nil
+}
+
+// getNodeInstancesAttributes returns the values of the given attributes of instances of a node
+// indexed by instance name and attribute name
+func (d *deploymentService) getNodeInstancesAttributes(ctx context.Context, appID, envID, nodeName string, attributeNames []string) (map[[2]string]string, error) {
+	request, err := d.client.NewRequest(ctx,
+		"GET",
+		fmt.Sprintf("%s/applications/%s/environments/%s/deployment/informations", a4CRestAPIPrefix, appID, envID),
+		nil,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot create a request to get attributes of node %q", nodeName)
+	}
+	var res Informations
+	response, err := d.client.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot send a request to get attributes of node %q", nodeName)
+	}
+	err = ReadA4CResponse(response, &res)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get attributes of node %q", nodeName)
+	}
+
+	values := make(map[[2]string]string)
+	for instanceName, instance := range res.Data[nodeName] {
+		for _, attributeName := range attributeNames {
+			if value := instance.Attributes[attributeName]; value != "" {
+				values[[2]string{instanceName, attributeName}] = value
+			}
+		}
+	}
+	return values, nil
+}
+
+// notifyAttributeChanges calls callback for each attribute value differing from the known values
+// which are updated accordingly, changes are notified sorted by instance and attribute names
+func notifyAttributeChanges(nodeName string, known, current map[[2]string]string, callback AttributeChangeCallback) {
+	keys := make([][2]string, 0, len(known)+len(current))
+	for key := range current {
+		keys = append(keys, key)
+	}
+	for key := range known {
+		if _, ok := current[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i][0] != keys[j][0] {
+			return keys[i][0] < keys[j][0]
+		}
+		return keys[i][1] < keys[j][1]
+	})
+
+	for _, key := range keys {
+		previous, value := known[key], current[key]
+		if previous == value {
+			continue
+		}
+		if value == "" {
+			delete(known, key)
+		} else {
+			known[key] = value
+		}
+		callback(&AttributeChange{
+			NodeName:      nodeName,
+			InstanceName:  key[0],
+			AttributeName: key[1],
+			Previous:      previous,
+			Current:       value,
+		}, nil)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_deploymentService_WatchAttributes(t *testing.T) {
+	var mu sync.Mutex
+	informations := `{"data":{"Compute":{"0":{"state":"creating","attributes":{}}}}}`
+	setInformations := func(data string) {
+		mu.Lock()
+		defer mu.Unlock()
+		informations = data
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/rest/latest/applications/app/environments/env/deployment/informations")
+		mu.Lock()
+		defer mu.Unlock()
+		_, _ = w.Write([]byte(informations))
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	received := make(chan AttributeChange, 10)
+	d := &deploymentService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	err := d.WatchAttributes(ctx, "app", "env", "Compute", []string{"ip_address", "public_ip_address"}, 10*time.Millisecond,
+		func(change *AttributeChange, err error) {
+			assert.NilError(t, err)
+			received <- *change
+		})
+	assert.NilError(t, err)
+
+	expectChanges := func(expected ...AttributeChange) {
+		t.Helper()
+		for _, change := range expected {
+			select {
+			case got := <-received:
+				assert.DeepEqual(t, got, change)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("timeout waiting for change %+v", change)
+			}
+		}
+	}
+
+	setInformations(`{"data":{"Compute":{"0":{"state":"started","attributes":{"ip_address":"10.0.0.1","state":"started"}}}}}`)
+	expectChanges(AttributeChange{NodeName: "Compute", InstanceName: "0", AttributeName: "ip_address", Current: "10.0.0.1"})
+
+	setInformations(`{"data":{"Compute":{
+		"0":{"state":"started","attributes":{"ip_address":"10.0.0.2"}},
+		"1":{"state":"started","attributes":{"public_ip_address":"1.2.3.4"}}}}}`)
+	expectChanges(
+		AttributeChange{NodeName: "Compute", InstanceName: "0", AttributeName: "ip_address", Previous: "10.0.0.1", Current: "10.0.0.2"},
+		AttributeChange{NodeName: "Compute", InstanceName: "1", AttributeName: "public_ip_address", Current: "1.2.3.4"},
+	)
+
+	setInformations(`{"data":{"Compute":{"0":{"state":"started","attributes":{"ip_address":"10.0.0.2"}}}}}`)
+	expectChanges(AttributeChange{NodeName: "Compute", InstanceName: "1", AttributeName: "public_ip_address", Previous: "1.2.3.4"})
+
+	select {
+	case change := <-received:
+		t.Fatalf("unexpected change %+v", change)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// StandardNodeInterface is the name of the TOSCA standard node lifecycle interface
+	StandardNodeInterface = types.StandardNodeInterface
+	// ConfigureRelationshipInterface is the name of the TOSCA standard relationship configure interface
+	ConfigureRelationshipInterface = types.ConfigureRelationshipInterface
+)
+
+// GetCustomCommands returns custom commands (operations of non-standard interfaces) available on a deployed application
+func (d *deploymentService) GetCustomCommands(ctx context.Context, appID, envID string) ([]CustomCommand, error) {
+	request, err := d.client.NewRequest(ctx,
+		"GET",
+		fmt.Sprintf("%s/runtime/%s/environment/%s/topology", a4CRestAPIPrefix, appID, envID),
+		nil,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot create a request to get runtime topology of application '%s' on environment '%s'", appID, envID)
+	}
+
+	var res struct {
+		Data struct {
+			Topology struct {
+				NodeTemplates map[string]NodeTemplate `json:"nodeTemplates"`
+			} `json:"topology"`
+			NodeTypes map[string]nodeType `json:"nodeTypes"`
+		} `json:"data"`
+	}
+	response, err := d.client.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot send a request to get runtime topology of application '%s' on environment '%s'", appID, envID)
+	}
+	err = ReadA4CResponse(response, &res)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot get runtime topology of application '%s' on environment '%s'", appID, envID)
+	}
+
+	nodeTypes := make(map[string]nodeType, len(res.Data.NodeTypes))
+	for _, nt := range res.Data.NodeTypes {
+		nodeTypes[nt.ElementID] = nt
+	}
+
+	var commands []CustomCommand
+	for nodeName, node := range res.Data.Topology.NodeTemplates {
+		for interfaceName, itf := range nodeTypes[node.Type].Interfaces {
+			if interfaceName == StandardNodeInterface || interfaceName == ConfigureRelationshipInterface {
+				continue
+			}
+			for operationName, op := range itf.Operations {
+				inputs := make([]string, 0, len(op.InputParameters))
+				for input := range op.InputParameters {
+					inputs = append(inputs, input)
+				}
+				sort.Strings(inputs)
+				commands = append(commands, CustomCommand{
+					NodeName:      nodeName,
+					InterfaceName: interfaceName,
+					OperationName: operationName,
+					Description:   op.Description,
+					Inputs:        inputs,
+				})
+			}
+		}
+	}
+
+	sort.Slice(commands, func(i, j int) bool {
+		if commands[i].NodeName != commands[j].NodeName {
+			return commands[i].NodeName < commands[j].NodeName
+		}
+		if commands[i].InterfaceName != commands[j].InterfaceName {
+			return commands[i].InterfaceName < commands[j].InterfaceName
+		}
+		return commands[i].OperationName < commands[j].OperationName
+	})
+	return commands, nil
+}
+
+// RunCustomCommand runs a custom command on a deployed application and returns results per node instance
+func (d *deploymentService) RunCustomCommand(ctx context.Context, appID, envID string, command CustomCommandRequest) (map[string]interface{}, error) {
+	body, err := json.Marshal(command)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot marshal a custom command request")
+	}
+
+	request, err := d.client.NewRequest(ctx,
+		"POST",
+		fmt.Sprintf("%s/applications/%s/environments/%s/operations", a4CRestAPIPrefix, appID, envID),
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot create a request to run custom command %s.%s on node '%s'", command.InterfaceName, command.OperationName, command.NodeName)
+	}
+
+	var res struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	response, err := d.client.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot send a request to run custom command %s.%s on node '%s'", command.InterfaceName, command.OperationName, command.NodeName)
+	}
+	err = ReadA4CResponse(response, &res)
+	return res.Data, errors.Wrapf(err, "Failed to run custom command %s.%s on node '%s' of application '%s' on environment '%s'",
+		command.InterfaceName, command.OperationName, command.NodeName, appID, envID)
+}
@@ -0,0 +1,89 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func newHTTPServerTestCustomCommands(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/runtime/app/environment/env/topology`).Match([]byte(r.URL.Path)):
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"data":{
+				"topology":{"nodeTemplates":{"Web":{"name":"Web","type":"org.WebServer"},"Compute":{"name":"Compute","type":"tosca.nodes.Compute"}}},
+				"nodeTypes":{
+					"org.WebServer:1.0.0":{"elementId":"org.WebServer","interfaces":{
+						"tosca.interfaces.node.lifecycle.Standard":{"operations":{"start":{}}},
+						"custom":{"operations":{"restart":{"description":"Restarts the server"},"reload":{"inputParameters":{"force":{},"delay":{}}}}}
+					}},
+					"tosca.nodes.Compute:1.0.0":{"elementId":"tosca.nodes.Compute"}
+				}}}`))
+		case regexp.MustCompile(`.*/applications/app/environments/env/operations`).Match([]byte(r.URL.Path)):
+			var req CustomCommandRequest
+			b, err := ioutil.ReadAll(r.Body)
+			assert.NilError(t, err)
+			assert.NilError(t, json.Unmarshal(b, &req))
+			if req.OperationName != "restart" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":{"code": 400,"message":"unknown operation"}}`))
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"data":{"0":"ok"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		}
+	}))
+}
+
+func Test_deploymentService_GetCustomCommands(t *testing.T) {
+	ts := newHTTPServerTestCustomCommands(t)
+	defer ts.Close()
+
+	d := &deploymentService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	commands, err := d.GetCustomCommands(context.Background(), "app", "env")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, commands, []CustomCommand{
+		{NodeName: "Web", InterfaceName: "custom", OperationName: "reload", Inputs: []string{"delay", "force"}},
+		{NodeName: "Web", InterfaceName: "custom", OperationName: "restart", Description: "Restarts the server", Inputs: []string{}},
+	})
+
+	_, err = d.GetCustomCommands(context.Background(), "unknown", "env")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func Test_deploymentService_RunCustomCommand(t *testing.T) {
+	ts := newHTTPServerTestCustomCommands(t)
+	defer ts.Close()
+
+	d := &deploymentService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	res, err := d.RunCustomCommand(context.Background(), "app", "env", CustomCommandRequest{NodeName: "Web", InterfaceName: "custom", OperationName: "restart"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, res, map[string]interface{}{"0": "ok"})
+
+	_, err = d.RunCustomCommand(context.Background(), "app", "env", CustomCommandRequest{NodeName: "Web", InterfaceName: "custom", OperationName: "unknown"})
+	assert.ErrorContains(t, err, "unknown operation")
+}
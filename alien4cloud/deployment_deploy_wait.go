@@ -0,0 +1,84 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DeployAndWaitOptions allows to configure DeploymentService.DeployApplicationAndWait()
+type DeployAndWaitOptions struct {
+	// Timeout is the maximum duration of the wait for the end of the deployment, independently of the context deadline.
+	// No timeout is applied if it is 0.
+	Timeout time.Duration
+	// Progress is an optional function called with each observed status
+	Progress func(status string)
+	// Logs is an optional function called with new logs of the deployment while waiting.
+	// Logs could be written using a logutil.TailWriter WriteLogs function for instance.
+	Logs func(logs []Log)
+}
+
+// DeployApplicationAndWait deploys an application and waits until its status is ApplicationDeployed or ApplicationError.
+//
+// The reached status is returned, an *UnexpectedStatusError is returned along with ApplicationError if the deployment failed.
+func (d *deploymentService) DeployApplicationAndWait(ctx context.Context, appID, envID, location string, opts DeployAndWaitOptions) (string, error) {
+	err := d.DeployApplication(ctx, appID, envID, location)
+	if err != nil {
+		return "", err
+	}
+
+	if opts.Logs == nil {
+		return d.WaitUntilStateIsWithOptions(ctx, appID, envID,
+			WaitUntilStateOptions{FailureStatuses: []string{ApplicationError}, Timeout: opts.Timeout, Progress: opts.Progress},
+			ApplicationDeployed)
+	}
+
+	// Logs are retrieved each time the status is polled, the wait is cancelled if logs could not be retrieved
+	waitCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var logIndex int
+	var logsErr error
+	fetchLogs := func() {
+		logs, nbLogs, err := d.client.logService.GetLogsOfApplication(ctx, appID, envID, LogFilter{}, logIndex)
+		if err != nil {
+			logsErr = errors.Wrapf(err, "Cannot get logs of deployment of application %q", appID)
+			cancel()
+			return
+		}
+		logIndex += nbLogs
+		if len(logs) > 0 {
+			opts.Logs(logs)
+		}
+	}
+	status, err := d.WaitUntilStateIsWithOptions(waitCtx, appID, envID,
+		WaitUntilStateOptions{
+			FailureStatuses: []string{ApplicationError},
+			Timeout:         opts.Timeout,
+			Progress: func(status string) {
+				if opts.Progress != nil {
+					opts.Progress(status)
+				}
+				fetchLogs()
+			},
+		},
+		ApplicationDeployed)
+	if logsErr != nil {
+		return "", logsErr
+	}
+	return status, err
+}
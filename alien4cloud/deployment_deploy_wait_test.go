@@ -0,0 +1,106 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_deploymentService_DeployApplicationAndWait(t *testing.T) {
+	var statusCalls int
+	allLogs := []Log{{ID: "1", Content: "creating"}, {ID: "2", Content: "starting"}, {ID: "3", Content: "failed"}}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/topologies/TopologyID/locations$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":[{"location":{"id":"locationID","name":"location","orchestratorId":"orchestratorID"}}]}`))
+		case regexp.MustCompile(`.*/applications/.*/environments/env/topology$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":"TopologyID"}`))
+		case regexp.MustCompile(`.*/deployment-topology/location-policies$`).Match([]byte(r.URL.Path)),
+			regexp.MustCompile(`.*/applications/deployment$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{}`))
+		case regexp.MustCompile(`.*/applications/.*/environments/env/active-deployment-monitored$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":{"deployment":{"id":"depID"}}}`))
+		case regexp.MustCompile(`.*/deployments/depID/status$`).Match([]byte(r.URL.Path)):
+			// The deployment ends at the second status check
+			statusCalls++
+			status := ApplicationDeploymentInProgress
+			if statusCalls >= 2 {
+				status = ApplicationError
+			}
+			_, _ = w.Write([]byte(`{"data":"` + status + `"}`))
+		case regexp.MustCompile(`.*/deployments/search$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":{"data":[{"deployment":{"id":"depID"}}],"totalResults":1}}`))
+		case regexp.MustCompile(`.*/deployment/logs/search$`).Match([]byte(r.URL.Path)):
+			var req logsSearchRequest
+			assert.NilError(t, json.NewDecoder(r.Body).Decode(&req))
+			// Logs are available progressively
+			available := allLogs[:1]
+			if statusCalls >= 2 {
+				available = allLogs
+			}
+			var res struct {
+				Data struct {
+					Data         []Log `json:"data"`
+					TotalResults int   `json:"totalResults"`
+				} `json:"data"`
+			}
+			res.Data.TotalResults = len(available)
+			if req.From < len(available) {
+				end := req.From + req.Size
+				if end > len(available) {
+					end = len(available)
+				}
+				res.Data.Data = available[req.From:end]
+			}
+			b, err := json.Marshal(&res)
+			assert.NilError(t, err)
+			_, _ = w.Write(b)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL, "", "", "", false)
+	assert.NilError(t, err)
+
+	var statuses []string
+	var logs []string
+	status, err := client.DeploymentService().DeployApplicationAndWait(context.Background(), "app", "env", "location", DeployAndWaitOptions{
+		Progress: func(status string) { statuses = append(statuses, status) },
+		Logs: func(l []Log) {
+			for _, log := range l {
+				logs = append(logs, log.Content)
+			}
+		},
+	})
+	assert.Equal(t, status, ApplicationError)
+	var unexpectedStatusErr *UnexpectedStatusError
+	assert.Assert(t, errors.As(err, &unexpectedStatusErr))
+	assert.DeepEqual(t, statuses, []string{ApplicationDeploymentInProgress, ApplicationError})
+	assert.DeepEqual(t, logs, []string{"creating", "starting", "failed"})
+
+	_, err = client.DeploymentService().DeployApplicationAndWait(context.Background(), "app", "env", "unknown", DeployAndWaitOptions{})
+	assert.ErrorContains(t, err, `Location "unknown" not found`)
+}
@@ -81,30 +81,48 @@ func (d *deploymentService) GetExecution(ctx context.Context, deploymentID, work
 }
 
 func (d *deploymentService) CancelExecution(ctx context.Context, environmentID string, executionID string) error {
+	return d.executionAction(ctx, "cancel", environmentID, executionID, nil)
+}
+
+// ResumeExecution resumes a failed execution from its failed steps
+func (d *deploymentService) ResumeExecution(ctx context.Context, environmentID string, executionID string) error {
+	return d.executionAction(ctx, "resume", environmentID, executionID, nil)
+}
+
+// RelaunchExecution runs again the workflow of an execution with the same parameters and returns the ID of the new execution
+func (d *deploymentService) RelaunchExecution(ctx context.Context, environmentID string, executionID string) (string, error) {
+	var res struct {
+		Data string `json:"data"`
+	}
+	err := d.executionAction(ctx, "relaunch", environmentID, executionID, &res)
+	return res.Data, err
+}
 
-	cancelExecBody, err := json.Marshal(
+// executionAction sends a request to apply an action (cancel, resume or relaunch) to an execution
+func (d *deploymentService) executionAction(ctx context.Context, action, environmentID, executionID string, res interface{}) error {
+	execBody, err := json.Marshal(
 		CancelExecRequest{
 			EnvironmentID: environmentID,
 			ExecutionID:   executionID,
 		},
 	)
 	if err != nil {
-		return errors.Wrap(err, "Cannot marshal a cancelExecRequest structure")
+		return errors.Wrapf(err, "Cannot marshal a request to %s an execution", action)
 	}
 
 	request, err := d.client.NewRequest(ctx,
 		"POST",
-		fmt.Sprintf("%s/executions/cancel", a4CRestAPIPrefix),
-		bytes.NewReader(cancelExecBody))
+		fmt.Sprintf("%s/executions/%s", a4CRestAPIPrefix, action),
+		bytes.NewReader(execBody))
 
 	if err != nil {
-		return errors.Wrapf(err, "Failed to cancel execution for execution '%s' on environment '%s'", executionID, environmentID)
+		return errors.Wrapf(err, "Failed to %s execution for execution '%s' on environment '%s'", action, executionID, environmentID)
 	}
 
 	response, err := d.client.Do(request)
 	if err != nil {
-		return errors.Wrapf(err, "Failed to cancel execution for execution '%s' on environment '%s'", executionID, environmentID)
+		return errors.Wrapf(err, "Failed to %s execution for execution '%s' on environment '%s'", action, executionID, environmentID)
 	}
-	err = ReadA4CResponse(response, nil)
-	return errors.Wrapf(err, "Failed to cancel execution for execution '%s' on environment '%s'", executionID, environmentID)
+	err = ReadA4CResponse(response, res)
+	return errors.Wrapf(err, "Failed to %s execution for execution '%s' on environment '%s'", action, executionID, environmentID)
 }
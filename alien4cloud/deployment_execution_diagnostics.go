@@ -0,0 +1,97 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ExportExecutionDiagnostics writes to w a zip archive gathering everything related to an execution,
+// typically to be attached to an incident ticket. The archive contains:
+//   - execution.json: the execution
+//   - workflow_execution.json: steps statuses and instances of the execution, only if the execution
+//     is the last one of its deployment as Alien4Cloud does not keep them for previous executions
+//   - logs.json and logs.txt: logs of the execution, respectively raw and formatted as text
+//   - deployment_topology.json: the current deployment topology of the application environment
+func (d *deploymentService) ExportExecutionDiagnostics(ctx context.Context, appID, envID, executionID string, w io.Writer) error {
+	execution, err := d.GetExecutionByID(ctx, executionID)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to get execution %q", executionID)
+	}
+
+	workflowExecution, err := d.getWorkflowExecution(ctx, execution.DeploymentID)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to get steps of execution %q", executionID)
+	}
+
+	logs, _, err := d.client.logService.GetLogsOfApplication(ctx, appID, envID, LogFilter{ExecutionID: []string{executionID}}, 0)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to get logs of execution %q", executionID)
+	}
+
+	topology, err := d.client.applicationService.GetDeploymentTopology(ctx, appID, envID)
+	if err != nil {
+		return err
+	}
+
+	archive := zip.NewWriter(w)
+	err = writeZipJSON(archive, "execution.json", execution)
+	if err != nil {
+		return err
+	}
+	if workflowExecution.Execution.ID == executionID {
+		err = writeZipJSON(archive, "workflow_execution.json", workflowExecution)
+		if err != nil {
+			return err
+		}
+	}
+	err = writeZipJSON(archive, "logs.json", logs)
+	if err != nil {
+		return err
+	}
+	f, err := archive.Create("logs.txt")
+	if err != nil {
+		return errors.Wrap(err, "Failed to add logs.txt to diagnostics archive")
+	}
+	for _, l := range logs {
+		_, err = fmt.Fprintf(f, "%s [%s] %s %s.%s: %s\n", l.Timestamp.Format(time.RFC3339Nano), l.Level, l.NodeID, l.InterfaceName, l.OperationName, l.Content)
+		if err != nil {
+			return errors.Wrap(err, "Failed to write logs.txt to diagnostics archive")
+		}
+	}
+	err = writeZipJSON(archive, "deployment_topology.json", topology)
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(archive.Close(), "Failed to write diagnostics archive")
+}
+
+// writeZipJSON adds a file named name holding the indented JSON representation of v to a zip archive
+func writeZipJSON(archive *zip.Writer, name string, v interface{}) error {
+	f, err := archive.Create(name)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to add %s to diagnostics archive", name)
+	}
+	encoder := json.NewEncoder(f)
+	encoder.SetIndent("", "  ")
+	return errors.Wrapf(encoder.Encode(v), "Failed to write %s to diagnostics archive", name)
+}
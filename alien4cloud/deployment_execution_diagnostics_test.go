@@ -0,0 +1,97 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_deploymentService_ExportExecutionDiagnostics(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/executions/(execID|oldExecID)$`).Match([]byte(r.URL.Path)):
+			id := regexp.MustCompile(`.*/executions/(.*)$`).FindStringSubmatch(r.URL.Path)[1]
+			_, _ = w.Write([]byte(`{"data":{"id":"` + id + `","deploymentId":"depID","workflowName":"install","status":"FAILED"}}`))
+		case regexp.MustCompile(`.*/workflow_execution/depID$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":{"execution":{"id":"execID","status":"FAILED"},"stepStatus":{"create":"COMPLETED_WITH_ERROR"}}}`))
+		case regexp.MustCompile(`.*/deployments/search$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":{"data":[{"deployment":{"id":"depID"}}],"totalResults":1}}`))
+		case regexp.MustCompile(`.*/deployment/logs/search$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":{"data":[{"level":"ERROR","timestamp":1620659921608,"nodeId":"Compute","interfaceName":"standard","operationName":"create","content":"failed"}],"totalResults":1}}`))
+		case regexp.MustCompile(`.*/applications/app/environments/env/deployment-topology$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":{"topology":{"archiveName":"app"}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL, "", "", "", false)
+	assert.NilError(t, err)
+	d := client.DeploymentService()
+
+	readArchive := func(b []byte) map[string]string {
+		t.Helper()
+		r, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+		assert.NilError(t, err)
+		files := make(map[string]string)
+		for _, f := range r.File {
+			rc, err := f.Open()
+			assert.NilError(t, err)
+			content, err := ioutil.ReadAll(rc)
+			assert.NilError(t, err)
+			rc.Close()
+			files[f.Name] = string(content)
+		}
+		return files
+	}
+	names := func(files map[string]string) []string {
+		var res []string
+		for name := range files {
+			res = append(res, name)
+		}
+		sort.Strings(res)
+		return res
+	}
+
+	var buf bytes.Buffer
+	assert.NilError(t, d.ExportExecutionDiagnostics(context.Background(), "app", "env", "execID", &buf))
+	files := readArchive(buf.Bytes())
+	assert.DeepEqual(t, names(files), []string{"deployment_topology.json", "execution.json", "logs.json", "logs.txt", "workflow_execution.json"})
+	assert.Assert(t, strings.Contains(files["execution.json"], `"status": "FAILED"`))
+	assert.Assert(t, strings.Contains(files["workflow_execution.json"], `"create": "COMPLETED_WITH_ERROR"`))
+	assert.Assert(t, strings.Contains(files["logs.txt"], "[ERROR] Compute standard.create: failed"))
+	assert.Assert(t, strings.Contains(files["deployment_topology.json"], `"archiveName": "app"`))
+
+	// Steps of previous executions are not available
+	buf.Reset()
+	assert.NilError(t, d.ExportExecutionDiagnostics(context.Background(), "app", "env", "oldExecID", &buf))
+	assert.DeepEqual(t, names(readArchive(buf.Bytes())), []string{"deployment_topology.json", "execution.json", "logs.json", "logs.txt"})
+
+	err = d.ExportExecutionDiagnostics(context.Background(), "app", "env", "unknown", &buf)
+	assert.ErrorContains(t, err, `Unable to get execution "unknown"`)
+}
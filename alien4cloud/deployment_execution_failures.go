@@ -0,0 +1,133 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// GetExecutionFailureReport returns a report attributing failed tasks of an execution to node templates and operations.
+//
+// When the execution is the last one of its deployment, failures are the workflow step instances having failed tasks
+// and error logs of their node instances are joined to them. Alien4Cloud does not keep step instances of previous
+// executions, failures are then computed from error logs of the execution grouped by node instance and operation.
+// The report has no failure if the execution has no failed tasks.
+func (d *deploymentService) GetExecutionFailureReport(ctx context.Context, appID, envID, executionID string) (FailureReport, error) {
+	execution, err := d.GetExecutionByID(ctx, executionID)
+	if err != nil {
+		return FailureReport{}, errors.Wrapf(err, "Unable to get execution %q", executionID)
+	}
+	report := FailureReport{ExecutionID: executionID, WorkflowName: execution.WorkflowName}
+	if !execution.HasFailedTasks {
+		return report, nil
+	}
+
+	workflowExecution, err := d.getWorkflowExecution(ctx, execution.DeploymentID)
+	if err != nil {
+		return report, errors.Wrapf(err, "Unable to get steps of execution %q", executionID)
+	}
+
+	logs, _, err := d.client.logService.GetLogsOfApplication(ctx, appID, envID, LogFilter{ExecutionID: []string{executionID}}, 0)
+	if err != nil {
+		return report, errors.Wrapf(err, "Unable to get logs of execution %q", executionID)
+	}
+	var errorLogs []Log
+	for _, l := range logs {
+		if strings.ToLower(l.Level) == LogLevelError {
+			errorLogs = append(errorLogs, l)
+		}
+	}
+
+	if workflowExecution.Execution.ID == executionID {
+		report.Failures = failuresFromSteps(workflowExecution, errorLogs)
+	}
+	if len(report.Failures) == 0 {
+		report.Failures = failuresFromLogs(errorLogs)
+	}
+	return report, nil
+}
+
+// failuresFromSteps returns failures of step instances having failed tasks, joined with error logs of their node instance
+func failuresFromSteps(workflowExecution *WorkflowExecution, errorLogs []Log) []TaskFailure {
+	stepIDs := make([]string, 0, len(workflowExecution.StepInstances))
+	for stepID := range workflowExecution.StepInstances {
+		stepIDs = append(stepIDs, stepID)
+	}
+	sort.Strings(stepIDs)
+	var failures []TaskFailure
+	for _, stepID := range stepIDs {
+		for _, instance := range workflowExecution.StepInstances[stepID] {
+			if !instance.HasFailedTasks {
+				continue
+			}
+			failure := TaskFailure{
+				StepID:       stepID,
+				NodeID:       instance.NodeId,
+				InstanceID:   instance.InstanceId,
+				TargetNodeID: instance.TargetNodeId,
+			}
+			// Step instances operation names are fully qualified by the interface name
+			if i := strings.LastIndex(instance.OperationName, "."); i >= 0 {
+				failure.InterfaceName = instance.OperationName[:i]
+				failure.OperationName = instance.OperationName[i+1:]
+			} else {
+				failure.OperationName = instance.OperationName
+			}
+			for _, l := range errorLogs {
+				if l.NodeID != failure.NodeID || (l.InstanceID != "" && failure.InstanceID != "" && l.InstanceID != failure.InstanceID) {
+					continue
+				}
+				if l.OperationName != "" && !strings.EqualFold(l.OperationName, failure.OperationName) {
+					continue
+				}
+				if failure.InterfaceName == "" {
+					failure.InterfaceName = l.InterfaceName
+				}
+				failure.Logs = append(failure.Logs, l)
+			}
+			failures = append(failures, failure)
+		}
+	}
+	return failures
+}
+
+// failuresFromLogs groups error logs attributed to a node by node instance and operation
+func failuresFromLogs(errorLogs []Log) []TaskFailure {
+	var failures []TaskFailure
+	index := make(map[[4]string]int)
+	for _, l := range errorLogs {
+		if l.NodeID == "" {
+			continue
+		}
+		key := [4]string{l.NodeID, l.InstanceID, l.InterfaceName, l.OperationName}
+		i, ok := index[key]
+		if !ok {
+			i = len(failures)
+			index[key] = i
+			failures = append(failures, TaskFailure{
+				NodeID:        l.NodeID,
+				InstanceID:    l.InstanceID,
+				InterfaceName: l.InterfaceName,
+				OperationName: l.OperationName,
+			})
+		}
+		failures[i].Logs = append(failures[i].Logs, l)
+	}
+	return failures
+}
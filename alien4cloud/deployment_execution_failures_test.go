@@ -0,0 +1,89 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_deploymentService_GetExecutionFailureReport(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/executions/(execID|oldExecID|okExecID)$`).Match([]byte(r.URL.Path)):
+			id := regexp.MustCompile(`.*/executions/(.*)$`).FindStringSubmatch(r.URL.Path)[1]
+			status, hasFailedTasks := "FAILED", "true"
+			if id == "okExecID" {
+				status, hasFailedTasks = "SUCCEEDED", "false"
+			}
+			_, _ = w.Write([]byte(`{"data":{"id":"` + id + `","deploymentId":"depID","workflowName":"install","status":"` + status + `","hasFailedTasks":` + hasFailedTasks + `}}`))
+		case regexp.MustCompile(`.*/workflow_execution/depID$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":{"execution":{"id":"execID","status":"FAILED"},"stepInstances":{
+				"DB_create":[{"nodeId":"DB","instanceId":"0","operationName":"tosca.interfaces.node.lifecycle.Standard.create","hasFailedTasks":true}],
+				"Web_create":[{"nodeId":"Web","instanceId":"0","operationName":"tosca.interfaces.node.lifecycle.Standard.create"}],
+				"Web_configure":[
+					{"nodeId":"Web","instanceId":"0","targetNodeId":"DB","operationName":"tosca.interfaces.relationship.Configure.pre_configure_source"},
+					{"nodeId":"Web","instanceId":"1","targetNodeId":"DB","operationName":"tosca.interfaces.relationship.Configure.pre_configure_source","hasFailedTasks":true}]}}}`))
+		case regexp.MustCompile(`.*/deployments/search$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":{"data":[{"deployment":{"id":"depID"}}],"totalResults":1}}`))
+		case regexp.MustCompile(`.*/deployment/logs/search$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":{"data":[
+				{"id":"1","level":"INFO","nodeId":"DB","instanceId":"0","interfaceName":"standard","operationName":"create","content":"creating"},
+				{"id":"2","level":"ERROR","nodeId":"DB","instanceId":"0","interfaceName":"standard","operationName":"create","content":"disk full"},
+				{"id":"3","level":"ERROR","nodeId":"Web","instanceId":"1","interfaceName":"configure","operationName":"pre_configure_source","content":"connection refused"},
+				{"id":"4","level":"ERROR","content":"workflow failed"}],"totalResults":4}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL, "", "", "", false)
+	assert.NilError(t, err)
+	d := client.DeploymentService()
+	ctx := context.Background()
+
+	dbLog := Log{ID: "2", Level: "ERROR", NodeID: "DB", InstanceID: "0", InterfaceName: "standard", OperationName: "create", Content: "disk full"}
+	webLog := Log{ID: "3", Level: "ERROR", NodeID: "Web", InstanceID: "1", InterfaceName: "configure", OperationName: "pre_configure_source", Content: "connection refused"}
+
+	report, err := d.GetExecutionFailureReport(ctx, "app", "env", "execID")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, report, FailureReport{ExecutionID: "execID", WorkflowName: "install", Failures: []TaskFailure{
+		{StepID: "DB_create", NodeID: "DB", InstanceID: "0", InterfaceName: "tosca.interfaces.node.lifecycle.Standard", OperationName: "create", Logs: []Log{dbLog}},
+		{StepID: "Web_configure", NodeID: "Web", InstanceID: "1", TargetNodeID: "DB", InterfaceName: "tosca.interfaces.relationship.Configure", OperationName: "pre_configure_source", Logs: []Log{webLog}},
+	}})
+	assert.DeepEqual(t, report.FailedNodes(), []string{"DB", "Web"})
+
+	// Step instances of previous executions are not available, failures are computed from logs
+	report, err = d.GetExecutionFailureReport(ctx, "app", "env", "oldExecID")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, report, FailureReport{ExecutionID: "oldExecID", WorkflowName: "install", Failures: []TaskFailure{
+		{NodeID: "DB", InstanceID: "0", InterfaceName: "standard", OperationName: "create", Logs: []Log{dbLog}},
+		{NodeID: "Web", InstanceID: "1", InterfaceName: "configure", OperationName: "pre_configure_source", Logs: []Log{webLog}},
+	}})
+
+	report, err = d.GetExecutionFailureReport(ctx, "app", "env", "okExecID")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, report, FailureReport{ExecutionID: "okExecID", WorkflowName: "install"})
+
+	_, err = d.GetExecutionFailureReport(ctx, "app", "env", "unknown")
+	assert.ErrorContains(t, err, `Unable to get execution "unknown"`)
+}
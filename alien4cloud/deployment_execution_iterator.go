@@ -0,0 +1,207 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/base64"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ExecutionsIteratorOptions allows to configure an ExecutionsIterator
+type ExecutionsIteratorOptions struct {
+	// Query allows to search specific executions but may be empty
+	Query string
+	// PageSize is the number of executions retrieved per request, defaults to DefaultPageSize
+	// and is capped to MaxPageSize
+	PageSize int
+	// Checkpoint is a token returned by ExecutionsIterator.Checkpoint() allowing to resume
+	// the iteration right after the execution returned when this token was computed
+	Checkpoint string
+}
+
+// ExecutionsIterator iterates over executions in chronological order of their start date,
+// executions having the same start date being ordered by ID.
+//
+// Executions search results are not returned by Alien4Cloud in chronological order, so all executions
+// matching the search are retrieved page by page on the first call to Next and then sorted.
+// Executions created after this first call are not returned, they will be returned by an iterator
+// resumed from a checkpoint.
+//
+// Typical usage is:
+//
+//	it, err := client.DeploymentService().ExecutionsIterator(ctx, deploymentID, opts)
+//	if err != nil {
+//		return err
+//	}
+//	for it.Next() {
+//		exec := it.Execution()
+//		// ...
+//		checkpoint := it.Checkpoint()
+//	}
+//	return it.Err()
+type ExecutionsIterator struct {
+	ctx          context.Context
+	d            *deploymentService
+	deploymentID string
+	query        string
+	pageSize     int
+
+	// after is the position of the last returned execution, only executions after it are returned
+	after   *executionPosition
+	fetched bool
+	page    []Execution
+	current Execution
+	err     error
+}
+
+// executionPosition is the position of an execution in the chronological order of executions
+type executionPosition struct {
+	startDate time.Time
+	id        string
+}
+
+func (p executionPosition) before(e Execution) bool {
+	if !p.startDate.Equal(e.StartDate.Time) {
+		return p.startDate.Before(e.StartDate.Time)
+	}
+	return p.id < e.ID
+}
+
+// ExecutionsIterator returns an iterator over executions in chronological order of their start date
+func (d *deploymentService) ExecutionsIterator(ctx context.Context, deploymentID string, opts ExecutionsIteratorOptions) (*ExecutionsIterator, error) {
+	it := &ExecutionsIterator{
+		ctx:          ctx,
+		d:            d,
+		deploymentID: deploymentID,
+		query:        opts.Query,
+		pageSize:     paginate(SearchRequest{Size: opts.PageSize}).Size,
+	}
+	if opts.Checkpoint != "" {
+		after, err := parseExecutionsCheckpoint(opts.Checkpoint)
+		if err != nil {
+			return nil, err
+		}
+		it.after = &after
+	}
+	return it, nil
+}
+
+// Next advances the iterator to the next execution, which will then be available through the Execution method.
+// It returns false when the iteration stops, either by reaching the end or an error.
+// After Next returns false, the Err method will return any error that occurred during iteration.
+func (it *ExecutionsIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if !it.fetched {
+		it.err = it.fetch()
+		if it.err != nil {
+			return false
+		}
+		it.fetched = true
+	}
+	if len(it.page) == 0 {
+		return false
+	}
+	it.current = it.page[0]
+	it.page = it.page[1:]
+	it.after = &executionPosition{startDate: it.current.StartDate.Time, id: it.current.ID}
+	return true
+}
+
+// Execution returns the current execution
+func (it *ExecutionsIterator) Execution() Execution {
+	return it.current
+}
+
+// Err returns the error, if any, that was encountered during iteration
+func (it *ExecutionsIterator) Err() error {
+	return it.err
+}
+
+// Checkpoint returns a token allowing to resume the iteration right after the current execution
+// using ExecutionsIteratorOptions.Checkpoint.
+//
+// If no execution was returned yet, the checkpoint the iterator was created with is returned,
+// it is empty if the iteration started from the oldest execution.
+func (it *ExecutionsIterator) Checkpoint() string {
+	if it.after == nil {
+		return ""
+	}
+	var startDate string
+	if !it.after.startDate.IsZero() {
+		startDate = strconv.FormatInt(it.after.startDate.UnixNano(), 10)
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(startDate + ":" + it.after.id))
+}
+
+// fetch retrieves all executions after the iterator position and sorts them chronologically.
+//
+// Pages are requested until a page shorter than the page size is returned. Executions created
+// while paging shift results and may be returned twice, they are deduplicated by ID.
+func (it *ExecutionsIterator) fetch() error {
+	seen := make(map[string]struct{})
+	var execs []Execution
+	for from := 0; ; {
+		page, _, err := it.d.GetExecutions(it.ctx, it.deploymentID, it.query, from, it.pageSize)
+		if err != nil {
+			return err
+		}
+		for _, e := range page {
+			if _, ok := seen[e.ID]; ok {
+				continue
+			}
+			seen[e.ID] = struct{}{}
+			if it.after == nil || it.after.before(e) {
+				execs = append(execs, e)
+			}
+		}
+		if len(page) < it.pageSize {
+			break
+		}
+		from += len(page)
+	}
+	sort.Slice(execs, func(i, j int) bool {
+		return executionPosition{startDate: execs[i].StartDate.Time, id: execs[i].ID}.before(execs[j])
+	})
+	it.page = execs
+	return nil
+}
+
+func parseExecutionsCheckpoint(checkpoint string) (executionPosition, error) {
+	b, err := base64.RawURLEncoding.DecodeString(checkpoint)
+	if err != nil {
+		return executionPosition{}, errors.Wrap(err, "invalid executions checkpoint")
+	}
+	parts := strings.SplitN(string(b), ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return executionPosition{}, errors.Errorf("invalid executions checkpoint %q", checkpoint)
+	}
+	position := executionPosition{id: parts[1]}
+	if parts[0] != "" {
+		nanos, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			return executionPosition{}, errors.Errorf("invalid executions checkpoint %q", checkpoint)
+		}
+		position.startDate = time.Unix(0, nanos)
+	}
+	return position, nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+// newHTTPServerTestExecutionsSearch returns a server searching executions the way Alien4Cloud does,
+// from the most recent to the oldest one. The returned function adds a new execution.
+// If afterSearch is not nil, it is called after each search request.
+func newHTTPServerTestExecutionsSearch(t *testing.T, nbExecs int, afterSearch func(add func())) (*httptest.Server, func()) {
+	var lock sync.Mutex
+	var execs []Execution
+	start := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	add := func() {
+		lock.Lock()
+		defer lock.Unlock()
+		e := Execution{ID: fmt.Sprintf("exec%d", len(execs))}
+		e.StartDate.Time = start.Add(time.Duration(len(execs)) * time.Minute)
+		execs = append([]Execution{e}, execs...)
+	}
+	for i := 0; i < nbExecs; i++ {
+		add()
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		from, _ := strconv.Atoi(r.URL.Query().Get("from"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+		var res struct {
+			Data struct {
+				Data []Execution `json:"data"`
+				FacetedSearchResult
+			} `json:"data"`
+		}
+		lock.Lock()
+		to := from + size
+		if to > len(execs) {
+			to = len(execs)
+		}
+		if from < to {
+			res.Data.Data = append([]Execution(nil), execs[from:to]...)
+		}
+		res.Data.TotalResults = len(execs)
+		res.Data.From = from
+		res.Data.To = to - 1
+		lock.Unlock()
+		b, err := json.Marshal(res)
+		assert.NilError(t, err)
+		_, _ = w.Write(b)
+		if afterSearch != nil {
+			afterSearch(add)
+		}
+	}))
+	return ts, add
+}
+
+func executionIDs(t *testing.T, it *ExecutionsIterator) []string {
+	t.Helper()
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Execution().ID)
+	}
+	assert.NilError(t, it.Err())
+	return ids
+}
+
+func Test_deploymentService_ExecutionsIterator(t *testing.T) {
+	ts, addExecution := newHTTPServerTestExecutionsSearch(t, 5, nil)
+	defer ts.Close()
+
+	d := &deploymentService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+
+	it, err := d.ExecutionsIterator(context.Background(), "dep", ExecutionsIteratorOptions{PageSize: 2})
+	assert.NilError(t, err)
+	assert.Equal(t, it.Checkpoint(), "")
+	var ids []string
+	var checkpoint string
+	for it.Next() {
+		ids = append(ids, it.Execution().ID)
+		if it.Execution().ID == "exec2" {
+			checkpoint = it.Checkpoint()
+			// Executions created while iterating are returned when resuming
+			addExecution()
+		}
+	}
+	assert.NilError(t, it.Err())
+	assert.DeepEqual(t, ids, []string{"exec0", "exec1", "exec2", "exec3", "exec4"})
+
+	addExecution()
+	it, err = d.ExecutionsIterator(context.Background(), "dep", ExecutionsIteratorOptions{PageSize: 2, Checkpoint: checkpoint})
+	assert.NilError(t, err)
+	assert.Equal(t, it.Checkpoint(), checkpoint)
+	assert.DeepEqual(t, executionIDs(t, it), []string{"exec3", "exec4", "exec5", "exec6"})
+
+	// Resuming from the last execution returns nothing
+	it, err = d.ExecutionsIterator(context.Background(), "dep", ExecutionsIteratorOptions{Checkpoint: it.Checkpoint()})
+	assert.NilError(t, err)
+	assert.Assert(t, !it.Next())
+	assert.NilError(t, it.Err())
+
+	_, err = d.ExecutionsIterator(context.Background(), "dep", ExecutionsIteratorOptions{Checkpoint: "not a checkpoint"})
+	assert.ErrorContains(t, err, "invalid executions checkpoint")
+}
+
+func Test_deploymentService_ExecutionsIteratorConcurrentExecutions(t *testing.T) {
+	// An execution is created after each page request, shifting the following pages
+	ts, _ := newHTTPServerTestExecutionsSearch(t, 5, func(add func()) { add() })
+	defer ts.Close()
+
+	d := &deploymentService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+
+	it, err := d.ExecutionsIterator(context.Background(), "dep", ExecutionsIteratorOptions{PageSize: 2})
+	assert.NilError(t, err)
+	ids := executionIDs(t, it)
+	seen := make(map[string]bool)
+	for i, id := range ids {
+		assert.Assert(t, !seen[id], "execution %s returned twice", id)
+		seen[id] = true
+		assert.Equal(t, id, fmt.Sprintf("exec%d", i))
+	}
+	assert.Assert(t, len(ids) >= 5, "executions are missing: %v", ids)
+}
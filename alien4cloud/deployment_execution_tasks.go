@@ -0,0 +1,78 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// executionTasksPageSize is the number of tasks retrieved per request by GetExecutionTasks
+const executionTasksPageSize = 100
+
+// GetExecutionTasks returns tasks of an execution, with their status and error message if they failed
+func (d *deploymentService) GetExecutionTasks(ctx context.Context, executionID string) ([]Task, error) {
+	var tasks []Task
+	for {
+		request, err := d.client.NewRequest(ctx,
+			"GET",
+			fmt.Sprintf("%s/tasks/search?executionId=%s&from=%d&size=%d", a4CRestAPIPrefix, url.QueryEscape(executionID), len(tasks), executionTasksPageSize),
+			nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Cannot create a request to get tasks of execution %q", executionID)
+		}
+
+		var res struct {
+			Data struct {
+				Data []Task `json:"data"`
+				FacetedSearchResult
+			} `json:"data"`
+		}
+		response, err := d.client.Do(request)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Cannot send a request to get tasks of execution %q", executionID)
+		}
+		err = ReadA4CResponse(response, &res)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Cannot get tasks of execution %q", executionID)
+		}
+		tasks = append(tasks, res.Data.Data...)
+		if len(res.Data.Data) == 0 || len(tasks) >= res.Data.TotalResults {
+			return tasks, nil
+		}
+	}
+}
+
+// GetExecutionStepStatuses returns statuses of steps of an execution indexed by step name.
+//
+// Alien4Cloud only keeps steps statuses of the last execution of a deployment, an error is returned for previous executions.
+func (d *deploymentService) GetExecutionStepStatuses(ctx context.Context, executionID string) (map[string]string, error) {
+	execution, err := d.GetExecutionByID(ctx, executionID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get execution %q", executionID)
+	}
+
+	workflowExecution, err := d.getWorkflowExecution(ctx, execution.DeploymentID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get steps of execution %q", executionID)
+	}
+	if workflowExecution.Execution.ID != executionID {
+		return nil, errors.Errorf("steps statuses of execution %q are not available as it is not the last execution of deployment %q", executionID, execution.DeploymentID)
+	}
+	return workflowExecution.StepStatus, nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_deploymentService_GetExecutionTasks(t *testing.T) {
+	const nbTasks = 150
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !regexp.MustCompile(`.*/tasks/search$`).Match([]byte(r.URL.Path)) || r.URL.Query().Get("executionId") != "execID" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+			return
+		}
+		from, _ := strconv.Atoi(r.URL.Query().Get("from"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+		var data string
+		for i := from; i < from+size && i < nbTasks; i++ {
+			if data != "" {
+				data += ","
+			}
+			status := "SUCCEEDED"
+			if i == nbTasks-1 {
+				status = "FAILED"
+			}
+			data += fmt.Sprintf(`{"id":"task%d","executionId":"execID","nodeId":"Compute","operationName":"create","status":%q}`, i, status)
+		}
+		fmt.Fprintf(w, `{"data":{"data":[%s],"totalResults":%d}}`, data, nbTasks)
+	}))
+	defer ts.Close()
+
+	d := &deploymentService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	tasks, err := d.GetExecutionTasks(context.Background(), "execID")
+	assert.NilError(t, err)
+	assert.Equal(t, len(tasks), nbTasks)
+	assert.DeepEqual(t, tasks[nbTasks-1], Task{ID: "task149", ExecutionID: "execID", NodeID: "Compute", OperationName: "create", Status: "FAILED"})
+
+	_, err = d.GetExecutionTasks(context.Background(), "unknown")
+	assert.ErrorContains(t, err, `Cannot get tasks of execution "unknown"`)
+}
+
+func Test_deploymentService_GetExecutionStepStatuses(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/executions/(execID|oldExecID)$`).Match([]byte(r.URL.Path)):
+			id := regexp.MustCompile(`.*/executions/(.*)$`).FindStringSubmatch(r.URL.Path)[1]
+			_, _ = w.Write([]byte(`{"data":{"id":"` + id + `","deploymentId":"depID","workflowName":"install","status":"FAILED"}}`))
+		case regexp.MustCompile(`.*/workflow_execution/depID$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":{"execution":{"id":"execID","status":"FAILED"},"stepStatus":{"create":"COMPLETED_SUCCESSFULL","start":"COMPLETED_WITH_ERROR"}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	d := &deploymentService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	statuses, err := d.GetExecutionStepStatuses(context.Background(), "execID")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, statuses, map[string]string{"create": "COMPLETED_SUCCESSFULL", "start": "COMPLETED_WITH_ERROR"})
+
+	_, err = d.GetExecutionStepStatuses(context.Background(), "oldExecID")
+	assert.ErrorContains(t, err, `execution "oldExecID" are not available as it is not the last execution of deployment "depID"`)
+}
@@ -18,7 +18,7 @@ func mustParseTime(t *testing.T, timeStr string) Time {
 	r, err := time.Parse("2006-01-02 15:04:05.000 -0700 MST", timeStr)
 	assert.NilError(t, err, "failed to parse time")
 
-	return Time{r}
+	return Time{Time: r}
 }
 
 func Test_deploymentService_GetExecutions(t *testing.T) {
@@ -214,3 +214,44 @@ func Test_deploymentService_GetExecutionByID(t *testing.T) {
 		})
 	}
 }
+
+func Test_deploymentService_ExecutionActions(t *testing.T) {
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req CancelExecRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.EnvironmentID != "env" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"code": 400,"message":"bad request"}}`))
+			return
+		}
+		requests = append(requests, r.Method+" "+path.Base(r.URL.Path)+" "+req.ExecutionID)
+		switch {
+		case req.ExecutionID == "running" && path.Base(r.URL.Path) != "cancel":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"code": 400,"message":"execution is running"}}`))
+		case path.Base(r.URL.Path) == "relaunch":
+			_, _ = w.Write([]byte(`{"data":"newExecID"}`))
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer ts.Close()
+
+	d := &deploymentService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	ctx := context.Background()
+
+	assert.NilError(t, d.CancelExecution(ctx, "env", "running"))
+	assert.NilError(t, d.ResumeExecution(ctx, "env", "failed"))
+	execID, err := d.RelaunchExecution(ctx, "env", "failed")
+	assert.NilError(t, err)
+	assert.Equal(t, execID, "newExecID")
+	err = d.ResumeExecution(ctx, "env", "running")
+	assert.ErrorContains(t, err, "Failed to resume execution for execution 'running' on environment 'env': execution is running")
+
+	assert.DeepEqual(t, requests, []string{
+		"POST cancel running",
+		"POST resume failed",
+		"POST relaunch failed",
+		"POST resume running",
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// GetDeploymentInputArtifacts returns the artifacts currently bound to input artifacts of a deployment topology
+// indexed by input artifact name.
+//
+// Uploaded artifacts take precedence over default artifacts defined in the topology.
+func (d *deploymentService) GetDeploymentInputArtifacts(ctx context.Context, appID, envID string) (map[string]InputArtifactBinding, error) {
+	topology, err := d.client.applicationService.GetDeploymentTopology(ctx, appID, envID)
+	if err != nil {
+		return nil, err
+	}
+	bindings := make(map[string]InputArtifactBinding)
+	for name, artifact := range topology.Data.Topology.InputArtifacts {
+		bindings[name] = InputArtifactBinding{Artifact: artifact}
+	}
+	for name, artifact := range topology.Data.Topology.UploadedInputArtifacts {
+		bindings[name] = InputArtifactBinding{Artifact: artifact, Uploaded: true}
+	}
+	return bindings, nil
+}
+
+// ResetDeploymentInputArtifact resets an uploaded input artifact of a deployment topology to its default artifact
+func (d *deploymentService) ResetDeploymentInputArtifact(ctx context.Context, appID, envID, inputArtifact string) error {
+	request, err := d.client.NewRequest(ctx, "PUT",
+		fmt.Sprintf("%s/applications/%s/environments/%s/deployment-topology/inputArtifacts/%s/reset",
+			a4CRestAPIPrefix, appID, envID, inputArtifact),
+		nil,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot create a request to reset input artifact %q", inputArtifact)
+	}
+	response, err := d.client.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot send a request to reset input artifact %q", inputArtifact)
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Cannot reset input artifact %q for application %q environment %q", inputArtifact, appID, envID)
+}
@@ -0,0 +1,66 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_deploymentService_GetAndResetDeploymentInputArtifacts(t *testing.T) {
+	var resets []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/deployment-topology/inputArtifacts/config/reset$`).Match([]byte(r.URL.Path)):
+			assert.Equal(t, r.Method, http.MethodPut)
+			resets = append(resets, "config")
+			_, _ = w.Write([]byte(`{"data":null}`))
+		case regexp.MustCompile(`.*/applications/app/environments/env/deployment-topology$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":{"topology":{
+				"inputArtifacts":{
+					"config":{"artifactType":"tosca.artifacts.File","artifactRef":"default.cfg"},
+					"script":{"artifactType":"tosca.artifacts.File","artifactRef":"run.sh"}},
+				"uploadedInputArtifacts":{
+					"config":{"artifactType":"tosca.artifacts.File","artifactName":"custom.cfg"}}}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL, "", "", "", false)
+	assert.NilError(t, err)
+	d := client.DeploymentService()
+	ctx := context.Background()
+
+	bindings, err := d.GetDeploymentInputArtifacts(ctx, "app", "env")
+	assert.NilError(t, err)
+	assert.Equal(t, len(bindings), 2)
+	assert.Equal(t, bindings["config"].Uploaded, true)
+	assert.Equal(t, bindings["config"].Artifact.ArtifactName, "custom.cfg")
+	assert.Equal(t, bindings["script"].Uploaded, false)
+	assert.Equal(t, bindings["script"].Artifact.ArtifactRef, "run.sh")
+
+	assert.NilError(t, d.ResetDeploymentInputArtifact(ctx, "app", "env", "config"))
+	assert.DeepEqual(t, resets, []string{"config"})
+	err = d.ResetDeploymentInputArtifact(ctx, "app", "env", "unknown")
+	assert.ErrorContains(t, err, `Cannot reset input artifact "unknown" for application "app" environment "env"`)
+}
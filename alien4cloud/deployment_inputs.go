@@ -0,0 +1,132 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// ComputeInputsDiff returns changes that would be applied to inputs of a deployment topology
+// by calling UpdateDeploymentTopology with the desired input values.
+//
+// Inputs that are not part of the desired values are left unchanged by UpdateDeploymentTopology,
+// so they are not reported. An error is returned if a desired input is not defined in the topology.
+// Changes are sorted by input name.
+func (d *deploymentService) ComputeInputsDiff(ctx context.Context, appID, envID string, desired map[string]interface{}) ([]InputChange, error) {
+	topology, err := d.client.applicationService.GetDeploymentTopology(ctx, appID, envID)
+	if err != nil {
+		return nil, err
+	}
+	return diffInputs(topology, desired)
+}
+
+// ApplyInputsDefaults copies default values of topology inputs into the deployment topology
+// of the given environment, so that an application created with CreateAppli is deployable
+// without a separate inputs update when defaults suffice.
+//
+// Only inputs having a default value and no value set in the deployment topology are updated.
+// Names of updated inputs are returned sorted.
+func (d *deploymentService) ApplyInputsDefaults(ctx context.Context, appID, envID string) ([]string, error) {
+	topology, err := d.client.applicationService.GetDeploymentTopology(ctx, appID, envID)
+	if err != nil {
+		return nil, err
+	}
+	defaults := inputsDefaults(topology)
+	if len(defaults) == 0 {
+		return nil, nil
+	}
+	err = d.UpdateDeploymentTopology(ctx, appID, envID, UpdateDeploymentTopologyRequest{InputProperties: defaults})
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(defaults))
+	for name := range defaults {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func inputsDefaults(topology *Topology) map[string]interface{} {
+	defaults := make(map[string]interface{})
+	for name, def := range topology.Data.Topology.Inputs {
+		if def.DefaultValue.Value == nil {
+			continue
+		}
+		if current, ok := topology.Data.Topology.DeployerInputProperties[name]; ok && current.Value != nil {
+			continue
+		}
+		defaults[name] = def.DefaultValue.Value
+	}
+	return defaults
+}
+
+func diffInputs(topology *Topology, desired map[string]interface{}) ([]InputChange, error) {
+	var changes []InputChange
+	for name, desiredValue := range desired {
+		if _, ok := topology.Data.Topology.Inputs[name]; !ok {
+			return nil, errors.Errorf("input %q is not defined in the topology", name)
+		}
+		current, ok := topology.Data.Topology.DeployerInputProperties[name]
+		if !ok || current.Value == nil {
+			changes = append(changes, InputChange{Name: name, Kind: InputAdded, Desired: desiredValue})
+			continue
+		}
+		equal, err := inputValuesEqual(current.Value, desiredValue)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to compare values of input %q", name)
+		}
+		if !equal {
+			changes = append(changes, InputChange{Name: name, Kind: InputUpdated, Current: current.Value, Desired: desiredValue})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Name < changes[j].Name
+	})
+	return changes, nil
+}
+
+// inputValuesEqual compares a value returned by Alien4Cloud to a user-provided value.
+// The user-provided value is normalized through JSON to match types of values returned by Alien4Cloud
+// and scalar values are compared on their string representation as Alien4Cloud stores them as strings.
+func inputValuesEqual(current, desired interface{}) (bool, error) {
+	b, err := json.Marshal(desired)
+	if err != nil {
+		return false, err
+	}
+	var normalized interface{}
+	err = json.Unmarshal(b, &normalized)
+	if err != nil {
+		return false, err
+	}
+	if isScalarValue(current) && isScalarValue(normalized) {
+		return fmt.Sprint(current) == fmt.Sprint(normalized), nil
+	}
+	return reflect.DeepEqual(current, normalized), nil
+}
+
+func isScalarValue(v interface{}) bool {
+	switch v.(type) {
+	case string, bool, float64:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_deploymentService_ComputeInputsDiff(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/applications/app/environments/env/deployment-topology`).Match([]byte(r.URL.Path)):
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"data":{"topology":{
+				"inputs":{"cpus":{"type":"integer"},"name":{"type":"string"},"tags":{"type":"list"},"debug":{"type":"boolean"}},
+				"deployerInputProperties":{"cpus":{"value":"2"},"name":{"value":"web"},"tags":{"value":["a","b"]}}
+			}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	client := &a4cClient{client: http.DefaultClient, baseURL: ts.URL}
+	client.applicationService = &applicationService{client}
+	d := &deploymentService{client}
+
+	changes, err := d.ComputeInputsDiff(context.Background(), "app", "env", map[string]interface{}{
+		"cpus":  2,
+		"name":  "api",
+		"tags":  []string{"a", "b"},
+		"debug": true,
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, changes, []InputChange{
+		{Name: "debug", Kind: InputAdded, Desired: true},
+		{Name: "name", Kind: InputUpdated, Current: "web", Desired: "api"},
+	})
+
+	_, err = d.ComputeInputsDiff(context.Background(), "app", "env", map[string]interface{}{"unknown": 1})
+	assert.ErrorContains(t, err, `input "unknown" is not defined`)
+
+	_, err = d.ComputeInputsDiff(context.Background(), "unknown", "env", nil)
+	assert.ErrorContains(t, err, "not found")
+}
+
+func Test_deploymentService_ApplyInputsDefaults(t *testing.T) {
+	var updateRequest UpdateDeploymentTopologyRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/applications/app/environments/env/deployment-topology`).Match([]byte(r.URL.Path)) && r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"data":{"topology":{
+				"inputs":{"cpus":{"type":"integer","default":{"value":"2"}},"name":{"type":"string","default":{"value":"web"}},"debug":{"type":"boolean"}},
+				"deployerInputProperties":{"name":{"value":"api"}}
+			}}}`))
+		case regexp.MustCompile(`.*/applications/app/environments/env/deployment-topology`).Match([]byte(r.URL.Path)) && r.Method == http.MethodPut:
+			assert.NilError(t, json.NewDecoder(r.Body).Decode(&updateRequest))
+			_, _ = w.Write([]byte(`{"data":null}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	client := &a4cClient{client: http.DefaultClient, baseURL: ts.URL}
+	client.applicationService = &applicationService{client}
+	d := &deploymentService{client}
+
+	names, err := d.ApplyInputsDefaults(context.Background(), "app", "env")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, names, []string{"cpus"})
+	assert.DeepEqual(t, updateRequest.InputProperties, map[string]interface{}{"cpus": "2"})
+
+	_, err = d.ApplyInputsDefaults(context.Background(), "unknown", "env")
+	assert.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,57 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// SetMaintenanceMode enables or disables the maintenance mode of a deployment.
+//
+// While in maintenance mode, instances of the deployment are not managed by the orchestrator.
+func (d *deploymentService) SetMaintenanceMode(ctx context.Context, appID, envID string, enabled bool) error {
+	err := d.setMaintenanceMode(ctx, fmt.Sprintf("%s/applications/%s/environments/%s/maintenance", a4CRestAPIPrefix, appID, envID), enabled)
+	return errors.Wrapf(err, "Unable to set maintenance mode to %t for application %q environment %q", enabled, appID, envID)
+}
+
+// SetInstanceMaintenanceMode enables or disables the maintenance mode of a node instance of a deployment
+func (d *deploymentService) SetInstanceMaintenanceMode(ctx context.Context, appID, envID, nodeName, instanceName string, enabled bool) error {
+	err := d.setMaintenanceMode(ctx,
+		fmt.Sprintf("%s/applications/%s/environments/%s/maintenance/%s/%s", a4CRestAPIPrefix, appID, envID, nodeName, instanceName), enabled)
+	return errors.Wrapf(err, "Unable to set maintenance mode to %t for instance %q of node %q of application %q environment %q",
+		enabled, instanceName, nodeName, appID, envID)
+}
+
+// setMaintenanceMode switches the maintenance mode on using a POST request or off using a DELETE request
+func (d *deploymentService) setMaintenanceMode(ctx context.Context, url string, enabled bool) error {
+	method := http.MethodDelete
+	if enabled {
+		method = http.MethodPost
+	}
+	request, err := d.client.NewRequest(ctx, method, url, nil)
+	if err != nil {
+		return errors.Wrap(err, "Cannot create a request to set maintenance mode")
+	}
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		return errors.Wrap(err, "Cannot send a request to set maintenance mode")
+	}
+	return ReadA4CResponse(response, nil)
+}
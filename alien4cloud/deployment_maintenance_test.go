@@ -0,0 +1,56 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_deploymentService_MaintenanceMode(t *testing.T) {
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !regexp.MustCompile(`.*/applications/app/environments/env/maintenance(/Compute/0)?$`).Match([]byte(r.URL.Path)) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+			return
+		}
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	d := &deploymentService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	ctx := context.Background()
+
+	assert.NilError(t, d.SetMaintenanceMode(ctx, "app", "env", true))
+	assert.NilError(t, d.SetInstanceMaintenanceMode(ctx, "app", "env", "Compute", "0", true))
+	assert.NilError(t, d.SetInstanceMaintenanceMode(ctx, "app", "env", "Compute", "0", false))
+	assert.NilError(t, d.SetMaintenanceMode(ctx, "app", "env", false))
+	assert.DeepEqual(t, requests, []string{
+		"POST /rest/latest/applications/app/environments/env/maintenance",
+		"POST /rest/latest/applications/app/environments/env/maintenance/Compute/0",
+		"DELETE /rest/latest/applications/app/environments/env/maintenance/Compute/0",
+		"DELETE /rest/latest/applications/app/environments/env/maintenance",
+	})
+
+	err := d.SetInstanceMaintenanceMode(ctx, "app", "env", "Unknown", "0", true)
+	assert.ErrorContains(t, err, `Unable to set maintenance mode to true for instance "0" of node "Unknown"`)
+}
@@ -0,0 +1,59 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// GetOrchestratorDeploymentInfo returns orchestrator-side identifiers of the active deployment of an application environment.
+//
+// The orchestrator deployment ID (also known as paasId) allows to query the orchestrator APIs directly,
+// for instance for low-level debugging on Yorc.
+func (d *deploymentService) GetOrchestratorDeploymentInfo(ctx context.Context, appID, envID string) (OrchestratorDeploymentInfo, error) {
+	request, err := d.client.NewRequest(ctx, http.MethodGet,
+		fmt.Sprintf("%s/applications/%s/environments/%s/active-deployment-monitored", a4CRestAPIPrefix, appID, envID), nil)
+	if err != nil {
+		return OrchestratorDeploymentInfo{}, errors.Wrapf(err, "Cannot create a request in order to get the active deployment of application %q environment %q", appID, envID)
+	}
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		return OrchestratorDeploymentInfo{}, errors.Wrapf(err, "Cannot send a request in order to get the active deployment of application %q environment %q", appID, envID)
+	}
+	var res struct {
+		Data struct {
+			Deployment Deployment `json:"deployment"`
+		} `json:"data"`
+	}
+	err = ReadA4CResponse(response, &res)
+	if err != nil {
+		return OrchestratorDeploymentInfo{}, errors.Wrapf(err, "Cannot get the active deployment of application %q environment %q", appID, envID)
+	}
+	deployment := res.Data.Deployment
+	if deployment.ID == "" {
+		return OrchestratorDeploymentInfo{}, errors.Errorf("No active deployment for application %q environment %q", appID, envID)
+	}
+	return OrchestratorDeploymentInfo{
+		DeploymentID:             deployment.ID,
+		OrchestratorDeploymentID: deployment.OrchestratorDeploymentID,
+		OrchestratorID:           deployment.OrchestratorID,
+		LocationIDs:              deployment.LocationIds,
+	}, nil
+}
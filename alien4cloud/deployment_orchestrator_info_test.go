@@ -0,0 +1,55 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_deploymentService_GetOrchestratorDeploymentInfo(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/latest/applications/app/environments/env/active-deployment-monitored":
+			_, _ = w.Write([]byte(`{"data":{"deployment":{"id":"dep1","orchestratorDeploymentId":"app-env","orchestratorId":"orch1","locationIds":["loc1"]}}}`))
+		case "/rest/latest/applications/app/environments/undeployed/active-deployment-monitored":
+			_, _ = w.Write([]byte(`{"data":null}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":504,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	d := &deploymentService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	info, err := d.GetOrchestratorDeploymentInfo(context.Background(), "app", "env")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, info, OrchestratorDeploymentInfo{
+		DeploymentID:             "dep1",
+		OrchestratorDeploymentID: "app-env",
+		OrchestratorID:           "orch1",
+		LocationIDs:              []string{"loc1"},
+	})
+
+	_, err = d.GetOrchestratorDeploymentInfo(context.Background(), "app", "undeployed")
+	assert.ErrorContains(t, err, `No active deployment for application "app" environment "undeployed"`)
+
+	_, err = d.GetOrchestratorDeploymentInfo(context.Background(), "app", "unknown")
+	assert.ErrorContains(t, err, `Cannot get the active deployment of application "app" environment "unknown"`)
+}
@@ -0,0 +1,142 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// ResourceQuota is the result of the quota check of a location resource
+type ResourceQuota struct {
+	LocationID   string
+	ResourceID   string
+	ResourceName string
+	// Nodes are names of the topology nodes matched to this resource
+	Nodes []string
+	// Requested is the number of instances of this resource requested by the topology when deployed,
+	// that is the sum of default instances of nodes matched to this resource
+	Requested int
+	// MaxRequested is the number of instances of this resource the topology may request when scaled,
+	// that is the sum of maximum instances of nodes matched to this resource
+	MaxRequested int
+	// Available is the number of instances of this resource still available on the location,
+	// -1 if it is unknown
+	Available int
+}
+
+// QuotaReport is the result of DeploymentService.CheckResourceQuotas()
+type QuotaReport struct {
+	Resources []ResourceQuota
+	// Warnings describe exceeded quotas, quotas which may be exceeded when scaling and resources
+	// which availability is unknown
+	Warnings []string
+}
+
+// Exceeded returns true if a requested resource exceeds the quota of its location
+func (r QuotaReport) Exceeded() bool {
+	for _, resource := range r.Resources {
+		if resource.Available >= 0 && resource.Requested > resource.Available {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckResourceQuotas compares location resources requested by the deployment topology of an application
+// environment to their availability, in order to detect deployments doomed to fail before starting them.
+//
+// Requested resources are location resources matched by nodes of the deployment topology, so a location should
+// have been set on the deployment topology. Instances of a node are counted from the default_instances and
+// max_instances properties of its scalable capability, a node without this capability is counted once.
+//
+// Alien4Cloud does not expose quotas of locations, so available maps IDs of location resources to the number
+// of instances still available as reported by the infrastructure. Resources missing from available are reported
+// in warnings.
+func (d *deploymentService) CheckResourceQuotas(ctx context.Context, appID, envID string, available map[string]int) (QuotaReport, error) {
+	var report QuotaReport
+	matched, nodeTemplates, err := d.getMatchedResources(ctx, appID, envID)
+	if err != nil {
+		return report, err
+	}
+
+	byResource := make(map[string]*ResourceQuota)
+	for nodeName, resource := range matched {
+		quota, ok := byResource[resource.ID]
+		if !ok {
+			quota = &ResourceQuota{LocationID: resource.LocationID, ResourceID: resource.ID, ResourceName: resource.Name, Available: -1}
+			byResource[resource.ID] = quota
+		}
+		instances, maxInstances := nodeInstances(nodeTemplates[nodeName])
+		quota.Nodes = append(quota.Nodes, nodeName)
+		quota.Requested += instances
+		quota.MaxRequested += maxInstances
+	}
+
+	ids := make([]string, 0, len(byResource))
+	for id := range byResource {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		quota := byResource[id]
+		sort.Strings(quota.Nodes)
+		if availableInstances, ok := available[id]; !ok {
+			report.Warnings = append(report.Warnings, fmt.Sprintf("availability of resource %q of location %q is unknown", quota.ResourceName, quota.LocationID))
+		} else {
+			quota.Available = availableInstances
+			if quota.Available < 0 {
+				quota.Available = 0
+			}
+			if quota.Requested > quota.Available {
+				report.Warnings = append(report.Warnings, fmt.Sprintf("resource %q of location %q is requested %d time(s) but only %d are available",
+					quota.ResourceName, quota.LocationID, quota.Requested, quota.Available))
+			} else if quota.MaxRequested > quota.Available {
+				report.Warnings = append(report.Warnings, fmt.Sprintf("resource %q of location %q may be requested up to %d time(s) when scaling but only %d are available",
+					quota.ResourceName, quota.LocationID, quota.MaxRequested, quota.Available))
+			}
+		}
+		report.Resources = append(report.Resources, *quota)
+	}
+	return report, nil
+}
+
+// nodeInstances returns the default and maximum numbers of instances of a node template
+// defined by its scalable capability
+func nodeInstances(nodeTemplate NodeTemplate) (int, int) {
+	instances, maxInstances := 1, 1
+	scalable, ok := nodeTemplate.Capability("scalable")
+	if !ok {
+		return instances, maxInstances
+	}
+	for _, prop := range scalable.Properties {
+		value, err := strconv.Atoi(fmt.Sprint(prop.Value.Value))
+		if err != nil || value < 0 {
+			continue
+		}
+		switch prop.Key {
+		case "default_instances":
+			instances = value
+		case "max_instances":
+			maxInstances = value
+		}
+	}
+	if maxInstances < instances {
+		maxInstances = instances
+	}
+	return instances, maxInstances
+}
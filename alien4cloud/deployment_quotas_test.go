@@ -0,0 +1,78 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/pkg/errors"
+	"gotest.tools/v3/assert"
+)
+
+func Test_deploymentService_CheckResourceQuotas(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/applications/app/environments/env/deployment-topology$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":{
+				"topology":{
+					"orchestratorId":"orch",
+					"substitutedNodes":{"Web1":"small","Web2":"small","DB":"large","Cache":"other"},
+					"nodeTemplates":{
+						"Web1":{"name":"Web1","type":"org.alien4cloud.nodes.openstack.Compute","capabilities":[
+							{"key":"scalable","value":{"type":"tosca.capabilities.Scalable","properties":[
+								{"key":"min_instances","value":{"value":"1"}},
+								{"key":"max_instances","value":{"value":"3"}},
+								{"key":"default_instances","value":{"value":"2"}}]}}]},
+						"Web2":{"name":"Web2","type":"org.alien4cloud.nodes.openstack.Compute"},
+						"DB":{"name":"DB","type":"org.alien4cloud.nodes.openstack.Compute","capabilities":[
+							{"key":"scalable","value":{"type":"tosca.capabilities.Scalable","properties":[
+								{"key":"max_instances","value":{"value":"5"}},
+								{"key":"default_instances","value":{"value":"1"}}]}}]},
+						"Cache":{"name":"Cache","type":"org.alien4cloud.nodes.vsphere.Compute"}}},
+				"locationResourceTemplates":{
+					"small":{"id":"small","name":"m1.small","locationId":"openstack"},
+					"large":{"id":"large","name":"m1.large","locationId":"openstack"},
+					"other":{"id":"other","name":"vm","locationId":"vsphere"}}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	d := &deploymentService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	report, err := d.CheckResourceQuotas(context.Background(), "app", "env", map[string]int{"small": 2, "large": 3})
+	assert.NilError(t, err)
+	assert.Assert(t, report.Exceeded())
+	assert.DeepEqual(t, report, QuotaReport{
+		Resources: []ResourceQuota{
+			{LocationID: "openstack", ResourceID: "large", ResourceName: "m1.large", Nodes: []string{"DB"}, Requested: 1, MaxRequested: 5, Available: 3},
+			{LocationID: "vsphere", ResourceID: "other", ResourceName: "vm", Nodes: []string{"Cache"}, Requested: 1, MaxRequested: 1, Available: -1},
+			{LocationID: "openstack", ResourceID: "small", ResourceName: "m1.small", Nodes: []string{"Web1", "Web2"}, Requested: 3, MaxRequested: 4, Available: 2},
+		},
+		Warnings: []string{
+			`resource "m1.large" of location "openstack" may be requested up to 5 time(s) when scaling but only 3 are available`,
+			`availability of resource "vm" of location "vsphere" is unknown`,
+			`resource "m1.small" of location "openstack" is requested 3 time(s) but only 2 are available`,
+		},
+	})
+
+	_, err = d.CheckResourceQuotas(context.Background(), "unknown", "env", nil)
+	assert.Assert(t, errors.Is(err, ErrNotFound), "unexpected error %v", err)
+}
@@ -0,0 +1,112 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// GetRuntimeWorkflows returns workflows of the topology deployed on an application environment, including
+// workflows injected at deployment time, sorted by name.
+//
+// A workflow is launchable if the deployment is deployed or updated and the workflow is not currently running.
+func (d *deploymentService) GetRuntimeWorkflows(ctx context.Context, appID, envID string) ([]RuntimeWorkflow, error) {
+	deploymentID, err := d.GetCurrentDeploymentID(ctx, appID, envID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get deployment of application %q environment %q", appID, envID)
+	}
+	if deploymentID == "" {
+		return nil, errors.Errorf("Application %q is not deployed on environment %q", appID, envID)
+	}
+
+	request, err := d.client.NewRequest(ctx,
+		"GET",
+		fmt.Sprintf("%s/runtime/%s/environment/%s/topology", a4CRestAPIPrefix, appID, envID),
+		nil,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot create a request to get runtime topology of application '%s' on environment '%s'", appID, envID)
+	}
+
+	var res struct {
+		Data struct {
+			Topology struct {
+				Workflows map[string]Workflow `json:"workflows"`
+			} `json:"topology"`
+		} `json:"data"`
+	}
+	response, err := d.client.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot send a request to get runtime topology of application '%s' on environment '%s'", appID, envID)
+	}
+	err = ReadA4CResponse(response, &res)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot get runtime topology of application '%s' on environment '%s'", appID, envID)
+	}
+
+	deploymentReason, runningWorkflow, err := d.workflowsLaunchState(ctx, deploymentID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to check if workflows could be run on application %q environment %q", appID, envID)
+	}
+
+	workflows := make([]RuntimeWorkflow, 0, len(res.Data.Topology.Workflows))
+	for name, wf := range res.Data.Topology.Workflows {
+		if wf.Name != "" {
+			name = wf.Name
+		}
+		notLaunchableReason := deploymentReason
+		if notLaunchableReason == "" && name == runningWorkflow {
+			notLaunchableReason = fmt.Sprintf("workflow %s is running", name)
+		}
+		workflows = append(workflows, RuntimeWorkflow{
+			Name:                name,
+			Description:         wf.Description,
+			Metadata:            wf.Metadata,
+			Inputs:              wf.Inputs,
+			Standard:            wf.Standard,
+			Launchable:          notLaunchableReason == "",
+			NotLaunchableReason: notLaunchableReason,
+		})
+	}
+	sort.Slice(workflows, func(i, j int) bool {
+		return workflows[i].Name < workflows[j].Name
+	})
+	return workflows, nil
+}
+
+// workflowsLaunchState returns why no workflow could be run on the given deployment, or an empty string
+// if they could be run, and the name of the workflow currently running on it if any
+func (d *deploymentService) workflowsLaunchState(ctx context.Context, deploymentID string) (string, string, error) {
+	status, err := d.GetDeploymentStatusByID(ctx, deploymentID)
+	if err != nil {
+		return "", "", err
+	}
+	if status != ApplicationDeployed && status != ApplicationUpdated {
+		return fmt.Sprintf("deployment status is %s", status), "", nil
+	}
+
+	wfExec, err := d.getWorkflowExecution(ctx, deploymentID)
+	if err != nil {
+		return "", "", err
+	}
+	if wfExec.Execution.Status == WorkflowRunning {
+		return "", wfExec.Execution.WorkflowName, nil
+	}
+	return "", "", nil
+}
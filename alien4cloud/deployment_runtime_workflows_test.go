@@ -0,0 +1,81 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_deploymentService_GetRuntimeWorkflows(t *testing.T) {
+	executionStatus := WorkflowSucceeded
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/latest/applications/app/environments/env/active-deployment-monitored":
+			_, _ = w.Write([]byte(`{"data":{"deployment":{"id":"dep1"}}}`))
+		case "/rest/latest/applications/app/environments/undeployed/active-deployment-monitored":
+			_, _ = w.Write([]byte(`{"data":null}`))
+		case "/rest/latest/deployments/dep1/status":
+			_, _ = w.Write([]byte(`{"data":"DEPLOYED"}`))
+		case "/rest/latest/workflow_execution/dep1":
+			_, _ = w.Write([]byte(`{"data":{"execution":{"id":"exec1","workflowName":"backup","status":"` + executionStatus + `"}}}`))
+		case "/rest/latest/runtime/app/environment/env/topology":
+			_, _ = w.Write([]byte(`{"data":{"topology":{"workflows":{
+				"install":{"name":"install","standard":true},
+				"backup":{"name":"backup","description":"Backups the database","inputs":{"target":{"type":"string","required":true}}},
+				"orchestrator_heal":{"name":"orchestrator_heal","metadata":{"injectedBy":"orchestrator"}}
+			}}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":504,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	d := &deploymentService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	ctx := context.Background()
+
+	workflows, err := d.GetRuntimeWorkflows(ctx, "app", "env")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, workflows, []RuntimeWorkflow{
+		{
+			Name:        "backup",
+			Description: "Backups the database",
+			Inputs:      map[string]PropertyDefinition{"target": {Type: "string", Required: true}},
+			Launchable:  true,
+		},
+		{Name: "install", Standard: true, Launchable: true},
+		{Name: "orchestrator_heal", Metadata: map[string]string{"injectedBy": "orchestrator"}, Launchable: true},
+	})
+
+	executionStatus = WorkflowRunning
+	workflows, err = d.GetRuntimeWorkflows(ctx, "app", "env")
+	assert.NilError(t, err)
+	for _, wf := range workflows {
+		if wf.Name == "backup" {
+			assert.Assert(t, !wf.Launchable)
+			assert.Equal(t, wf.NotLaunchableReason, "workflow backup is running")
+			continue
+		}
+		assert.Assert(t, wf.Launchable, "workflow %s", wf.Name)
+	}
+
+	_, err = d.GetRuntimeWorkflows(ctx, "app", "undeployed")
+	assert.ErrorContains(t, err, "not deployed")
+}
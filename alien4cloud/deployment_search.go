@@ -0,0 +1,151 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// GetDeployments searches deployments matching the given request.
+//
+// It returns a page of deployments and the total number of deployments matching the request filters.
+// That means that this number can be used to control pagination processing along with the From and Size fields
+// of the DeploymentSearchRequest. The size defaults to DefaultPageSize and is capped to MaxPageSize.
+// A TruncatedResultsError is then returned along with results if more resources match the request.
+func (d *deploymentService) GetDeployments(ctx context.Context, searchRequest DeploymentSearchRequest) ([]Deployment, int, error) {
+	page := paginate(SearchRequest{From: searchRequest.From, Size: searchRequest.Size})
+	query := url.Values{}
+	query.Set("query", searchRequest.Query)
+	query.Set("from", strconv.Itoa(page.From))
+	query.Set("size", strconv.Itoa(page.Size))
+	if searchRequest.OrchestratorID != "" {
+		query.Set("orchestratorId", searchRequest.OrchestratorID)
+	}
+	if searchRequest.SourceID != "" {
+		query.Set("sourceId", searchRequest.SourceID)
+	}
+	if searchRequest.EnvironmentID != "" {
+		query.Set("environmentId", searchRequest.EnvironmentID)
+	}
+
+	request, err := d.client.NewRequest(ctx,
+		"GET",
+		fmt.Sprintf("%s/deployments/search?%s", a4CRestAPIPrefix, query.Encode()),
+		nil)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Cannot create a request to search deployments")
+	}
+
+	var res struct {
+		Data struct {
+			Data []struct {
+				Deployment Deployment `json:"deployment"`
+			} `json:"data"`
+			TotalResults int `json:"totalResults"`
+		} `json:"data"`
+	}
+	response, err := d.client.Do(request)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Cannot send a request to search deployments")
+	}
+	err = ReadA4CResponse(response, &res)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "Cannot search deployments")
+	}
+
+	deployments := make([]Deployment, 0, len(res.Data.Data))
+	for _, r := range res.Data.Data {
+		deployments = append(deployments, r.Deployment)
+	}
+	return deployments, res.Data.TotalResults, checkTruncated(SearchRequest{From: searchRequest.From, Size: searchRequest.Size}, len(deployments), res.Data.TotalResults)
+}
+
+// DeploymentsIterator iterates over all deployments matching a search request,
+// transparently paging through deployments search results.
+//
+// Typical usage is:
+//
+//	it := client.DeploymentService().DeploymentsIterator(ctx, DeploymentSearchRequest{SourceID: appID})
+//	for it.Next() {
+//		deployment := it.Deployment()
+//		// ...
+//	}
+//	return it.Err()
+type DeploymentsIterator struct {
+	ctx           context.Context
+	d             *deploymentService
+	searchRequest DeploymentSearchRequest
+
+	done    bool
+	page    []Deployment
+	current Deployment
+	err     error
+}
+
+// DeploymentsIterator returns an iterator over deployments matching the given search request.
+//
+// Iteration starts at the search request From index and the search request Size, capped to MaxPageSize, is used as page size.
+func (d *deploymentService) DeploymentsIterator(ctx context.Context, searchRequest DeploymentSearchRequest) *DeploymentsIterator {
+	searchRequest.Size = paginate(SearchRequest{Size: searchRequest.Size}).Size
+	return &DeploymentsIterator{ctx: ctx, d: d, searchRequest: searchRequest}
+}
+
+// Next advances the iterator to the next deployment, which will then be available through the Deployment method.
+// It returns false when the iteration stops, either by reaching the end or an error.
+// After Next returns false, the Err method will return any error that occurred during iteration.
+func (it *DeploymentsIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if len(it.page) == 0 {
+		if it.done {
+			return false
+		}
+		it.err = it.fetch()
+		if it.err != nil || len(it.page) == 0 {
+			return false
+		}
+	}
+	it.current = it.page[0]
+	it.page = it.page[1:]
+	return true
+}
+
+// Deployment returns the current deployment
+func (it *DeploymentsIterator) Deployment() Deployment {
+	return it.current
+}
+
+// Err returns the error, if any, that was encountered during iteration
+func (it *DeploymentsIterator) Err() error {
+	return it.err
+}
+
+// fetch retrieves the next page of deployments
+func (it *DeploymentsIterator) fetch() error {
+	deployments, total, err := it.d.GetDeployments(it.ctx, it.searchRequest)
+	if err != nil {
+		return err
+	}
+	it.page = deployments
+	it.searchRequest.From = paginate(SearchRequest{From: it.searchRequest.From}).From + len(deployments)
+	it.done = len(deployments) == 0 || it.searchRequest.From >= total
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_deploymentService_GetDeployments(t *testing.T) {
+	var queries []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/rest/latest/deployments/search")
+		q := r.URL.Query()
+		queries = append(queries, r.URL.RawQuery)
+		if q.Get("sourceId") == "error" {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error":{"code":500,"message":"search failed"}}`))
+			return
+		}
+		// 5 deployments of app1 on orch1
+		const total = 5
+		from, _ := strconv.Atoi(q.Get("from"))
+		size, _ := strconv.Atoi(q.Get("size"))
+		var items []string
+		for i := from; i < total && i < from+size; i++ {
+			items = append(items, fmt.Sprintf(`{"deployment":{"id":"d%d","sourceId":"app1","orchestratorId":"orch1"}}`, i))
+		}
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"data":{"totalResults":%d,"data":[%s]}}`, total, strings.Join(items, ","))))
+	}))
+	defer ts.Close()
+
+	d := &deploymentService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	ctx := context.Background()
+
+	deployments, total, err := d.GetDeployments(ctx, DeploymentSearchRequest{OrchestratorID: "orch1", SourceID: "app1", EnvironmentID: "env1", From: 2, Size: 2})
+	assert.NilError(t, err)
+	assert.Equal(t, total, 5)
+	assert.DeepEqual(t, deployments, []Deployment{{ID: "d2", SourceID: "app1", OrchestratorID: "orch1"}, {ID: "d3", SourceID: "app1", OrchestratorID: "orch1"}})
+	assert.Equal(t, queries[0], "environmentId=env1&from=2&orchestratorId=orch1&query=&size=2&sourceId=app1")
+
+	_, _, err = d.GetDeployments(ctx, DeploymentSearchRequest{Size: MaxPageSize + 1})
+	assert.NilError(t, err)
+	assert.Equal(t, queries[1], fmt.Sprintf("from=0&query=&size=%d", MaxPageSize))
+
+	queries = nil
+	it := d.DeploymentsIterator(ctx, DeploymentSearchRequest{SourceID: "app1", From: 1, Size: 2})
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Deployment().ID)
+	}
+	assert.NilError(t, it.Err())
+	assert.DeepEqual(t, ids, []string{"d1", "d2", "d3", "d4"})
+	assert.Equal(t, len(queries), 2)
+
+	_, _, err = d.GetDeployments(ctx, DeploymentSearchRequest{SourceID: "error"})
+	assert.ErrorContains(t, err, "search failed")
+
+	it = d.DeploymentsIterator(ctx, DeploymentSearchRequest{SourceID: "error"})
+	assert.Assert(t, !it.Next())
+	assert.ErrorContains(t, it.Err(), "search failed")
+}
@@ -0,0 +1,140 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DeploymentStatusCallback is a function called when the deployment status of an application environment changes.
+// If the status could not be retrieved, err is not nil.
+type DeploymentStatusCallback func(appID, envID, status string, err error)
+
+const defaultDeploymentStatusPollInterval = 5 * time.Second
+
+type environmentKey struct {
+	appID string
+	envID string
+}
+
+type deploymentStatusSubscription struct {
+	key        environmentKey
+	callback   DeploymentStatusCallback
+	lastStatus string
+}
+
+// deploymentStatusRegistry multiplexes deployment status callbacks on a single poller
+type deploymentStatusRegistry struct {
+	client   *a4cClient
+	interval time.Duration
+
+	lock          sync.Mutex
+	nextID        int
+	subscriptions map[int]*deploymentStatusSubscription
+	cancel        context.CancelFunc
+}
+
+func newDeploymentStatusRegistry(client *a4cClient) *deploymentStatusRegistry {
+	return &deploymentStatusRegistry{
+		client:        client,
+		interval:      defaultDeploymentStatusPollInterval,
+		subscriptions: make(map[int]*deploymentStatusSubscription),
+	}
+}
+
+// RegisterDeploymentStatusCallback registers a callback called each time the deployment status
+// of the given application environment changes
+func (c *a4cClient) RegisterDeploymentStatusCallback(appID, envID string, callback DeploymentStatusCallback) func() {
+	return c.statusRegistry.register(environmentKey{appID, envID}, callback)
+}
+
+func (r *deploymentStatusRegistry) register(key environmentKey, callback DeploymentStatusCallback) func() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	id := r.nextID
+	r.nextID++
+	r.subscriptions[id] = &deploymentStatusSubscription{key: key, callback: callback}
+	if r.cancel == nil {
+		var ctx context.Context
+		ctx, r.cancel = context.WithCancel(context.Background())
+		go r.run(ctx)
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { r.unregister(id) })
+	}
+}
+
+func (r *deploymentStatusRegistry) unregister(id int) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.subscriptions, id)
+	if len(r.subscriptions) == 0 && r.cancel != nil {
+		r.cancel()
+		r.cancel = nil
+	}
+}
+
+func (r *deploymentStatusRegistry) run(ctx context.Context) {
+	for {
+		if r.client.waitLeadership(ctx) != nil {
+			return
+		}
+		r.poll(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.interval):
+		}
+	}
+}
+
+func (r *deploymentStatusRegistry) poll(ctx context.Context) {
+	r.lock.Lock()
+	keys := make(map[environmentKey]struct{})
+	for _, s := range r.subscriptions {
+		keys[s.key] = struct{}{}
+	}
+	r.lock.Unlock()
+
+	for key := range keys {
+		status, err := r.client.deploymentService.GetDeploymentStatus(ctx, key.appID, key.envID)
+		if ctx.Err() != nil {
+			return
+		}
+
+		var toNotify []DeploymentStatusCallback
+		r.lock.Lock()
+		for _, s := range r.subscriptions {
+			if s.key != key {
+				continue
+			}
+			if err != nil {
+				toNotify = append(toNotify, s.callback)
+			} else if s.lastStatus != status {
+				s.lastStatus = status
+				toNotify = append(toNotify, s.callback)
+			}
+		}
+		r.lock.Unlock()
+
+		for _, cb := range toNotify {
+			cb(key.appID, key.envID, status, err)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/leader"
+)
+
+func Test_a4cClient_RegisterDeploymentStatusCallback(t *testing.T) {
+	var nbStatusCalls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/applications/app/environments/env/active-deployment-monitored`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":{"deployment":{"id":"depID"}}}`))
+		case regexp.MustCompile(`.*/deployments/depID/status`).Match([]byte(r.URL.Path)):
+			status := ApplicationDeploymentInProgress
+			if atomic.AddInt32(&nbStatusCalls, 1) > 2 {
+				status = ApplicationDeployed
+			}
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"data":%q}`, status)))
+		default:
+			t.Errorf("Unexpected call for request %+v", r)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL, "", "", "", true)
+	assert.NilError(t, err)
+	client.(*a4cClient).statusRegistry.interval = 10 * time.Millisecond
+
+	var lock sync.Mutex
+	statuses := make(map[string][]string)
+	newCallback := func(name string) DeploymentStatusCallback {
+		return func(appID, envID, status string, err error) {
+			assert.NilError(t, err)
+			lock.Lock()
+			defer lock.Unlock()
+			statuses[name] = append(statuses[name], status)
+		}
+	}
+	unregister1 := client.RegisterDeploymentStatusCallback("app", "env", newCallback("cb1"))
+	unregister2 := client.RegisterDeploymentStatusCallback("app", "env", newCallback("cb2"))
+
+	assert.Assert(t, waitFor(time.Second, func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(statuses["cb1"]) == 2 && len(statuses["cb2"]) == 2
+	}), "callbacks not called as expected: %v", statuses)
+	unregister1()
+	unregister2()
+	unregister2()
+
+	lock.Lock()
+	assert.DeepEqual(t, statuses["cb1"], []string{ApplicationDeploymentInProgress, ApplicationDeployed})
+	assert.DeepEqual(t, statuses["cb2"], []string{ApplicationDeploymentInProgress, ApplicationDeployed})
+	lock.Unlock()
+
+	registry := client.(*a4cClient).statusRegistry
+	registry.lock.Lock()
+	assert.Assert(t, registry.cancel == nil)
+	registry.lock.Unlock()
+}
+
+func Test_a4cClient_RegisterDeploymentStatusCallbackFollower(t *testing.T) {
+	var nbCalls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&nbCalls, 1)
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+	}))
+	defer ts.Close()
+
+	lock := leader.NewInMemoryLock(time.Minute)
+	isLeader, err := lock.Candidate("leader").IsLeader(context.Background())
+	assert.NilError(t, err)
+	assert.Assert(t, isLeader)
+	client, err := NewClient(ts.URL, "", "", "", true, WithLeaderElector(lock.Candidate("follower")))
+	assert.NilError(t, err)
+	client.(*a4cClient).statusRegistry.interval = 10 * time.Millisecond
+
+	unregister := client.RegisterDeploymentStatusCallback("app", "env", func(appID, envID, status string, err error) {
+		t.Errorf("Unexpected callback call with status %q and error %v", status, err)
+	})
+	time.Sleep(100 * time.Millisecond)
+	unregister()
+	assert.Equal(t, atomic.LoadInt32(&nbCalls), int32(0))
+}
+
+func waitFor(timeout time.Duration, condition func() bool) bool {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if condition() {
+			return true
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	return condition()
+}
@@ -0,0 +1,191 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+func (d *deploymentService) getAvailableSubstitutions(ctx context.Context, appID, envID string) (DeploymentSubstitutions, error) {
+	request, err := d.client.NewRequest(ctx,
+		"GET",
+		fmt.Sprintf("%s/applications/%s/environments/%s/deployment-topology", a4CRestAPIPrefix, appID, envID),
+		nil,
+	)
+	if err != nil {
+		return DeploymentSubstitutions{}, errors.Wrapf(err, "Cannot create a request to get the deployment topology for application '%s' on environment '%s'", appID, envID)
+	}
+
+	var res struct {
+		Data struct {
+			AvailableSubstitutions DeploymentSubstitutions `json:"availableSubstitutions"`
+		} `json:"data"`
+	}
+	response, err := d.client.Do(request)
+	if err != nil {
+		return DeploymentSubstitutions{}, errors.Wrapf(err, "Cannot send a request to get the deployment topology for application '%s' on environment '%s'", appID, envID)
+	}
+	err = ReadA4CResponse(response, &res)
+	return res.Data.AvailableSubstitutions, errors.Wrapf(err, "Cannot get the deployment topology for application '%s' on environment '%s'", appID, envID)
+}
+
+// GetMatchingServices returns services that could be bound to the given node of a deployment topology
+//
+// A location should have been set on the deployment topology to compute matching services.
+func (d *deploymentService) GetMatchingServices(ctx context.Context, appID, envID, nodeName string) ([]LocationResourceTemplate, error) {
+	substitutions, err := d.getAvailableSubstitutions(ctx, appID, envID)
+	if err != nil {
+		return nil, err
+	}
+
+	var services []LocationResourceTemplate
+	for _, resourceID := range substitutions.AvailableSubstitutions[nodeName] {
+		resource, ok := substitutions.SubstitutionsTemplates[resourceID]
+		if ok && resource.Service {
+			services = append(services, resource)
+		}
+	}
+	return services, nil
+}
+
+// GetSubstitutionCandidates returns a map of node names to the location resources (services or
+// on-demand resources) that could substitute them in the deployment topology
+//
+// A location should have been set on the deployment topology to compute substitution candidates.
+func (d *deploymentService) GetSubstitutionCandidates(ctx context.Context, appID, envID string) (map[string][]LocationResourceTemplate, error) {
+	substitutions, err := d.getAvailableSubstitutions(ctx, appID, envID)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make(map[string][]LocationResourceTemplate, len(substitutions.AvailableSubstitutions))
+	for nodeName, resourceIDs := range substitutions.AvailableSubstitutions {
+		for _, resourceID := range resourceIDs {
+			resource, ok := substitutions.SubstitutionsTemplates[resourceID]
+			if !ok {
+				return nil, errors.Errorf("Location resource '%s' available for node '%s' not found in the deployment topology for application '%s' on environment '%s'",
+					resourceID, nodeName, appID, envID)
+			}
+			candidates[nodeName] = append(candidates[nodeName], resource)
+		}
+	}
+	return candidates, nil
+}
+
+// SetNodeSubstitution selects the location resource substituting the given node of a deployment topology
+func (d *deploymentService) SetNodeSubstitution(ctx context.Context, appID, envID, nodeName, resourceID string) error {
+	err := d.setNodeSubstitution(ctx, appID, envID, nodeName, resourceID)
+	return errors.Wrapf(err, "Cannot substitute node '%s' by location resource '%s' for application '%s' on environment '%s'", nodeName, resourceID, appID, envID)
+}
+
+// BindNodeToService binds the given node of a deployment topology to an existing service
+func (d *deploymentService) BindNodeToService(ctx context.Context, appID, envID, nodeName, serviceResourceID string) error {
+	err := d.setNodeSubstitution(ctx, appID, envID, nodeName, serviceResourceID)
+	return errors.Wrapf(err, "Cannot bind node '%s' to service '%s' for application '%s' on environment '%s'", nodeName, serviceResourceID, appID, envID)
+}
+
+func (d *deploymentService) setNodeSubstitution(ctx context.Context, appID, envID, nodeName, resourceID string) error {
+	request, err := d.client.NewRequest(ctx,
+		"POST",
+		fmt.Sprintf("%s/applications/%s/environments/%s/deployment-topology/substitutions/%s?locationResourceTemplateId=%s",
+			a4CRestAPIPrefix, appID, envID, nodeName, url.QueryEscape(resourceID)),
+		nil,
+	)
+	if err != nil {
+		return errors.Wrap(err, "Cannot create a request to set a node substitution")
+	}
+	response, err := d.client.Do(request)
+	if err != nil {
+		return errors.Wrap(err, "Cannot send a request to set a node substitution")
+	}
+	return ReadA4CResponse(response, nil)
+}
+
+// UnbindNodeFromService unbinds the given node of a deployment topology from the service it is bound to
+func (d *deploymentService) UnbindNodeFromService(ctx context.Context, appID, envID, nodeName string) error {
+	request, err := d.client.NewRequest(ctx,
+		"DELETE",
+		fmt.Sprintf("%s/applications/%s/environments/%s/deployment-topology/substitutions/%s",
+			a4CRestAPIPrefix, appID, envID, nodeName),
+		nil,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot create a request to unbind node '%s' from its service", nodeName)
+	}
+	response, err := d.client.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot send a request to unbind node '%s' from its service", nodeName)
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Cannot unbind node '%s' from its service for application '%s' on environment '%s'", nodeName, appID, envID)
+}
+
+// GetMatchedResources returns a map of node names to the location resource template
+// currently matched by this node in the deployment topology.
+//
+// A location should have been set on the deployment topology to match nodes to location resources.
+// Nodes not matched to any location resource are not part of the returned map.
+func (d *deploymentService) GetMatchedResources(ctx context.Context, appID, envID string) (map[string]LocationResourceTemplate, error) {
+	matched, _, err := d.getMatchedResources(ctx, appID, envID)
+	return matched, err
+}
+
+// getMatchedResources returns a map of node names to the location resource template currently matched
+// by this node in the deployment topology, and the node templates of the deployment topology
+func (d *deploymentService) getMatchedResources(ctx context.Context, appID, envID string) (map[string]LocationResourceTemplate, map[string]NodeTemplate, error) {
+	request, err := d.client.NewRequest(ctx,
+		"GET",
+		fmt.Sprintf("%s/applications/%s/environments/%s/deployment-topology", a4CRestAPIPrefix, appID, envID),
+		nil,
+	)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "Cannot create a request to get the deployment topology for application '%s' on environment '%s'", appID, envID)
+	}
+
+	var res struct {
+		Data struct {
+			Topology struct {
+				// Map of node names to the ID of the location resource they are substituted by
+				SubstitutedNodes map[string]string       `json:"substitutedNodes"`
+				NodeTemplates    map[string]NodeTemplate `json:"nodeTemplates"`
+			} `json:"topology"`
+			// Map of location resources IDs to location resources
+			LocationResourceTemplates map[string]LocationResourceTemplate `json:"locationResourceTemplates"`
+		} `json:"data"`
+	}
+	response, err := d.client.Do(request)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "Cannot send a request to get the deployment topology for application '%s' on environment '%s'", appID, envID)
+	}
+	err = ReadA4CResponse(response, &res)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "Cannot get the deployment topology for application '%s' on environment '%s'", appID, envID)
+	}
+
+	matched := make(map[string]LocationResourceTemplate, len(res.Data.Topology.SubstitutedNodes))
+	for nodeName, resourceID := range res.Data.Topology.SubstitutedNodes {
+		resource, ok := res.Data.LocationResourceTemplates[resourceID]
+		if !ok {
+			return nil, nil, errors.Errorf("Location resource '%s' matched by node '%s' not found in the deployment topology for application '%s' on environment '%s'",
+				resourceID, nodeName, appID, envID)
+		}
+		matched[nodeName] = resource
+	}
+	return matched, res.Data.Topology.NodeTemplates, nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func newHTTPServerTestSubstitutions(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/deployment-topology/substitutions/Database`).Match([]byte(r.URL.Path)):
+			if r.Method == http.MethodPost && r.URL.Query().Get("locationResourceTemplateId") != "srv1" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":{"code": 400,"message":"unexpected resource"}}`))
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{}`))
+		case regexp.MustCompile(`.*/deployment-topology/substitutions/.*`).Match([]byte(r.URL.Path)):
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"node not found"}}`))
+		case regexp.MustCompile(`.*/applications/app/environments/env/deployment-topology`).Match([]byte(r.URL.Path)):
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"data":{
+				"topology":{"substitutedNodes":{"Compute":"res1"}},
+				"locationResourceTemplates":{
+					"res1":{"id":"res1","name":"Compute","enabled":true,"service":false,"template":{"type":"tosca.nodes.Compute",
+						"properties":[{"key":"flavor","value":{"value":"m1.small","definition":false}}]}}
+				},
+				"availableSubstitutions":{
+				"availableSubstitutions":{"Database":["srv1","res1"]},
+				"substitutionsTemplates":{
+					"srv1":{"id":"srv1","name":"MyDB","enabled":true,"service":true,"template":{"type":"org.db.Database"}},
+					"res1":{"id":"res1","name":"Compute","enabled":true,"service":false,"template":{"type":"tosca.nodes.Compute"}}
+				}}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		}
+	}))
+}
+
+func Test_deploymentService_GetMatchingServices(t *testing.T) {
+	ts := newHTTPServerTestSubstitutions(t)
+	defer ts.Close()
+
+	d := &deploymentService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	services, err := d.GetMatchingServices(context.Background(), "app", "env", "Database")
+	assert.NilError(t, err)
+	assert.Equal(t, len(services), 1)
+	assert.Equal(t, services[0].ID, "srv1")
+	assert.Equal(t, services[0].Template.Type, "org.db.Database")
+
+	services, err = d.GetMatchingServices(context.Background(), "app", "env", "Unknown")
+	assert.NilError(t, err)
+	assert.Equal(t, len(services), 0)
+
+	_, err = d.GetMatchingServices(context.Background(), "unknown", "env", "Database")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func Test_deploymentService_BindUnbindNodeToService(t *testing.T) {
+	ts := newHTTPServerTestSubstitutions(t)
+	defer ts.Close()
+
+	d := &deploymentService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	assert.NilError(t, d.BindNodeToService(context.Background(), "app", "env", "Database", "srv1"))
+	assert.ErrorContains(t, d.BindNodeToService(context.Background(), "app", "env", "Database", "other"), "unexpected resource")
+	assert.ErrorContains(t, d.BindNodeToService(context.Background(), "app", "env", "Unknown", "srv1"), "node not found")
+	assert.NilError(t, d.UnbindNodeFromService(context.Background(), "app", "env", "Database"))
+	assert.ErrorContains(t, d.UnbindNodeFromService(context.Background(), "app", "env", "Unknown"), "node not found")
+}
+
+func Test_deploymentService_GetMatchedResources(t *testing.T) {
+	ts := newHTTPServerTestSubstitutions(t)
+	defer ts.Close()
+
+	d := &deploymentService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	resources, err := d.GetMatchedResources(context.Background(), "app", "env")
+	assert.NilError(t, err)
+	assert.Equal(t, len(resources), 1)
+	compute, ok := resources["Compute"]
+	assert.Assert(t, ok)
+	assert.Equal(t, compute.ID, "res1")
+	assert.Equal(t, compute.Template.Type, "tosca.nodes.Compute")
+	assert.Equal(t, len(compute.Template.Properties), 1)
+	assert.Equal(t, compute.Template.Properties[0].Key, "flavor")
+	assert.Equal(t, compute.Template.Properties[0].Value.Value, "m1.small")
+
+	_, err = d.GetMatchedResources(context.Background(), "unknown", "env")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func Test_deploymentService_SubstitutionCandidates(t *testing.T) {
+	ts := newHTTPServerTestSubstitutions(t)
+	defer ts.Close()
+
+	d := &deploymentService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	candidates, err := d.GetSubstitutionCandidates(context.Background(), "app", "env")
+	assert.NilError(t, err)
+	assert.Equal(t, len(candidates), 1)
+	assert.Equal(t, len(candidates["Database"]), 2)
+	assert.Equal(t, candidates["Database"][0].ID, "srv1")
+	assert.Equal(t, candidates["Database"][1].ID, "res1")
+	assert.Equal(t, candidates["Database"][1].Service, false)
+
+	assert.NilError(t, d.SetNodeSubstitution(context.Background(), "app", "env", "Database", "srv1"))
+	err = d.SetNodeSubstitution(context.Background(), "app", "env", "Database", "other")
+	assert.ErrorContains(t, err, "Cannot substitute node 'Database' by location resource 'other'")
+	assert.ErrorContains(t, err, "unexpected resource")
+}
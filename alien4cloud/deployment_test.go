@@ -270,7 +270,7 @@ func Test_deploymentService_UpdateApplication(t *testing.T) {
 }
 
 func Test_deploymentService_GetDeploymentList(t *testing.T) {
-	mt := &Time{time.Now()}
+	mt := &Time{Time: time.Now()}
 	b, err := json.Marshal(mt)
 	assert.NilError(t, err)
 	err = json.Unmarshal(b, mt)
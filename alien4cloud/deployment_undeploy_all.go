@@ -0,0 +1,122 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// UndeployAllOptions allows to configure DeploymentService.UndeployAllEnvironments()
+type UndeployAllOptions struct {
+	// Concurrency is the maximum number of environments undeployed concurrently, defaults to 8
+	Concurrency int
+	// Timeout is the maximum duration of the wait for the end of each undeployment, independently of the context deadline.
+	// No timeout is applied if it is 0.
+	Timeout time.Duration
+	// Progress is an optional function called with each observed status of an environment,
+	// it may be called concurrently for different environments
+	Progress func(envID, status string)
+}
+
+// UndeployResult is the result of the undeployment of an environment by DeploymentService.UndeployAllEnvironments()
+type UndeployResult struct {
+	EnvID   string
+	EnvName string
+	// Status is the last known status of the environment, ApplicationUndeployed if it was undeployed
+	Status string
+	// Skipped is true if the environment was not deployed
+	Skipped bool
+	// Err is the error which occurred while undeploying the environment if any
+	Err error
+}
+
+// UndeployAllEnvironments undeploys all deployed environments of an application and waits until they are undeployed.
+//
+// Environments are undeployed concurrently. Results are returned for each environment sorted by environment name,
+// a *BulkError indexed by environment ID is returned along with them if some environments could not be undeployed.
+func (d *deploymentService) UndeployAllEnvironments(ctx context.Context, appID string, opts UndeployAllOptions) ([]UndeployResult, error) {
+	var envs []Environment
+	for {
+		page, total, err := d.client.applicationService.SearchEnvironments(ctx, appID, SearchRequest{From: len(envs), Size: MaxPageSize})
+		if err != nil {
+			return nil, errors.Wrapf(err, "Unable to get environments of application %q", appID)
+		}
+		envs = append(envs, page...)
+		if len(page) == 0 || len(envs) >= total {
+			break
+		}
+	}
+
+	results := make(map[string]*UndeployResult, len(envs))
+	var toUndeploy []string
+	for _, env := range envs {
+		result := &UndeployResult{EnvID: env.ID, EnvName: env.Name, Status: env.Status}
+		results[env.ID] = result
+		if env.Status == ApplicationUndeployed {
+			result.Skipped = true
+			continue
+		}
+		toUndeploy = append(toUndeploy, env.ID)
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = bulkConcurrency
+	}
+	var lock sync.Mutex
+	err := runBulkWithConcurrency(ctx, concurrency, toUndeploy, func(ctx context.Context, envID string) error {
+		status, err := d.undeployAndWait(ctx, appID, envID, opts)
+		lock.Lock()
+		defer lock.Unlock()
+		if status != "" {
+			results[envID].Status = status
+		}
+		return err
+	})
+	if bulkErr, ok := err.(*BulkError); ok {
+		for envID, envErr := range bulkErr.Errors {
+			results[envID].Err = envErr
+		}
+	}
+
+	sorted := make([]UndeployResult, 0, len(results))
+	for _, result := range results {
+		sorted = append(sorted, *result)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].EnvName < sorted[j].EnvName
+	})
+	return sorted, err
+}
+
+// undeployAndWait undeploys an environment and waits until it is undeployed, it returns the last status reached
+func (d *deploymentService) undeployAndWait(ctx context.Context, appID, envID string, opts UndeployAllOptions) (string, error) {
+	err := d.UndeployApplication(ctx, appID, envID)
+	if err != nil {
+		return "", err
+	}
+	waitOpts := WaitUntilStateOptions{FailureStatuses: []string{ApplicationError}, Timeout: opts.Timeout}
+	if opts.Progress != nil {
+		waitOpts.Progress = func(status string) {
+			opts.Progress(envID, status)
+		}
+	}
+	return d.WaitUntilStateIsWithOptions(ctx, appID, envID, waitOpts, ApplicationUndeployed)
+}
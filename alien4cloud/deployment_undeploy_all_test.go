@@ -0,0 +1,82 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_deploymentService_UndeployAllEnvironments(t *testing.T) {
+	var lock sync.Mutex
+	undeployed := make(map[string]bool)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		switch {
+		case r.URL.Path == "/rest/latest/applications/app/environments/search":
+			_, _ = w.Write([]byte(`{"data":{"data":[
+				{"id":"e1","name":"dev","status":"DEPLOYED"},
+				{"id":"e2","name":"prod","status":"UNDEPLOYED"},
+				{"id":"e3","name":"qa","status":"FAILURE"}
+			],"totalResults":3}}`))
+		case r.URL.Path == "/rest/latest/applications/app/environments/e1/deployment" && r.Method == http.MethodDelete:
+			undeployed["e1"] = true
+			_, _ = w.Write([]byte(`{"data":null}`))
+		case r.URL.Path == "/rest/latest/applications/app/environments/e3/deployment" && r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"error":{"code":500,"message":"orchestrator is unreachable"}}`))
+		case r.URL.Path == "/rest/latest/applications/app/environments/e1/active-deployment-monitored":
+			if undeployed["e1"] {
+				_, _ = w.Write([]byte(`{"data":null}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":{"deployment":{"id":"dep1"}}}`))
+		default:
+			t.Errorf("Unexpected call for request %+v", r)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL, "", "", "", false)
+	assert.NilError(t, err)
+	d := &deploymentService{client: client.(*a4cClient)}
+
+	var progress []string
+	results, err := d.UndeployAllEnvironments(context.Background(), "app", UndeployAllOptions{
+		Concurrency: 1,
+		Progress: func(envID, status string) {
+			progress = append(progress, envID+":"+status)
+		},
+	})
+	var bulkErr *BulkError
+	assert.Assert(t, errors.As(err, &bulkErr), "unexpected error %v", err)
+	assert.Equal(t, len(bulkErr.Errors), 1)
+	assert.ErrorContains(t, bulkErr.Errors["e3"], "orchestrator is unreachable")
+	assert.DeepEqual(t, progress, []string{"e1:" + ApplicationUndeployed})
+
+	assert.Equal(t, len(results), 3)
+	assert.DeepEqual(t, results[0], UndeployResult{EnvID: "e1", EnvName: "dev", Status: ApplicationUndeployed})
+	assert.DeepEqual(t, results[1], UndeployResult{EnvID: "e2", EnvName: "prod", Status: ApplicationUndeployed, Skipped: true})
+	assert.Equal(t, results[2].EnvID, "e3")
+	assert.Equal(t, results[2].Status, ApplicationError)
+	assert.Assert(t, results[2].Err != nil)
+}
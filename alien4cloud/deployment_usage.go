@@ -0,0 +1,60 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// deploymentsSearchPageSize is the number of deployments retrieved per request by GetEnvironmentsDeployedOn
+const deploymentsSearchPageSize = 100
+
+// GetEnvironmentsDeployedOn returns application environments having an active deployment on the given orchestrator.
+//
+// If locationID is not empty, only environments deployed on this location are returned.
+// It allows to know which applications would be impacted by the maintenance of a location or orchestrator.
+func (d *deploymentService) GetEnvironmentsDeployedOn(ctx context.Context, orchestratorID, locationID string) ([]EnvironmentRef, error) {
+	var envs []EnvironmentRef
+	seen := make(map[EnvironmentRef]bool)
+	it := d.DeploymentsIterator(ctx, DeploymentSearchRequest{OrchestratorID: orchestratorID, Size: deploymentsSearchPageSize})
+	for it.Next() {
+		deployment := it.Deployment()
+		// Deployments having an end date are undeployed
+		if !deployment.EndDate.IsZero() || deployment.OrchestratorID != orchestratorID ||
+			(locationID != "" && !containsString(deployment.LocationIds, locationID)) {
+			continue
+		}
+		env := EnvironmentRef{AppID: deployment.SourceID, EnvID: deployment.EnvironmentID}
+		if !seen[env] {
+			seen[env] = true
+			envs = append(envs, env)
+		}
+	}
+	if it.Err() != nil {
+		return nil, errors.Wrapf(it.Err(), "Cannot search deployments on orchestrator %q", orchestratorID)
+	}
+	return envs, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_deploymentService_GetEnvironmentsDeployedOn(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/rest/latest/deployments/search")
+		if r.URL.Query().Get("orchestratorId") != "orch1" {
+			_, _ = w.Write([]byte(`{"data":{"data":[],"totalResults":0}}`))
+			return
+		}
+		switch r.URL.Query().Get("from") {
+		case "0":
+			_, _ = w.Write([]byte(`{"data":{"totalResults":4,"data":[
+				{"deployment":{"id":"d1","sourceId":"app1","environmentId":"env1","orchestratorId":"orch1","locationIds":["loc1"]}},
+				{"deployment":{"id":"d2","sourceId":"app2","environmentId":"env2","orchestratorId":"orch1","locationIds":["loc2"]}}]}}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"data":{"totalResults":4,"data":[
+				{"deployment":{"id":"d3","sourceId":"app3","environmentId":"env3","orchestratorId":"orch1","locationIds":["loc1"],"endDate":1600000000000}},
+				{"deployment":{"id":"d4","sourceId":"app1","environmentId":"env1","orchestratorId":"orch1","locationIds":["loc1"]}}]}}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+		}
+	}))
+	defer ts.Close()
+
+	d := &deploymentService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	ctx := context.Background()
+
+	envs, err := d.GetEnvironmentsDeployedOn(ctx, "orch1", "")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, envs, []EnvironmentRef{{AppID: "app1", EnvID: "env1"}, {AppID: "app2", EnvID: "env2"}})
+
+	envs, err = d.GetEnvironmentsDeployedOn(ctx, "orch1", "loc1")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, envs, []EnvironmentRef{{AppID: "app1", EnvID: "env1"}})
+
+	envs, err = d.GetEnvironmentsDeployedOn(ctx, "orch2", "")
+	assert.NilError(t, err)
+	assert.Equal(t, len(envs), 0)
+}
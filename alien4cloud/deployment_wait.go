@@ -0,0 +1,176 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultWaitUntilStatePollInterval     = time.Second
+	defaultWaitUntilStateMaxPollInterval  = 15 * time.Second
+	defaultWaitUntilStatePollMultiplier   = 1.5
+	defaultWaitUntilStatePollJitter       = 0.2
+	defaultWaitUntilStateSlowResponseTime = 2 * time.Second
+)
+
+// WaitUntilStateOptions allows to configure DeploymentService.WaitUntilStateIsWithOptions()
+type WaitUntilStateOptions struct {
+	// FailureStatuses are statuses that stop the wait with an *UnexpectedStatusError,
+	// typically ApplicationError when waiting for ApplicationDeployed
+	FailureStatuses []string
+	// Timeout is the maximum duration of the wait, independently of the context deadline.
+	// No timeout is applied if it is 0.
+	Timeout time.Duration
+	// Progress is an optional function called with each observed status
+	Progress func(status string)
+	// PollInterval is the delay before the second status check, defaults to 1s.
+	// The delay is then multiplied by PollMultiplier after each check up to MaxPollInterval.
+	PollInterval time.Duration
+	// MaxPollInterval is the maximum delay between two status checks, defaults to 15s
+	MaxPollInterval time.Duration
+	// PollMultiplier is the factor applied to the delay after each status check, defaults to 1.5.
+	// A value of 1 polls at a fixed PollInterval.
+	PollMultiplier float64
+	// PollJitter is the fraction of the delay randomly added or removed to spread status checks of
+	// concurrent waiters, defaults to 0.2. A negative value disables the jitter.
+	PollJitter float64
+	// SlowResponseTime is the duration of a status check above which Alien4Cloud is considered overloaded,
+	// defaults to 2s. The delay before the next check is then at least twice the duration of the slow check.
+	SlowResponseTime time.Duration
+}
+
+// pollBackoff computes delays between status checks
+type pollBackoff struct {
+	next       time.Duration
+	max        time.Duration
+	multiplier float64
+	jitter     float64
+	slow       time.Duration
+}
+
+func newPollBackoff(opts WaitUntilStateOptions) *pollBackoff {
+	b := &pollBackoff{
+		next:       opts.PollInterval,
+		max:        opts.MaxPollInterval,
+		multiplier: opts.PollMultiplier,
+		jitter:     opts.PollJitter,
+		slow:       opts.SlowResponseTime,
+	}
+	if b.next <= 0 {
+		b.next = defaultWaitUntilStatePollInterval
+	}
+	if b.max <= 0 {
+		b.max = defaultWaitUntilStateMaxPollInterval
+	}
+	if b.max < b.next {
+		b.max = b.next
+	}
+	if b.multiplier <= 0 {
+		b.multiplier = defaultWaitUntilStatePollMultiplier
+	}
+	if b.jitter == 0 {
+		b.jitter = defaultWaitUntilStatePollJitter
+	}
+	if b.slow <= 0 {
+		b.slow = defaultWaitUntilStateSlowResponseTime
+	}
+	return b
+}
+
+// delay returns the delay to wait before the next status check given the duration of the last one
+func (b *pollBackoff) delay(lastCheck time.Duration) time.Duration {
+	delay := b.next
+	if lastCheck > b.slow && 2*lastCheck > delay {
+		// Slow down while Alien4Cloud is overloaded
+		delay = 2 * lastCheck
+		if delay > b.max {
+			delay = b.max
+		}
+	}
+	b.next = time.Duration(float64(delay) * b.multiplier)
+	if b.next > b.max {
+		b.next = b.max
+	}
+	if b.jitter > 0 {
+		delay += time.Duration((rand.Float64()*2 - 1) * b.jitter * float64(delay))
+	}
+	return delay
+}
+
+// UnexpectedStatusError is returned by DeploymentService.WaitUntilStateIsWithOptions() when
+// one of the WaitUntilStateOptions FailureStatuses is reached.
+//
+// It may be retrieved from returned errors using errors.As.
+type UnexpectedStatusError struct {
+	// Status is the reached failure status
+	Status string
+	// Expected are the statuses that were waited for
+	Expected []string
+}
+
+func (e *UnexpectedStatusError) Error() string {
+	return fmt.Sprintf("deployment reached status %s while waiting for %s", e.Status, strings.Join(e.Expected, ", "))
+}
+
+// WaitUntilStateIsWithOptions Waits until the state of an Alien4Cloud application is one of the given statuses as parameter and returns the actual status.
+func (d *deploymentService) WaitUntilStateIsWithOptions(ctx context.Context, appID string, envID string, opts WaitUntilStateOptions, statuses ...string) (string, error) {
+	if len(statuses) == 0 {
+		return "", errors.New("at least one status should be given")
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+	backoff := newPollBackoff(opts)
+	for {
+		checkStart := time.Now()
+		a4cStatus, err := d.GetDeploymentStatus(ctx, appID, envID)
+		checkDuration := time.Since(checkStart)
+
+		if err != nil {
+			return "", errors.Wrapf(err, "Unable to get status from application %s", appID)
+		}
+
+		if opts.Progress != nil {
+			opts.Progress(a4cStatus)
+		}
+
+		for _, status := range statuses {
+			if a4cStatus == status {
+				return a4cStatus, nil
+			}
+		}
+		for _, status := range opts.FailureStatuses {
+			if a4cStatus == status {
+				return a4cStatus, errors.WithStack(&UnexpectedStatusError{Status: a4cStatus, Expected: statuses})
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", errors.Wrapf(classifyTimeout(fmt.Sprintf("wait for statuses %v of application %s", statuses, appID), ctx.Err()),
+				"Unable to get status from application %s", appID)
+		case <-time.After(backoff.delay(checkDuration)):
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_deploymentService_WaitUntilStateIsWithOptions(t *testing.T) {
+	var nbStatusCalls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/applications/.*/environments/.*/active-deployment-monitored`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"data":{"deployment":{"id":"%s"}}}`, regexp.MustCompile(`.*/applications/(.*)/environments/.*`).FindStringSubmatch(r.URL.Path)[1])))
+		case regexp.MustCompile(`.*/deployments/failing/status`).Match([]byte(r.URL.Path)):
+			status := ApplicationDeploymentInProgress
+			if atomic.AddInt32(&nbStatusCalls, 1) > 1 {
+				status = ApplicationError
+			}
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"data":"%s"}`, status)))
+		case regexp.MustCompile(`.*/deployments/.*/status`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"data":"%s"}`, ApplicationDeploymentInProgress)))
+		default:
+			t.Errorf("Unexpected call for request %+v", r)
+		}
+	}))
+	defer ts.Close()
+
+	d := &deploymentService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+
+	var observed []string
+	opts := WaitUntilStateOptions{
+		FailureStatuses: []string{ApplicationError},
+		Progress:        func(status string) { observed = append(observed, status) },
+	}
+	status, err := d.WaitUntilStateIsWithOptions(context.Background(), "failing", "env", opts, ApplicationDeployed)
+	assert.Equal(t, status, ApplicationError)
+	var statusErr *UnexpectedStatusError
+	assert.Assert(t, errors.As(err, &statusErr))
+	assert.Equal(t, statusErr.Status, ApplicationError)
+	assert.DeepEqual(t, statusErr.Expected, []string{ApplicationDeployed})
+	assert.DeepEqual(t, observed, []string{ApplicationDeploymentInProgress, ApplicationError})
+
+	start := time.Now()
+	_, err = d.WaitUntilStateIsWithOptions(context.Background(), "pending", "env", WaitUntilStateOptions{Timeout: 50 * time.Millisecond}, ApplicationDeployed)
+	assert.Assert(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Assert(t, time.Since(start) < defaultWaitUntilStatePollInterval)
+}
+
+func Test_pollBackoff(t *testing.T) {
+	b := newPollBackoff(WaitUntilStateOptions{PollJitter: -1})
+	var delays []time.Duration
+	for i := 0; i < 9; i++ {
+		delays = append(delays, b.delay(10*time.Millisecond))
+	}
+	assert.DeepEqual(t, delays, []time.Duration{
+		time.Second, 1500 * time.Millisecond, 2250 * time.Millisecond, 3375 * time.Millisecond, 5062500 * time.Microsecond,
+		7593750 * time.Microsecond, 11390625 * time.Microsecond, 15 * time.Second, 15 * time.Second,
+	})
+
+	// Fixed interval
+	b = newPollBackoff(WaitUntilStateOptions{PollInterval: 100 * time.Millisecond, PollMultiplier: 1, PollJitter: -1})
+	assert.Equal(t, b.delay(0), 100*time.Millisecond)
+	assert.Equal(t, b.delay(0), 100*time.Millisecond)
+
+	// Slow responses increase the delay up to the maximum
+	b = newPollBackoff(WaitUntilStateOptions{PollJitter: -1, MaxPollInterval: 10 * time.Second})
+	assert.Equal(t, b.delay(1500*time.Millisecond), time.Second)
+	assert.Equal(t, b.delay(2500*time.Millisecond), 5*time.Second)
+	assert.Equal(t, b.delay(0), 7500*time.Millisecond)
+	assert.Equal(t, b.delay(6*time.Second), 10*time.Second)
+
+	// Jitter stays within the given fraction of the delay
+	b = newPollBackoff(WaitUntilStateOptions{PollMultiplier: 1, PollJitter: 0.5})
+	for i := 0; i < 100; i++ {
+		delay := b.delay(0)
+		assert.Assert(t, delay >= 500*time.Millisecond && delay <= 1500*time.Millisecond, "unexpected delay %s", delay)
+	}
+}
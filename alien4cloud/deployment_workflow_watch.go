@@ -0,0 +1,101 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// WorkflowStepCallback is a function called when the status of a workflow step changes.
+// previousStatus is empty when the step is seen for the first time.
+// Known statuses are StepStarted, StepCompletedSuccessfull and StepCompletedWithError.
+type WorkflowStepCallback func(stepName, previousStatus, status string)
+
+const workflowStepsPollingPeriod = 2 * time.Second
+
+// RunWorkflowWatch runs a workflow asynchronously like RunWorkflowAsyncWithParameters and, in addition,
+// notifies status transitions of workflow steps using stepCallback.
+//
+// Steps statuses are retrieved by polling the workflow execution of the deployment.
+// All step transitions known when the execution ends are notified before callback is called.
+func (d *deploymentService) RunWorkflowWatch(ctx context.Context, a4cAppID string, a4cEnvID string, workflowName string,
+	parameters map[string]interface{}, stepCallback WorkflowStepCallback, callback ExecutionCallback) (string, error) {
+
+	deploymentID, err := d.GetCurrentDeploymentID(ctx, a4cAppID, a4cEnvID)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get deployment of application %q, environment %q", a4cAppID, a4cEnvID)
+	}
+
+	watchCtx, cancelWatch := context.WithCancel(ctx)
+	stepsDone := make(chan struct{})
+	executionID, err := d.RunWorkflowAsyncWithParameters(ctx, a4cAppID, a4cEnvID, workflowName, parameters, func(exec *Execution, err error) {
+		cancelWatch()
+		<-stepsDone
+		callback(exec, err)
+	})
+	if err != nil {
+		cancelWatch()
+		return "", err
+	}
+
+	go func() {
+		defer close(stepsDone)
+		stepStatus := make(map[string]string)
+		for {
+			if d.client.waitLeadership(watchCtx) == nil {
+				d.notifyStepTransitions(ctx, deploymentID, executionID, stepStatus, stepCallback)
+			}
+			select {
+			case <-watchCtx.Done():
+				// Execution is over, notify last transitions unless the caller cancelled the watch
+				if ctx.Err() == nil {
+					d.notifyStepTransitions(ctx, deploymentID, executionID, stepStatus, stepCallback)
+				}
+				return
+			case <-time.After(workflowStepsPollingPeriod):
+			}
+		}
+	}()
+
+	return executionID, nil
+}
+
+// notifyStepTransitions retrieves steps statuses of the given execution and calls stepCallback for each change
+// compared to the known statuses which are updated accordingly.
+// Errors are ignored, statuses will be retrieved on next call.
+func (d *deploymentService) notifyStepTransitions(ctx context.Context, deploymentID, executionID string,
+	known map[string]string, stepCallback WorkflowStepCallback) {
+
+	wfExec, err := d.getWorkflowExecution(ctx, deploymentID)
+	if err != nil || wfExec.Execution.ID != executionID {
+		return
+	}
+	steps := make([]string, 0, len(wfExec.StepStatus))
+	for step := range wfExec.StepStatus {
+		steps = append(steps, step)
+	}
+	sort.Strings(steps)
+	for _, step := range steps {
+		status := wfExec.StepStatus[step]
+		if previous := known[step]; previous != status {
+			known[step] = status
+			stepCallback(step, previous, status)
+		}
+	}
+}
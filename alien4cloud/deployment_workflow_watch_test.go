@@ -0,0 +1,82 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_deploymentService_RunWorkflowWatch(t *testing.T) {
+	var nbWfExecCalls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/applications/app/environments/env/active-deployment-monitored`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":{"deployment":{"id":"depID"}}}`))
+		case regexp.MustCompile(`.*/applications/unknown/environments/env/active-deployment-monitored`).Match([]byte(r.URL.Path)):
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		case regexp.MustCompile(`.*/applications/app/environments/env/workflows/wf`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":"execID"}`))
+		case regexp.MustCompile(`.*/executions/execID`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":{"id":"execID","deploymentId":"depID","workflowName":"wf","status":"SUCCEEDED"}}`))
+		case regexp.MustCompile(`.*/workflow_execution/depID`).Match([]byte(r.URL.Path)):
+			if atomic.AddInt32(&nbWfExecCalls, 1) == 1 {
+				_, _ = w.Write([]byte(`{"data":{"execution":{"id":"execID","status":"RUNNING"},"stepStatus":{"create":"COMPLETED_SUCCESSFULL","start":"STARTED"}}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":{"execution":{"id":"execID","status":"SUCCEEDED"},"stepStatus":{"create":"COMPLETED_SUCCESSFULL","start":"COMPLETED_SUCCESSFULL"}}}`))
+		default:
+			t.Errorf("Unexpected call for request %+v", r)
+		}
+	}))
+	defer ts.Close()
+
+	d := &deploymentService{
+		client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL},
+	}
+
+	var transitions [][3]string
+	done := make(chan struct{})
+	var gotExec *Execution
+	execID, err := d.RunWorkflowWatch(context.Background(), "app", "env", "wf", nil,
+		func(stepName, previousStatus, status string) {
+			transitions = append(transitions, [3]string{stepName, previousStatus, status})
+		},
+		func(exec *Execution, err error) {
+			assert.NilError(t, err)
+			gotExec = exec
+			close(done)
+		})
+	assert.NilError(t, err)
+	assert.Equal(t, execID, "execID")
+	<-done
+
+	assert.Equal(t, gotExec.Status, WorkflowSucceeded)
+	assert.DeepEqual(t, transitions, [][3]string{
+		{"create", "", StepCompletedSuccessfull},
+		{"start", "", StepStarted},
+		{"start", StepStarted, StepCompletedSuccessfull},
+	})
+
+	_, err = d.RunWorkflowWatch(context.Background(), "unknown", "env", "wf", nil, nil, nil)
+	assert.ErrorContains(t, err, "not found")
+}
@@ -31,6 +31,9 @@ type EventService interface {
 	// Events are sorted by date in descending order. This call returns as well
 	// the total number of events on this application
 	GetEventsForApplicationEnvironment(ctx context.Context, environmentID string, fromIndex, size int) ([]Event, int, error)
+	// Calls the given callback for each new event of a deployed application environment
+	// until the given context is cancelled
+	SubscribeToEvents(ctx context.Context, environmentID string, callback EventCallback) error
 }
 
 type eventService struct {
@@ -0,0 +1,156 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+// EventCallback is a function called for each new event of an application environment.
+// If events could not be retrieved, the event is nil and err is not nil.
+type EventCallback func(event *Event, err error)
+
+// eventsPollInterval is the period at which new events are retrieved by subscriptions
+var eventsPollInterval = 5 * time.Second
+
+// eventsPageSize is the number of events retrieved per request by subscriptions
+const eventsPageSize = 100
+
+// SubscribeToEvents calls the given callback for each event of an application environment
+// created after this call, in chronological order, until the given context is cancelled.
+//
+// New events are detected by polling Alien4Cloud. Events already notified are tracked
+// using their date rather than their number, so that purging old events does not lead
+// to missed or duplicated notifications.
+// An error is returned only if the current events of the environment could not be retrieved,
+// errors occurring afterwards are given to the callback and polling continues.
+func (e *eventService) SubscribeToEvents(ctx context.Context, environmentID string, callback EventCallback) error {
+	var sub eventSubscription
+	if err := sub.init(ctx, e, environmentID); err != nil {
+		return err
+	}
+
+	interval := eventsPollInterval
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+			if e.client.waitLeadership(ctx) != nil {
+				return
+			}
+			newEvents, err := sub.poll(ctx, e, environmentID)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				callback(nil, err)
+				continue
+			}
+			for i := range newEvents {
+				callback(&newEvents[i], nil)
+			}
+		}
+	}()
+	return nil
+}
+
+// eventSubscription tracks events already notified by a subscription
+type eventSubscription struct {
+	// lastDate is the date of the most recent event notified
+	lastDate time.Time
+	// lastEvents are events notified having lastDate as date
+	lastEvents []Event
+}
+
+// init marks current events as known, that is all the events having the date of the most recent event
+func (s *eventSubscription) init(ctx context.Context, e *eventService, environmentID string) error {
+	for from := 0; ; from += eventsPageSize {
+		// Events are sorted by date in descending order
+		events, total, err := e.GetEventsForApplicationEnvironment(ctx, environmentID, from, eventsPageSize)
+		if err != nil {
+			return err
+		}
+		if from == 0 && len(events) > 0 {
+			s.lastDate = events[0].Date.Time
+		}
+		for _, event := range events {
+			if !event.Date.Equal(s.lastDate) {
+				return nil
+			}
+			s.lastEvents = append(s.lastEvents, event)
+		}
+		if len(events) == 0 || from+len(events) >= total {
+			return nil
+		}
+	}
+}
+
+// poll returns events created since the previous poll in chronological order
+func (s *eventSubscription) poll(ctx context.Context, e *eventService, environmentID string) ([]Event, error) {
+	var newEvents []Event
+	for from := 0; ; from += eventsPageSize {
+		// Events are sorted by date in descending order
+		events, total, err := e.GetEventsForApplicationEnvironment(ctx, environmentID, from, eventsPageSize)
+		if err != nil {
+			return nil, err
+		}
+		reachedKnownEvents := false
+		for _, event := range events {
+			if event.Date.Before(s.lastDate) || (event.Date.Equal(s.lastDate) && s.isKnown(event)) {
+				reachedKnownEvents = true
+				break
+			}
+			newEvents = append(newEvents, event)
+		}
+		if reachedKnownEvents || len(events) == 0 || from+len(events) >= total {
+			break
+		}
+	}
+	if len(newEvents) == 0 {
+		return nil, nil
+	}
+
+	// Reverse events to get them in chronological order
+	for i, j := 0, len(newEvents)-1; i < j; i, j = i+1, j-1 {
+		newEvents[i], newEvents[j] = newEvents[j], newEvents[i]
+	}
+
+	last := newEvents[len(newEvents)-1].Date.Time
+	if !last.Equal(s.lastDate) {
+		s.lastDate = last
+		s.lastEvents = nil
+	}
+	for _, event := range newEvents {
+		if event.Date.Equal(last) {
+			s.lastEvents = append(s.lastEvents, event)
+		}
+	}
+	return newEvents, nil
+}
+
+// isKnown returns true if the given event was already notified
+func (s *eventSubscription) isKnown(event Event) bool {
+	for _, known := range s.lastEvents {
+		if reflect.DeepEqual(known, event) {
+			return true
+		}
+	}
+	return false
+}
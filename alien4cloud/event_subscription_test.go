@@ -0,0 +1,149 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_eventService_SubscribeToEvents(t *testing.T) {
+	previousInterval := eventsPollInterval
+	eventsPollInterval = 10 * time.Millisecond
+	defer func() { eventsPollInterval = previousInterval }()
+
+	var mu sync.Mutex
+	now := time.Now()
+	newEvent := func(node string, offset int) Event {
+		return Event{NodeTemplateId: node, InstanceState: "started", Date: Time{Time: now.Add(time.Duration(offset) * time.Second)}}
+	}
+	// Events stored in chronological order
+	events := []Event{newEvent("n1", 0), newEvent("n2", 1)}
+
+	ts := newEventsServer(t, &mu, &events)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	received := make(chan string, 10)
+	e := &eventService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	err := e.SubscribeToEvents(ctx, "env", func(event *Event, err error) {
+		assert.NilError(t, err)
+		received <- event.NodeTemplateId
+	})
+	assert.NilError(t, err)
+
+	expectEvents := func(expected ...string) {
+		t.Helper()
+		for _, node := range expected {
+			select {
+			case got := <-received:
+				assert.Equal(t, got, node)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("timeout waiting for an event on node %q", node)
+			}
+		}
+	}
+
+	mu.Lock()
+	events = append(events, newEvent("n3", 2), newEvent("n4", 2))
+	mu.Unlock()
+	expectEvents("n3", "n4")
+
+	// Purge old events, this should not lead to duplicated notifications
+	mu.Lock()
+	events = append(events[3:], newEvent("n5", 2), newEvent("n6", 3))
+	mu.Unlock()
+	expectEvents("n5", "n6")
+
+	select {
+	case got := <-received:
+		t.Fatalf("unexpected event on node %q", got)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func Test_eventService_SubscribeToEventsSameDate(t *testing.T) {
+	previousInterval := eventsPollInterval
+	eventsPollInterval = 10 * time.Millisecond
+	defer func() { eventsPollInterval = previousInterval }()
+
+	var mu sync.Mutex
+	now := time.Now()
+	newEvent := func(node string, offset int) Event {
+		return Event{NodeTemplateId: node, InstanceState: "started", Date: Time{Time: now.Add(time.Duration(offset) * time.Second)}}
+	}
+	// Existing events sharing the most recent date should not be notified
+	events := []Event{newEvent("n1", 0), newEvent("n2", 1), newEvent("n3", 1), newEvent("n4", 1)}
+	ts := newEventsServer(t, &mu, &events)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	received := make(chan string, 10)
+	e := &eventService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	err := e.SubscribeToEvents(ctx, "env", func(event *Event, err error) {
+		assert.NilError(t, err)
+		received <- event.NodeTemplateId
+	})
+	assert.NilError(t, err)
+
+	// Purge the most recent event, other events having its date should still be known
+	mu.Lock()
+	events = append(events[:3], newEvent("n5", 1))
+	mu.Unlock()
+	select {
+	case got := <-received:
+		assert.Equal(t, got, "n5")
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for an event on node n5")
+	}
+	select {
+	case got := <-received:
+		t.Fatalf("unexpected event on node %q", got)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// newEventsServer returns a server returning the given events, stored in chronological order, most recent first
+func newEventsServer(t *testing.T, mu *sync.Mutex, events *[]Event) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		from, _ := strconv.Atoi(r.URL.Query().Get("from"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+		var res struct {
+			Data struct {
+				Data         []Event `json:"data"`
+				TotalResults int     `json:"totalResults"`
+			} `json:"data"`
+		}
+		res.Data.TotalResults = len(*events)
+		for i := len(*events) - 1 - from; i >= 0 && len(res.Data.Data) < size; i-- {
+			res.Data.Data = append(res.Data.Data, (*events)[i])
+		}
+		b, err := json.Marshal(&res)
+		assert.NilError(t, err)
+		_, _ = w.Write(b)
+	}))
+}
@@ -0,0 +1,192 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FaultKind defines the kind of failure simulated by a Fault
+type FaultKind int
+
+const (
+	// FaultSessionExpired answers the request with a 403 Forbidden error without contacting Alien4Cloud,
+	// as Alien4Cloud does when the session expired. The client logs in again and resends the request once.
+	FaultSessionExpired FaultKind = iota
+	// FaultSlowResponse delays the request by the Fault Delay before sending it to Alien4Cloud
+	FaultSlowResponse
+	// FaultMalformedPayload sends the request to Alien4Cloud and replaces the response body with an invalid JSON payload
+	FaultMalformedPayload
+)
+
+// malformedPayload is the response body of requests affected by a FaultMalformedPayload fault
+const malformedPayload = `{"data":{"id":`
+
+// Fault describes a failure simulated by a FaultInjector on selected requests
+type Fault struct {
+	Kind FaultKind
+	// Method selects requests by their HTTP method, an empty method selects all methods
+	Method string
+	// Path selects requests by their URL path, a nil regular expression selects all paths
+	Path *regexp.Regexp
+	// Delay is the delay applied by FaultSlowResponse faults
+	Delay time.Duration
+	// Times is the number of requests affected by the fault, 0 means that all selected requests are affected
+	Times int
+}
+
+// matches checks if the fault selects the given request
+func (f *Fault) matches(request *http.Request) bool {
+	if f.Method != "" && !strings.EqualFold(f.Method, request.Method) {
+		return false
+	}
+	return f.Path == nil || f.Path.MatchString(request.URL.Path)
+}
+
+// FaultInjector is an HTTP transport simulating Alien4Cloud failures on selected requests, it is set
+// on a client using WithFaultInjector.
+//
+// It allows consumers to test their error handling against realistic failure modes like session expiry,
+// slow responses or malformed payloads. It is meant to be used in tests only.
+//
+// Faults are evaluated in their order of registration and the first fault selecting a request is applied,
+// requests not selected by any fault are sent to Alien4Cloud untouched.
+type FaultInjector struct {
+	transport http.RoundTripper
+
+	lock     sync.Mutex
+	faults   []Fault
+	applied  []int
+	injected int
+}
+
+// NewFaultInjector returns a FaultInjector simulating the given faults
+func NewFaultInjector(faults ...Fault) *FaultInjector {
+	fi := &FaultInjector{}
+	for _, f := range faults {
+		fi.AddFault(f)
+	}
+	return fi
+}
+
+// WithFaultInjector configures the client to simulate failures using the given FaultInjector
+func WithFaultInjector(fi *FaultInjector) ClientOption {
+	return func(c *a4cClient) {
+		fi.transport = c.client.Transport
+		c.client.Transport = fi
+	}
+}
+
+// AddFault registers a new fault, it could be called while requests are sent
+func (fi *FaultInjector) AddFault(f Fault) {
+	fi.lock.Lock()
+	defer fi.lock.Unlock()
+	fi.faults = append(fi.faults, f)
+	fi.applied = append(fi.applied, 0)
+}
+
+// Reset removes all registered faults
+func (fi *FaultInjector) Reset() {
+	fi.lock.Lock()
+	defer fi.lock.Unlock()
+	fi.faults = nil
+	fi.applied = nil
+}
+
+// Injected returns the number of requests affected by a fault so far
+func (fi *FaultInjector) Injected() int {
+	fi.lock.Lock()
+	defer fi.lock.Unlock()
+	return fi.injected
+}
+
+// selectFault returns the first active fault selecting the request and accounts for its use
+func (fi *FaultInjector) selectFault(request *http.Request) (Fault, bool) {
+	fi.lock.Lock()
+	defer fi.lock.Unlock()
+	for i := range fi.faults {
+		f := &fi.faults[i]
+		if (f.Times > 0 && fi.applied[i] >= f.Times) || !f.matches(request) {
+			continue
+		}
+		fi.applied[i]++
+		fi.injected++
+		return *f, true
+	}
+	return Fault{}, false
+}
+
+// RoundTrip implements http.RoundTripper
+func (fi *FaultInjector) RoundTrip(request *http.Request) (*http.Response, error) {
+	transport := fi.transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	f, ok := fi.selectFault(request)
+	if !ok {
+		return transport.RoundTrip(request)
+	}
+
+	switch f.Kind {
+	case FaultSessionExpired:
+		if request.Body != nil {
+			request.Body.Close()
+		}
+		return newFaultResponse(request, http.StatusForbidden,
+			`{"error":{"code":403,"message":"Access is denied"}}`), nil
+	case FaultSlowResponse:
+		timer := time.NewTimer(f.Delay)
+		defer timer.Stop()
+		select {
+		case <-request.Context().Done():
+			if request.Body != nil {
+				request.Body.Close()
+			}
+			return nil, request.Context().Err()
+		case <-timer.C:
+		}
+		return transport.RoundTrip(request)
+	default:
+		response, err := transport.RoundTrip(request)
+		if err != nil {
+			return nil, err
+		}
+		discardHTTPResponseBody(response)
+		response.Body = ioutil.NopCloser(bytes.NewReader([]byte(malformedPayload)))
+		response.ContentLength = int64(len(malformedPayload))
+		response.Header.Del("Content-Length")
+		return response, nil
+	}
+}
+
+func newFaultResponse(request *http.Request, statusCode int, body string) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(statusCode),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}
+}
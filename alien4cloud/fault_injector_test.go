@@ -0,0 +1,92 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"gotest.tools/v3/assert"
+)
+
+func TestFaultInjector(t *testing.T) {
+	var logins int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/login":
+			logins++
+			w.WriteHeader(http.StatusOK)
+		case "/rest/latest/applications/app":
+			_, _ = w.Write([]byte(`{"data":{"id":"app","name":"My App"}}`))
+		default:
+			t.Errorf("Unexpected call for request %+v", r)
+		}
+	}))
+	defer ts.Close()
+
+	appPath := regexp.MustCompile(`/applications/app$`)
+	fi := NewFaultInjector(Fault{Kind: FaultSessionExpired, Path: appPath, Times: 1})
+	client, err := NewClient(ts.URL, "user", "password", "", false, WithFaultInjector(fi))
+	assert.NilError(t, err)
+	ctx := context.Background()
+
+	// Session expiry is recovered by logging in again
+	app, err := client.ApplicationService().GetApplicationByID(ctx, "app")
+	assert.NilError(t, err)
+	assert.Equal(t, app.Name, "My App")
+	assert.Equal(t, logins, 1)
+	assert.Equal(t, fi.Injected(), 1)
+
+	// A request still forbidden after logging in again returns the 403 error
+	fi.Reset()
+	fi.AddFault(Fault{Kind: FaultSessionExpired, Path: appPath})
+	request, err := client.NewRequest(ctx, "GET", "/rest/latest/applications/app", nil)
+	assert.NilError(t, err)
+	response, err := client.Do(request)
+	assert.NilError(t, err)
+	assert.Assert(t, errors.Is(ReadA4CResponse(response, nil), ErrForbidden))
+	assert.Equal(t, logins, 2)
+	assert.Equal(t, fi.Injected(), 3)
+
+	fi.Reset()
+	fi.AddFault(Fault{Kind: FaultMalformedPayload, Method: "POST", Path: appPath})
+	fi.AddFault(Fault{Kind: FaultMalformedPayload, Method: "GET", Path: appPath, Times: 1})
+	_, err = client.ApplicationService().GetApplicationByID(ctx, "app")
+	assert.ErrorContains(t, err, "unexpected end of JSON input")
+	_, err = client.ApplicationService().GetApplicationByID(ctx, "app")
+	assert.NilError(t, err, "fault should be applied only once")
+	assert.Equal(t, fi.Injected(), 4)
+
+	fi.Reset()
+	fi.AddFault(Fault{Kind: FaultSlowResponse, Delay: time.Second})
+	timeoutCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err = client.ApplicationService().GetApplicationByID(timeoutCtx, "app")
+	assert.ErrorContains(t, err, "deadline exceeded")
+	assert.Assert(t, time.Since(start) < time.Second)
+
+	fi.Reset()
+	fi.AddFault(Fault{Kind: FaultSlowResponse, Delay: 20 * time.Millisecond})
+	start = time.Now()
+	_, err = client.ApplicationService().GetApplicationByID(ctx, "app")
+	assert.NilError(t, err)
+	assert.Assert(t, time.Since(start) >= 20*time.Millisecond)
+}
@@ -0,0 +1,119 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"sync"
+)
+
+// EnvironmentRef identifies an application environment
+type EnvironmentRef struct {
+	AppID string
+	EnvID string
+}
+
+// FleetStatus retrieves deployment statuses of many application environments.
+//
+// Current deployment IDs of environments are cached across sweeps, so a sweep on an environment
+// still deployed the same way requires a single request. A cached deployment ID is resolved again
+// once its deployment is undeployed or its status could not be retrieved.
+// A FleetStatus is safe for concurrent use.
+type FleetStatus struct {
+	// Concurrency is the maximum number of environments processed concurrently, defaults to 8
+	Concurrency int
+
+	deployments   DeploymentService
+	lock          sync.Mutex
+	deploymentIDs map[EnvironmentRef]string
+}
+
+// NewFleetStatus returns a FleetStatus retrieving deployment statuses using the given client
+func NewFleetStatus(client Client) *FleetStatus {
+	return &FleetStatus{
+		deployments:   client.DeploymentService(),
+		deploymentIDs: make(map[EnvironmentRef]string),
+	}
+}
+
+// Sweep returns the deployment statuses of the given environments.
+//
+// Environments for which the status could not be retrieved are reported in failures instead of statuses.
+// Remaining environments are not processed once the context is cancelled, the context error is reported for them.
+func (f *FleetStatus) Sweep(ctx context.Context, envs []EnvironmentRef) (statuses map[EnvironmentRef]string, failures map[EnvironmentRef]error) {
+	statuses = make(map[EnvironmentRef]string, len(envs))
+	failures = make(map[EnvironmentRef]error)
+	concurrency := f.Concurrency
+	if concurrency <= 0 {
+		concurrency = bulkConcurrency
+	}
+
+	var lock sync.Mutex
+	errs := runBulkIndexed(ctx, concurrency, len(envs), func(ctx context.Context, i int) error {
+		status, err := f.status(ctx, envs[i])
+		if err != nil {
+			return err
+		}
+		lock.Lock()
+		statuses[envs[i]] = status
+		lock.Unlock()
+		return nil
+	})
+	for i, err := range errs {
+		failures[envs[i]] = err
+	}
+	return statuses, failures
+}
+
+// status returns the deployment status of an environment using the cached deployment ID if any
+func (f *FleetStatus) status(ctx context.Context, env EnvironmentRef) (string, error) {
+	f.lock.Lock()
+	deploymentID, cached := f.deploymentIDs[env]
+	f.lock.Unlock()
+
+	if cached {
+		status, err := f.deployments.GetDeploymentStatusByID(ctx, deploymentID)
+		if err == nil && status != ApplicationUndeployed {
+			return status, nil
+		}
+		// The environment may have been undeployed or redeployed since the deployment ID was cached
+		f.forget(env)
+	}
+
+	deploymentID, err := f.deployments.GetCurrentDeploymentID(ctx, env.AppID, env.EnvID)
+	if err != nil {
+		return "", err
+	}
+	if deploymentID == "" {
+		return ApplicationUndeployed, nil
+	}
+	status, err := f.deployments.GetDeploymentStatusByID(ctx, deploymentID)
+	if err != nil {
+		return "", err
+	}
+	if status != ApplicationUndeployed {
+		f.lock.Lock()
+		f.deploymentIDs[env] = deploymentID
+		f.lock.Unlock()
+	}
+	return status, nil
+}
+
+// forget removes the cached deployment ID of an environment
+func (f *FleetStatus) forget(env EnvironmentRef) {
+	f.lock.Lock()
+	delete(f.deploymentIDs, env)
+	f.lock.Unlock()
+}
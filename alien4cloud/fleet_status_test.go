@@ -0,0 +1,99 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestFleetStatus_Sweep(t *testing.T) {
+	var lock sync.Mutex
+	deploymentIDRequests := make(map[string]int)
+	// Current deployment of each application, the "error" application has no deployment information
+	currentDeployments := map[string]string{"app1": "dep1", "app2": "", "app3": "dep3"}
+	deploymentStatuses := map[string]string{"dep1": ApplicationDeployed, "dep3": ApplicationDeploymentInProgress}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		if m := regexp.MustCompile(`.*/applications/(.*)/environments/.*/active-deployment-monitored$`).FindStringSubmatch(r.URL.Path); m != nil {
+			deploymentIDRequests[m[1]]++
+			depID, ok := currentDeployments[m[1]]
+			if !ok {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"error":{"code": 500,"message":"internal error"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":{"deployment":{"id":"` + depID + `"}}}`))
+			return
+		}
+		if m := regexp.MustCompile(`.*/deployments/(.*)/status$`).FindStringSubmatch(r.URL.Path); m != nil {
+			status, ok := deploymentStatuses[m[1]]
+			if !ok {
+				status = ApplicationUndeployed
+			}
+			_, _ = w.Write([]byte(`{"data":"` + status + `"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL, "", "", "", false)
+	assert.NilError(t, err)
+	fleet := NewFleetStatus(client)
+	fleet.Concurrency = 2
+
+	envs := []EnvironmentRef{{"app1", "env"}, {"app2", "env"}, {"app3", "env"}, {"error", "env"}}
+	statuses, failures := fleet.Sweep(context.Background(), envs)
+	assert.DeepEqual(t, statuses, map[EnvironmentRef]string{
+		{"app1", "env"}: ApplicationDeployed,
+		{"app2", "env"}: ApplicationUndeployed,
+		{"app3", "env"}: ApplicationDeploymentInProgress,
+	})
+	assert.Equal(t, len(failures), 1)
+	assert.ErrorContains(t, failures[EnvironmentRef{"error", "env"}], "internal error")
+
+	// app3 is redeployed with a new deployment
+	lock.Lock()
+	currentDeployments["app3"] = "dep3bis"
+	deploymentStatuses["dep3bis"] = ApplicationDeployed
+	delete(deploymentStatuses, "dep3")
+	lock.Unlock()
+
+	statuses, failures = fleet.Sweep(context.Background(), envs[:3])
+	assert.Equal(t, len(failures), 0)
+	assert.DeepEqual(t, statuses, map[EnvironmentRef]string{
+		{"app1", "env"}: ApplicationDeployed,
+		{"app2", "env"}: ApplicationUndeployed,
+		{"app3", "env"}: ApplicationDeployed,
+	})
+	// app1 deployment ID was cached, app2 is undeployed so resolved on each sweep, app3 was redeployed
+	assert.DeepEqual(t, deploymentIDRequests, map[string]int{"app1": 1, "app2": 2, "app3": 2, "error": 1})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	statuses, failures = fleet.Sweep(ctx, envs[:1])
+	assert.Equal(t, len(statuses), 0)
+	assert.Equal(t, failures[EnvironmentRef{"app1", "env"}], context.Canceled)
+}
@@ -0,0 +1,83 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrNotFound matches errors returned when Alien4Cloud responds with a 404 Not Found status, it may be checked using errors.Is.
+	ErrNotFound = errors.New("not found")
+	// ErrForbidden matches errors returned when Alien4Cloud responds with a 403 Forbidden status, it may be checked using errors.Is.
+	ErrForbidden = errors.New("forbidden")
+	// ErrConflict matches errors returned when Alien4Cloud responds with a 409 Conflict status, it may be checked using errors.Is.
+	ErrConflict = errors.New("conflict")
+)
+
+// A4CError is returned by ReadA4CResponse when Alien4Cloud responds with an HTTP error status.
+//
+// It matches ErrNotFound, ErrForbidden or ErrConflict according to its status code using errors.Is
+// and may be retrieved from returned errors using errors.As.
+type A4CError struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+	// Code is the Alien4Cloud error code, 0 if the response does not hold an Alien4Cloud error
+	Code int
+	// Message is the Alien4Cloud error message
+	Message string
+}
+
+func (e *A4CError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("Alien4Cloud responded with status %d", e.StatusCode)
+	}
+	return e.Message
+}
+
+// Is allows to match an A4CError with ErrNotFound, ErrForbidden or ErrConflict using errors.Is
+func (e *A4CError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	}
+	return false
+}
+
+// newA4CError returns an *A4CError built from an Alien4Cloud error response body.
+//
+// Bodies which are not Alien4Cloud errors, like error pages returned by a proxy, are reported using the status text.
+func newA4CError(statusCode int, responseBody []byte) error {
+	var res struct {
+		Error Error `json:"error"`
+	}
+	a4cErr := &A4CError{StatusCode: statusCode}
+	if err := json.Unmarshal(responseBody, &res); err == nil {
+		a4cErr.Code = res.Error.Code
+		a4cErr.Message = res.Error.Message
+	}
+	if a4cErr.Message == "" {
+		a4cErr.Message = http.StatusText(statusCode)
+	}
+	return errors.WithStack(a4cErr)
+}
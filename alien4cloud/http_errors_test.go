@@ -0,0 +1,57 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"gotest.tools/v3/assert"
+)
+
+func TestReadA4CResponse_A4CError(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		want       A4CError
+		sentinel   error
+	}{
+		{"NotFound", http.StatusNotFound, `{"error":{"code":504,"message":"Application [app] not found"}}`, A4CError{StatusCode: 404, Code: 504, Message: "Application [app] not found"}, ErrNotFound},
+		{"Forbidden", http.StatusForbidden, `{"error":{"code":102,"message":"access denied"}}`, A4CError{StatusCode: 403, Code: 102, Message: "access denied"}, ErrForbidden},
+		{"Conflict", http.StatusConflict, `{"error":{"code":502,"message":"already exists"}}`, A4CError{StatusCode: 409, Code: 502, Message: "already exists"}, ErrConflict},
+		{"NotA4CError", http.StatusBadGateway, `<html>Bad Gateway</html>`, A4CError{StatusCode: 502, Message: "Bad Gateway"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := &http.Response{StatusCode: tt.statusCode, Body: ioutil.NopCloser(strings.NewReader(tt.body))}
+			err := errors.Wrap(ReadA4CResponse(response, nil), "Cannot do something")
+
+			var a4cErr *A4CError
+			assert.Assert(t, errors.As(err, &a4cErr))
+			assert.DeepEqual(t, *a4cErr, tt.want)
+			assert.Equal(t, err.Error(), "Cannot do something: "+tt.want.Message)
+			for _, sentinel := range []error{ErrNotFound, ErrForbidden, ErrConflict} {
+				assert.Equal(t, errors.Is(err, sentinel), sentinel == tt.sentinel, "unexpected match of %v", sentinel)
+			}
+		})
+	}
+
+	response := &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{"data":"ok"}`))}
+	assert.NilError(t, ReadA4CResponse(response, nil))
+}
@@ -0,0 +1,70 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// IdempotencyKeyHeaderName is the name of the header carrying the idempotency key of a request
+const IdempotencyKeyHeaderName = "Idempotency-Key"
+
+type idempotencyKeyCtxKey struct{}
+
+// WithIdempotencyKey returns a copy of ctx carrying the given idempotency key.
+//
+// When such a context is given to DeploymentService DeployApplication, UndeployApplication
+// or RunWorkflow* functions, the key is sent in the IdempotencyKeyHeaderName header of
+// the request triggering the operation, allowing gateways supporting it to deduplicate
+// operations retried over flaky networks. Errors returned by these functions mention the key.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyCtxKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns the idempotency key carried by ctx if any
+func IdempotencyKeyFromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKeyCtxKey{}).(string)
+	return key, ok && key != ""
+}
+
+// NewIdempotencyKey generates a new random idempotency key
+func NewIdempotencyKey() (string, error) {
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to generate an idempotency key")
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// setIdempotencyKeyHeader sets the idempotency key header of the request if its context carries a key
+func setIdempotencyKeyHeader(request *http.Request) {
+	if key, ok := IdempotencyKeyFromContext(request.Context()); ok {
+		request.Header.Set(IdempotencyKeyHeaderName, key)
+	}
+}
+
+// wrapIdempotencyKey annotates a non-nil error with the idempotency key carried by ctx if any
+func wrapIdempotencyKey(ctx context.Context, err error) error {
+	if key, ok := IdempotencyKeyFromContext(ctx); ok && err != nil {
+		return errors.Wrapf(err, "idempotency key %q", key)
+	}
+	return err
+}
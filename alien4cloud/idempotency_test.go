@@ -0,0 +1,68 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_IdempotencyKey(t *testing.T) {
+	var receivedKeys []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedKeys = append(receivedKeys, r.Header.Get(IdempotencyKeyHeaderName))
+		switch {
+		case regexp.MustCompile(`.*/applications/error/environments/.*/deployment`).Match([]byte(r.URL.Path)):
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error":{"code": 409,"message":"already undeploying"}}`))
+		case regexp.MustCompile(`.*/applications/.*/environments/.*/deployment`).Match([]byte(r.URL.Path)):
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("Unexpected call for request %+v", r)
+		}
+	}))
+	defer ts.Close()
+
+	d := &deploymentService{
+		client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL},
+	}
+
+	key, err := NewIdempotencyKey()
+	assert.NilError(t, err)
+	assert.Assert(t, key != "")
+	otherKey, err := NewIdempotencyKey()
+	assert.NilError(t, err)
+	assert.Assert(t, key != otherKey)
+
+	ctx := WithIdempotencyKey(context.Background(), key)
+	ctxKey, ok := IdempotencyKeyFromContext(ctx)
+	assert.Assert(t, ok)
+	assert.Equal(t, ctxKey, key)
+
+	err = d.UndeployApplication(ctx, "app", "env")
+	assert.NilError(t, err)
+	err = d.UndeployApplication(ctx, "error", "env")
+	assert.ErrorContains(t, err, "already undeploying")
+	assert.ErrorContains(t, err, key)
+	err = d.UndeployApplication(context.Background(), "app", "env")
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, receivedKeys, []string{key, key, ""})
+}
@@ -0,0 +1,72 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// InputSecretProvider stores values of sensitive deployment inputs outside of Alien4Cloud.
+//
+// When configured on a client using WithInputSecretProvider, values of password inputs given to
+// DeploymentService.UpdateDeploymentTopology are stored by the provider and replaced by the references
+// it returns, so that clear-text secrets are not stored by Alien4Cloud. The orchestrator should then
+// support resolving those references, for instance Yorc resolves get_secret functions using Vault.
+type InputSecretProvider interface {
+	// StoreInputSecret stores the value of a sensitive input of an application environment
+	// and returns the value replacing it in the deployment topology
+	StoreInputSecret(ctx context.Context, appID, envID, inputName string, value interface{}) (interface{}, error)
+}
+
+// InputSecretProviderFunc is a function implementing InputSecretProvider
+type InputSecretProviderFunc func(ctx context.Context, appID, envID, inputName string, value interface{}) (interface{}, error)
+
+// StoreInputSecret calls f
+func (f InputSecretProviderFunc) StoreInputSecret(ctx context.Context, appID, envID, inputName string, value interface{}) (interface{}, error) {
+	return f(ctx, appID, envID, inputName, value)
+}
+
+// SecretReference returns a get_secret function value referencing a secret stored at the given path,
+// to be returned by an InputSecretProvider for orchestrators resolving secrets this way.
+func SecretReference(path string) PropertyValue {
+	return PropertyValue{Function: FunctionGetSecret, Parameters: []interface{}{path}}
+}
+
+// protectInputSecrets returns a copy of inputs where values of password inputs are replaced
+// by references returned by the configured secret provider
+func (c *a4cClient) protectInputSecrets(ctx context.Context, appID, envID string, inputs map[string]interface{}) (map[string]interface{}, error) {
+	if c.inputSecretProvider == nil || len(inputs) == 0 {
+		return inputs, nil
+	}
+	topology, err := c.applicationService.GetDeploymentTopology(ctx, appID, envID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get sensitive inputs of application %q environment %q", appID, envID)
+	}
+
+	protected := make(map[string]interface{}, len(inputs))
+	for name, value := range inputs {
+		protected[name] = value
+		if def, ok := topology.Data.Topology.Inputs[name]; !ok || !def.Password || value == nil {
+			continue
+		}
+		protected[name], err = c.inputSecretProvider.StoreInputSecret(ctx, appID, envID, name, value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Unable to store secret value of input %q of application %q environment %q", name, appID, envID)
+		}
+	}
+	return protected, nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"gotest.tools/v3/assert"
+)
+
+func TestWithInputSecretProvider(t *testing.T) {
+	var sent UpdateDeploymentTopologyRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/rest/latest/applications/app/environments/env/deployment-topology")
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"data":{"topology":{"inputs":{
+				"db_password":{"type":"string","password":true},
+				"db_user":{"type":"string"}}}}}`))
+		case http.MethodPut:
+			assert.NilError(t, json.NewDecoder(r.Body).Decode(&sent))
+			_, _ = w.Write([]byte(`{"data":null}`))
+		}
+	}))
+	defer ts.Close()
+
+	stored := make(map[string]interface{})
+	provider := InputSecretProviderFunc(func(ctx context.Context, appID, envID, inputName string, value interface{}) (interface{}, error) {
+		path := "secret/" + appID + "/" + envID + "/" + inputName
+		stored[path] = value
+		return SecretReference(path), nil
+	})
+	client, err := NewClient(ts.URL, "", "", "", false, WithInputSecretProvider(provider))
+	assert.NilError(t, err)
+
+	err = client.DeploymentService().UpdateDeploymentTopology(context.Background(), "app", "env", UpdateDeploymentTopologyRequest{
+		InputProperties: map[string]interface{}{"db_password": "s3cr3t", "db_user": "admin"},
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, stored, map[string]interface{}{"secret/app/env/db_password": "s3cr3t"})
+	assert.Equal(t, sent.InputProperties["db_user"], "admin")
+	assert.DeepEqual(t, sent.InputProperties["db_password"], map[string]interface{}{
+		"function":   FunctionGetSecret,
+		"parameters": []interface{}{"secret/app/env/db_password"},
+	})
+}
+
+func Test_protectInputSecretsErrors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"topology":{"inputs":{"failing":{"type":"string","password":true}}}}}`))
+	}))
+	defer ts.Close()
+
+	c, err := NewClient(ts.URL, "", "", "", false, WithInputSecretProvider(InputSecretProviderFunc(
+		func(ctx context.Context, appID, envID, inputName string, value interface{}) (interface{}, error) {
+			return nil, errors.New("vault unavailable")
+		})))
+	assert.NilError(t, err)
+	_, err = c.(*a4cClient).protectInputSecrets(context.Background(), "app", "env", map[string]interface{}{"failing": "value"})
+	assert.ErrorContains(t, err, `Unable to store secret value of input "failing"`)
+	assert.ErrorContains(t, err, "vault unavailable")
+
+	// Without provider, inputs are sent as is without retrieving the deployment topology
+	inputs := map[string]interface{}{"failing": "value"}
+	got, err := (&a4cClient{}).protectInputSecrets(context.Background(), "app", "env", inputs)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, inputs)
+}
@@ -0,0 +1,104 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package leader allows replicas of a controller sharing the monitoring of Alien4Cloud deployments to poll
+// Alien4Cloud from a single replica, the leader.
+//
+// An Elector is set on an Alien4Cloud client using alien4cloud.WithLeaderElector. Distributed lock or leader
+// election implementations (based on etcd, Consul, Kubernetes leases...) only need to implement the Elector
+// interface, InMemoryLock is an implementation for candidates of a same process.
+package leader
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Elector tells whether the calling replica is the leader
+type Elector interface {
+	// IsLeader returns true if the calling replica is currently the leader.
+	//
+	// It is called before each poll of Alien4Cloud, implementations may use this call to acquire or renew
+	// the leadership. A replica returning an error is considered as not being the leader.
+	IsLeader(ctx context.Context) (bool, error)
+}
+
+// InMemoryLock is a lease based lock shared by candidates of a same process.
+//
+// The leadership is granted to the first candidate asking for it and renewed each time this candidate checks it.
+// It is lost if the leader does not check it for the lease duration or releases it, another candidate may then
+// acquire it.
+type InMemoryLock struct {
+	lease time.Duration
+
+	lock   sync.Mutex
+	holder string
+	expiry time.Time
+}
+
+// NewInMemoryLock returns an InMemoryLock with the given lease duration
+func NewInMemoryLock(lease time.Duration) *InMemoryLock {
+	return &InMemoryLock{lease: lease}
+}
+
+// Candidate returns the Elector of the candidate with the given ID
+func (l *InMemoryLock) Candidate(id string) Elector {
+	return candidate{lock: l, id: id}
+}
+
+// Leader returns the ID of the current leader, or an empty string if there is no leader
+func (l *InMemoryLock) Leader() string {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if time.Now().After(l.expiry) {
+		return ""
+	}
+	return l.holder
+}
+
+// Release releases the leadership if it is held by the candidate with the given ID
+func (l *InMemoryLock) Release(id string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.holder == id {
+		l.holder = ""
+		l.expiry = time.Time{}
+	}
+}
+
+// acquire acquires or renews the leadership for the given candidate and returns true if it holds it
+func (l *InMemoryLock) acquire(id string) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	now := time.Now()
+	if l.holder != id && l.holder != "" && now.Before(l.expiry) {
+		return false
+	}
+	l.holder = id
+	l.expiry = now.Add(l.lease)
+	return true
+}
+
+type candidate struct {
+	lock *InMemoryLock
+	id   string
+}
+
+func (c candidate) IsLeader(ctx context.Context) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return c.lock.acquire(c.id), nil
+}
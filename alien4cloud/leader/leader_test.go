@@ -0,0 +1,58 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package leader
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestInMemoryLock(t *testing.T) {
+	ctx := context.Background()
+	lock := NewInMemoryLock(50 * time.Millisecond)
+	a := lock.Candidate("a")
+	b := lock.Candidate("b")
+
+	isLeader := func(e Elector) bool {
+		leader, err := e.IsLeader(ctx)
+		assert.NilError(t, err)
+		return leader
+	}
+
+	assert.Equal(t, lock.Leader(), "")
+	assert.Assert(t, isLeader(a))
+	assert.Assert(t, !isLeader(b))
+	assert.Assert(t, isLeader(a), "leadership should be renewed")
+	assert.Equal(t, lock.Leader(), "a")
+
+	// Leadership is lost when the lease expires
+	time.Sleep(60 * time.Millisecond)
+	assert.Equal(t, lock.Leader(), "")
+	assert.Assert(t, isLeader(b))
+	assert.Assert(t, !isLeader(a))
+
+	lock.Release("a")
+	assert.Equal(t, lock.Leader(), "b", "only the leader releases the leadership")
+	lock.Release("b")
+	assert.Assert(t, isLeader(a))
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err := a.IsLeader(cancelled)
+	assert.ErrorContains(t, err, "canceled")
+}
@@ -0,0 +1,56 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/leader"
+)
+
+// leaderCheckInterval is the period at which a replica which is not the leader checks if it became the leader
+const leaderCheckInterval = time.Second
+
+// WithLeaderElector configures the client to poll Alien4Cloud only when the given Elector reports that
+// it is the leader.
+//
+// This applies to the monitoring of RunWorkflowAsync* and RunWorkflowWatch executions, to WatchAttributes,
+// to SubscribeToEvents, to StreamLogs and to callbacks registered with RegisterDeploymentStatusCallback. Replicas which are not the leader wait for the leadership before polling,
+// so their callbacks are invoked once they become the leader or when the context is cancelled.
+// Without an Elector, the client always polls.
+func WithLeaderElector(elector leader.Elector) ClientOption {
+	return func(c *a4cClient) {
+		c.leaderElector = elector
+	}
+}
+
+// waitLeadership blocks until the client is the leader, it returns an error only if the context is done
+func (c *a4cClient) waitLeadership(ctx context.Context) error {
+	if c.leaderElector == nil {
+		return ctx.Err()
+	}
+	for {
+		isLeader, err := c.leaderElector.IsLeader(ctx)
+		if err == nil && isLeader {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(leaderCheckInterval):
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/leader"
+)
+
+func TestWithLeaderElector(t *testing.T) {
+	lock := leader.NewInMemoryLock(time.Minute)
+	var mu sync.Mutex
+	polls := make(map[string]int)
+	var servers []*httptest.Server
+	defer func() {
+		for _, ts := range servers {
+			ts.Close()
+		}
+	}()
+	newReplica := func(ctx context.Context, name string) *a4cClient {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			polls[name]++
+			_, _ = w.Write([]byte(`{"data":{"Compute":{"0":{"state":"started","attributes":{"ip_address":"10.0.0.1"}}}}}`))
+		}))
+		servers = append(servers, ts)
+		client, err := NewClient(ts.URL, "", "", "", false, WithLeaderElector(lock.Candidate(name)))
+		assert.NilError(t, err)
+		err = client.DeploymentService().WatchAttributes(ctx, "app", "env", "Compute", []string{"ip_address"}, 10*time.Millisecond,
+			func(*AttributeChange, error) {})
+		assert.NilError(t, err)
+		return client.(*a4cClient)
+	}
+	countPolls := func(name string) int {
+		mu.Lock()
+		defer mu.Unlock()
+		return polls[name]
+	}
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	defer cancelLeader()
+	leaderClient := newReplica(leaderCtx, "leader")
+	assert.NilError(t, leaderClient.waitLeadership(leaderCtx))
+	followerCtx, cancelFollower := context.WithCancel(context.Background())
+	defer cancelFollower()
+	followerClient := newReplica(followerCtx, "follower")
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, followerClient.waitLeadership(timeoutCtx), context.DeadlineExceeded)
+
+	// Only the initial retrieval of attributes is done by the follower
+	time.Sleep(100 * time.Millisecond)
+	assert.Assert(t, countPolls("leader") > 2, "leader polls: %d", countPolls("leader"))
+	assert.Equal(t, countPolls("follower"), 1)
+
+	// The follower takes over once the leader stops and releases the leadership
+	cancelLeader()
+	lock.Release("leader")
+	assert.NilError(t, followerClient.waitLeadership(followerCtx))
+	deadline := time.Now().Add(3 * leaderCheckInterval)
+	for countPolls("follower") < 3 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Assert(t, countPolls("follower") >= 3, "follower polls: %d", countPolls("follower"))
+	assert.Equal(t, lock.Leader(), "follower")
+}
@@ -0,0 +1,307 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockgen -destination=../a4cmocks/${GOFILE} -package a4cmocks . LocationService
+
+// LocationService is the interface to the service managing locations of orchestrators
+type LocationService interface {
+	// Creates a location on an orchestrator and returns its ID
+	CreateLocation(ctx context.Context, orchestratorID, name, infrastructureType string) (string, error)
+	// Deletes a location of an orchestrator
+	DeleteLocation(ctx context.Context, orchestratorID, locationID string) error
+	// Returns the locations of an orchestrator
+	GetLocations(ctx context.Context, orchestratorID string) ([]LocationConfiguration, error)
+	// Updates meta-properties of a location, meta-properties are identified by their definition ID
+	UpdateLocationMetaProperties(ctx context.Context, orchestratorID, locationID string, metaProperties map[string]string) error
+
+	// Returns the on-demand resources and services defined on a location
+	GetLocationResources(ctx context.Context, orchestratorID, locationID string) ([]LocationResourceTemplate, error)
+	// Creates an on-demand resource on a location
+	CreateLocationResource(ctx context.Context, orchestratorID, locationID string, createRequest LocationResourceCreateRequest) (LocationResourceTemplate, error)
+	// Updates the name or enabled state of an on-demand resource of a location
+	UpdateLocationResource(ctx context.Context, orchestratorID, locationID, resourceID string, updateRequest LocationResourceUpdateRequest) error
+	// Sets the value of a property of an on-demand resource of a location
+	SetLocationResourceProperty(ctx context.Context, orchestratorID, locationID, resourceID, propertyName string, propertyValue interface{}) error
+	// Deletes an on-demand resource of a location
+	DeleteLocationResource(ctx context.Context, orchestratorID, locationID, resourceID string) error
+}
+
+type locationService struct {
+	client *a4cClient
+}
+
+const locationsEndpointFormat = "%s/orchestrators/%s/locations"
+
+// CreateLocation creates a location on an orchestrator and returns its ID
+func (l *locationService) CreateLocation(ctx context.Context, orchestratorID, name, infrastructureType string) (string, error) {
+	body, err := json.Marshal(struct {
+		Name               string `json:"name"`
+		InfrastructureType string `json:"infrastructureType"`
+	}{
+		Name:               name,
+		InfrastructureType: infrastructureType,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "Cannot marshal a location creation request")
+	}
+
+	request, err := l.client.NewRequest(ctx,
+		"POST",
+		fmt.Sprintf(locationsEndpointFormat, a4CRestAPIPrefix, orchestratorID),
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return "", errors.Wrapf(err, "Unable to create request to create location '%s' on orchestrator '%s'", name, orchestratorID)
+	}
+
+	var res struct {
+		Data string `json:"data"`
+	}
+	response, err := l.client.Do(request)
+	if err != nil {
+		return "", errors.Wrapf(err, "Unable to send request to create location '%s' on orchestrator '%s'", name, orchestratorID)
+	}
+	err = ReadA4CResponse(response, &res)
+	return res.Data, errors.Wrapf(err, "Unable to create location '%s' on orchestrator '%s'", name, orchestratorID)
+}
+
+// DeleteLocation deletes a location of an orchestrator
+func (l *locationService) DeleteLocation(ctx context.Context, orchestratorID, locationID string) error {
+	request, err := l.client.NewRequest(ctx,
+		"DELETE",
+		fmt.Sprintf(locationsEndpointFormat+"/%s", a4CRestAPIPrefix, orchestratorID, locationID),
+		nil,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to create request to delete location '%s' of orchestrator '%s'", locationID, orchestratorID)
+	}
+	response, err := l.client.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to send request to delete location '%s' of orchestrator '%s'", locationID, orchestratorID)
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Unable to delete location '%s' of orchestrator '%s'", locationID, orchestratorID)
+}
+
+// GetLocations returns the locations of an orchestrator
+func (l *locationService) GetLocations(ctx context.Context, orchestratorID string) ([]LocationConfiguration, error) {
+	request, err := l.client.NewRequest(ctx,
+		"GET",
+		fmt.Sprintf(locationsEndpointFormat, a4CRestAPIPrefix, orchestratorID),
+		nil,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to create request to get locations of orchestrator '%s'", orchestratorID)
+	}
+
+	var res struct {
+		Data []struct {
+			Location LocationConfiguration `json:"location"`
+		} `json:"data"`
+	}
+	response, err := l.client.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to send request to get locations of orchestrator '%s'", orchestratorID)
+	}
+	err = ReadA4CResponse(response, &res)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get locations of orchestrator '%s'", orchestratorID)
+	}
+
+	locations := make([]LocationConfiguration, 0, len(res.Data))
+	for _, location := range res.Data {
+		locations = append(locations, location.Location)
+	}
+	return locations, nil
+}
+
+// UpdateLocationMetaProperties updates meta-properties of a location.
+//
+// Meta-properties are identified by their definition ID, they are updated one at a time
+// in the order of their definition ID, the update stops on the first error.
+func (l *locationService) UpdateLocationMetaProperties(ctx context.Context, orchestratorID, locationID string, metaProperties map[string]string) error {
+	definitionIDs := make([]string, 0, len(metaProperties))
+	for definitionID := range metaProperties {
+		definitionIDs = append(definitionIDs, definitionID)
+	}
+	sort.Strings(definitionIDs)
+
+	for _, definitionID := range definitionIDs {
+		body, err := json.Marshal(struct {
+			DefinitionID string `json:"definitionId"`
+			Value        string `json:"value"`
+		}{
+			DefinitionID: definitionID,
+			Value:        metaProperties[definitionID],
+		})
+		if err != nil {
+			return errors.Wrap(err, "Cannot marshal a meta-property update request")
+		}
+
+		request, err := l.client.NewRequest(ctx,
+			"POST",
+			fmt.Sprintf(locationsEndpointFormat+"/%s/properties", a4CRestAPIPrefix, orchestratorID, locationID),
+			bytes.NewReader(body),
+		)
+		if err != nil {
+			return errors.Wrapf(err, "Unable to create request to update meta-property '%s' of location '%s'", definitionID, locationID)
+		}
+		response, err := l.client.Do(request)
+		if err != nil {
+			return errors.Wrapf(err, "Unable to send request to update meta-property '%s' of location '%s'", definitionID, locationID)
+		}
+		err = ReadA4CResponse(response, nil)
+		if err != nil {
+			return errors.Wrapf(err, "Unable to update meta-property '%s' of location '%s'", definitionID, locationID)
+		}
+	}
+	return nil
+}
+
+// GetLocationResources returns the on-demand resources and services defined on a location
+func (l *locationService) GetLocationResources(ctx context.Context, orchestratorID, locationID string) ([]LocationResourceTemplate, error) {
+	request, err := l.client.NewRequest(ctx,
+		"GET",
+		fmt.Sprintf(locationsEndpointFormat+"/%s", a4CRestAPIPrefix, orchestratorID, locationID),
+		nil,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to create request to get resources of location '%s'", locationID)
+	}
+
+	var res struct {
+		Data struct {
+			Resources struct {
+				NodeTemplates []LocationResourceTemplate `json:"nodeTemplates"`
+			} `json:"resources"`
+		} `json:"data"`
+	}
+	response, err := l.client.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to send request to get resources of location '%s'", locationID)
+	}
+	err = ReadA4CResponse(response, &res)
+	return res.Data.Resources.NodeTemplates, errors.Wrapf(err, "Unable to get resources of location '%s'", locationID)
+}
+
+// CreateLocationResource creates an on-demand resource on a location
+func (l *locationService) CreateLocationResource(ctx context.Context, orchestratorID, locationID string, createRequest LocationResourceCreateRequest) (LocationResourceTemplate, error) {
+	body, err := json.Marshal(createRequest)
+	if err != nil {
+		return LocationResourceTemplate{}, errors.Wrap(err, "Cannot marshal a LocationResourceCreateRequest structure")
+	}
+
+	request, err := l.client.NewRequest(ctx,
+		"POST",
+		fmt.Sprintf(locationsEndpointFormat+"/%s/resources", a4CRestAPIPrefix, orchestratorID, locationID),
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return LocationResourceTemplate{}, errors.Wrapf(err, "Unable to create request to create resource '%s' on location '%s'", createRequest.ResourceName, locationID)
+	}
+
+	var res struct {
+		Data struct {
+			ResourceTemplate LocationResourceTemplate `json:"resourceTemplate"`
+		} `json:"data"`
+	}
+	response, err := l.client.Do(request)
+	if err != nil {
+		return LocationResourceTemplate{}, errors.Wrapf(err, "Unable to send request to create resource '%s' on location '%s'", createRequest.ResourceName, locationID)
+	}
+	err = ReadA4CResponse(response, &res)
+	return res.Data.ResourceTemplate, errors.Wrapf(err, "Unable to create resource '%s' on location '%s'", createRequest.ResourceName, locationID)
+}
+
+// UpdateLocationResource updates the name or enabled state of an on-demand resource of a location
+func (l *locationService) UpdateLocationResource(ctx context.Context, orchestratorID, locationID, resourceID string, updateRequest LocationResourceUpdateRequest) error {
+	body, err := json.Marshal(updateRequest)
+	if err != nil {
+		return errors.Wrap(err, "Cannot marshal a LocationResourceUpdateRequest structure")
+	}
+
+	request, err := l.client.NewRequest(ctx,
+		"PUT",
+		fmt.Sprintf(locationsEndpointFormat+"/%s/resources/%s", a4CRestAPIPrefix, orchestratorID, locationID, resourceID),
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to create request to update resource '%s' of location '%s'", resourceID, locationID)
+	}
+	response, err := l.client.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to send request to update resource '%s' of location '%s'", resourceID, locationID)
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Unable to update resource '%s' of location '%s'", resourceID, locationID)
+}
+
+// SetLocationResourceProperty sets the value of a property of an on-demand resource of a location
+func (l *locationService) SetLocationResourceProperty(ctx context.Context, orchestratorID, locationID, resourceID, propertyName string, propertyValue interface{}) error {
+	body, err := json.Marshal(struct {
+		PropertyName  string      `json:"propertyName"`
+		PropertyValue interface{} `json:"propertyValue"`
+	}{
+		PropertyName:  propertyName,
+		PropertyValue: propertyValue,
+	})
+	if err != nil {
+		return errors.Wrap(err, "Cannot marshal a resource property update request")
+	}
+
+	request, err := l.client.NewRequest(ctx,
+		"POST",
+		fmt.Sprintf(locationsEndpointFormat+"/%s/resources/%s/template/properties", a4CRestAPIPrefix, orchestratorID, locationID, resourceID),
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to create request to set property '%s' of resource '%s'", propertyName, resourceID)
+	}
+	response, err := l.client.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to send request to set property '%s' of resource '%s'", propertyName, resourceID)
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Unable to set property '%s' of resource '%s'", propertyName, resourceID)
+}
+
+// DeleteLocationResource deletes an on-demand resource of a location
+func (l *locationService) DeleteLocationResource(ctx context.Context, orchestratorID, locationID, resourceID string) error {
+	request, err := l.client.NewRequest(ctx,
+		"DELETE",
+		fmt.Sprintf(locationsEndpointFormat+"/%s/resources/%s", a4CRestAPIPrefix, orchestratorID, locationID, resourceID),
+		nil,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to create request to delete resource '%s' of location '%s'", resourceID, locationID)
+	}
+	response, err := l.client.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to send request to delete resource '%s' of location '%s'", resourceID, locationID)
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Unable to delete resource '%s' of location '%s'", resourceID, locationID)
+}
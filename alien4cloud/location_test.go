@@ -0,0 +1,115 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_locationService(t *testing.T) {
+	var requests []string
+	var bodies []map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.ContentLength > 0 {
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":{"code": 400,"message":"bad request"}}`))
+				return
+			}
+			bodies = append(bodies, body)
+		}
+		switch {
+		case regexp.MustCompile(`.*/orchestrators/orch/locations$`).Match([]byte(r.URL.Path)) && r.Method == http.MethodPost:
+			_, _ = w.Write([]byte(`{"data":"loc1"}`))
+		case regexp.MustCompile(`.*/orchestrators/orch/locations$`).Match([]byte(r.URL.Path)) && r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"data":[{"location":{"id":"loc1","name":"openstack","infrastructureType":"openstack"}}]}`))
+		case regexp.MustCompile(`.*/orchestrators/orch/locations/loc1$`).Match([]byte(r.URL.Path)) && r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"data":{"location":{"id":"loc1"},"resources":{"nodeTemplates":[{"id":"r1","name":"small","enabled":true,"template":{"type":"yorc.nodes.openstack.Compute"}}]}}}`))
+		case regexp.MustCompile(`.*/orchestrators/orch/locations/loc1/resources$`).Match([]byte(r.URL.Path)) && r.Method == http.MethodPost:
+			_, _ = w.Write([]byte(`{"data":{"resourceTemplate":{"id":"r2","name":"large","template":{"type":"yorc.nodes.openstack.Compute"}}}}`))
+		case regexp.MustCompile(`.*/orchestrators/orch/locations/unknown.*`).Match([]byte(r.URL.Path)):
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		case regexp.MustCompile(`.*/orchestrators/orch/locations/loc1.*`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	l := &locationService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+
+	locationID, err := l.CreateLocation(ctx, "orch", "openstack", "openstack")
+	assert.NilError(t, err)
+	assert.Equal(t, locationID, "loc1")
+
+	locations, err := l.GetLocations(ctx, "orch")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, locations, []LocationConfiguration{{ID: "loc1", Name: "openstack", InfrastructureType: "openstack"}})
+
+	assert.NilError(t, l.UpdateLocationMetaProperties(ctx, "orch", "loc1", map[string]string{"b": "2", "a": "1"}))
+
+	resources, err := l.GetLocationResources(ctx, "orch", "loc1")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, resources, []LocationResourceTemplate{{ID: "r1", Name: "small", Enabled: true, Template: LocationResourceNodeType{Type: "yorc.nodes.openstack.Compute"}}})
+
+	resource, err := l.CreateLocationResource(ctx, "orch", "loc1", LocationResourceCreateRequest{
+		ResourceType: "yorc.nodes.openstack.Compute", ResourceName: "large", ArchiveName: "yorc-openstack-types", ArchiveVersion: "1.0.0",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, resource.ID, "r2")
+
+	enabled := false
+	assert.NilError(t, l.UpdateLocationResource(ctx, "orch", "loc1", "r2", LocationResourceUpdateRequest{Enabled: &enabled}))
+	assert.NilError(t, l.SetLocationResourceProperty(ctx, "orch", "loc1", "r2", "flavor", "m1.large"))
+	assert.NilError(t, l.DeleteLocationResource(ctx, "orch", "loc1", "r2"))
+	assert.NilError(t, l.DeleteLocation(ctx, "orch", "loc1"))
+
+	assert.DeepEqual(t, requests, []string{
+		"POST /rest/latest/orchestrators/orch/locations",
+		"GET /rest/latest/orchestrators/orch/locations",
+		"POST /rest/latest/orchestrators/orch/locations/loc1/properties",
+		"POST /rest/latest/orchestrators/orch/locations/loc1/properties",
+		"GET /rest/latest/orchestrators/orch/locations/loc1",
+		"POST /rest/latest/orchestrators/orch/locations/loc1/resources",
+		"PUT /rest/latest/orchestrators/orch/locations/loc1/resources/r2",
+		"POST /rest/latest/orchestrators/orch/locations/loc1/resources/r2/template/properties",
+		"DELETE /rest/latest/orchestrators/orch/locations/loc1/resources/r2",
+		"DELETE /rest/latest/orchestrators/orch/locations/loc1",
+	})
+	assert.DeepEqual(t, bodies, []map[string]interface{}{
+		{"name": "openstack", "infrastructureType": "openstack"},
+		{"definitionId": "a", "value": "1"},
+		{"definitionId": "b", "value": "2"},
+		{"resourceType": "yorc.nodes.openstack.Compute", "resourceName": "large", "archiveName": "yorc-openstack-types", "archiveVersion": "1.0.0"},
+		{"enabled": false},
+		{"propertyName": "flavor", "propertyValue": "m1.large"},
+	})
+
+	err = l.DeleteLocation(ctx, "orch", "unknown")
+	assert.ErrorContains(t, err, "Unable to delete location 'unknown'")
+}
@@ -19,6 +19,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -29,6 +31,10 @@ import (
 type LogService interface {
 	// Returns the logs of the application and environment filtered
 	GetLogsOfApplication(ctx context.Context, applicationID string, environmentID string, filters LogFilter, fromIndex int) ([]Log, int, error)
+	// Calls the given callback with new log entries of the application and environment until the context is cancelled
+	StreamLogs(ctx context.Context, applicationID, environmentID string, filters LogFilter, interval time.Duration, callback LogCallback) error
+	// Returns a summary of the logs of a given workflow execution
+	GetExecutionLogsSummary(ctx context.Context, applicationID string, environmentID string, executionID string) (LogsSummary, error)
 }
 
 type logService struct {
@@ -130,3 +136,40 @@ func (l *logService) GetLogsOfApplication(ctx context.Context, applicationID str
 
 	return res.Data.Data, len(res.Data.Data), errors.Wrapf(err, "Cannot get logs from application '%s' and environment '%s'", applicationID, environmentID)
 }
+
+// GetExecutionLogsSummary returns a summary of the logs of a given workflow execution
+func (l *logService) GetExecutionLogsSummary(ctx context.Context, applicationID string, environmentID string, executionID string) (LogsSummary, error) {
+	logs, _, err := l.GetLogsOfApplication(ctx, applicationID, environmentID, LogFilter{ExecutionID: []string{executionID}}, 0)
+	if err != nil {
+		return LogsSummary{}, errors.Wrapf(err, "Cannot get logs of execution '%s'", executionID)
+	}
+	return SummarizeLogs(logs), nil
+}
+
+// SummarizeLogs computes a summary of the given logs.
+//
+// Logs are expected to be sorted by ascending timestamp, as returned by GetLogsOfApplication.
+// The failing node and operation are those of the last log entry with an error level.
+func SummarizeLogs(logs []Log) LogsSummary {
+	summary := LogsSummary{
+		Total:        len(logs),
+		CountByLevel: make(map[string]int),
+	}
+	for i := range logs {
+		level := strings.ToLower(logs[i].Level)
+		summary.CountByLevel[level]++
+		if level != LogLevelError {
+			continue
+		}
+		if summary.FirstError == nil {
+			summary.FirstError = &logs[i]
+		}
+		summary.LastError = &logs[i]
+	}
+	if summary.LastError != nil {
+		summary.FailingNode = summary.LastError.NodeID
+		summary.FailingInterface = summary.LastError.InterfaceName
+		summary.FailingOperation = summary.LastError.OperationName
+	}
+	return summary
+}
@@ -0,0 +1,66 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultLogsPollInterval is the period at which new logs are retrieved by StreamLogs when no interval is given
+const DefaultLogsPollInterval = 2 * time.Second
+
+// LogCallback is a function called by LogService.StreamLogs with new log entries in chronological order.
+// Streaming stops if it returns an error.
+type LogCallback func(logs []Log) error
+
+// StreamLogs calls the given callback with new log entries of the current deployment of an application environment
+// until the given context is cancelled.
+//
+// Logs are retrieved incrementally, starting from the first log entry, by polling Alien4Cloud at the given
+// interval, or DefaultLogsPollInterval if interval is zero. The context error is returned when it is cancelled,
+// otherwise the first error occurring while retrieving logs or returned by the callback is returned.
+func (l *logService) StreamLogs(ctx context.Context, applicationID, environmentID string, filters LogFilter,
+	interval time.Duration, callback LogCallback) error {
+
+	if interval <= 0 {
+		interval = DefaultLogsPollInterval
+	}
+	logIndex := 0
+	for {
+		if err := l.client.waitLeadership(ctx); err != nil {
+			return err
+		}
+		logs, nbLogs, err := l.GetLogsOfApplication(ctx, applicationID, environmentID, filters, logIndex)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		logIndex += nbLogs
+		if len(logs) > 0 {
+			if err = callback(logs); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_logService_StreamLogs(t *testing.T) {
+	var lock sync.Mutex
+	logs := []Log{{ID: "1", Content: "creating"}, {ID: "2", Content: "created"}}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/latest/deployments/search":
+			_, _ = w.Write([]byte(`{"data":{"data":[{"deployment":{"id":"dep1"}}],"totalResults":1}}`))
+		case "/rest/latest/deployment/logs/search":
+			var lsr logsSearchRequest
+			assert.NilError(t, json.NewDecoder(r.Body).Decode(&lsr))
+			assert.DeepEqual(t, lsr.Filters.DeploymentID, []string{"dep1"})
+			lock.Lock()
+			defer lock.Unlock()
+			var res struct {
+				Data struct {
+					Data         []Log `json:"data"`
+					TotalResults int   `json:"totalResults"`
+				} `json:"data"`
+			}
+			res.Data.TotalResults = len(logs)
+			for i := lsr.From; i < len(logs) && i < lsr.From+lsr.Size; i++ {
+				res.Data.Data = append(res.Data.Data, logs[i])
+			}
+			b, err := json.Marshal(res)
+			assert.NilError(t, err)
+			_, _ = w.Write(b)
+		default:
+			t.Errorf("Unexpected call for request %+v", r)
+		}
+	}))
+	defer ts.Close()
+
+	client := &a4cClient{client: http.DefaultClient, baseURL: ts.URL}
+	client.deploymentService = &deploymentService{client}
+	logService := &logService{client}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var received []string
+	err := logService.StreamLogs(ctx, "app", "env", LogFilter{}, time.Millisecond, func(newLogs []Log) error {
+		for _, l := range newLogs {
+			received = append(received, l.ID)
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		if len(received) == 2 {
+			logs = append(logs, Log{ID: "3", Content: "starting"})
+		} else {
+			cancel()
+		}
+		return nil
+	})
+	assert.Equal(t, err, context.Canceled)
+	assert.DeepEqual(t, received, []string{"1", "2", "3"})
+
+	stopErr := errors.New("stop")
+	err = logService.StreamLogs(context.Background(), "app", "env", LogFilter{}, time.Millisecond, func(newLogs []Log) error {
+		return stopErr
+	})
+	assert.Equal(t, err, stopErr)
+}
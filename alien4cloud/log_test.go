@@ -138,3 +138,27 @@ func Test_deploymentService_GetLogsOfApplication(t *testing.T) {
 		})
 	}
 }
+
+func TestSummarizeLogs(t *testing.T) {
+	logs := []Log{
+		{ID: "1", Level: "info", Content: "start"},
+		{ID: "2", Level: "ERROR", NodeID: "Compute", InterfaceName: "standard", OperationName: "create", Content: "first failure"},
+		{ID: "3", Level: "warn", Content: "retrying"},
+		{ID: "4", Level: "error", NodeID: "App", InterfaceName: "standard", OperationName: "start", Content: "last failure"},
+	}
+
+	summary := SummarizeLogs(logs)
+	assert.Equal(t, summary.Total, 4)
+	assert.Equal(t, summary.CountByLevel[LogLevelInfo], 1)
+	assert.Equal(t, summary.CountByLevel[LogLevelWarn], 1)
+	assert.Equal(t, summary.CountByLevel[LogLevelError], 2)
+	assert.Equal(t, summary.FirstError.ID, "2")
+	assert.Equal(t, summary.LastError.ID, "4")
+	assert.Equal(t, summary.FailingNode, "App")
+	assert.Equal(t, summary.FailingInterface, "standard")
+	assert.Equal(t, summary.FailingOperation, "start")
+
+	summary = SummarizeLogs([]Log{{ID: "1", Level: "info"}})
+	assert.Assert(t, summary.FirstError == nil)
+	assert.Equal(t, summary.FailingNode, "")
+}
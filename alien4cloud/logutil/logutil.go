@@ -0,0 +1,146 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package logutil provides helpers to display Alien4Cloud deployment logs on terminals.
+package logutil
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/types"
+	"github.com/fatih/color"
+	"github.com/mattn/go-isatty"
+)
+
+// Layout is the layout of formatted log entries
+type Layout int
+
+const (
+	// LayoutCompact displays the timestamp, level, node, instance, operation and content of log entries
+	LayoutCompact Layout = iota
+	// LayoutFull displays all fields of log entries
+	LayoutFull
+)
+
+// Formatter formats log entries for terminals
+type Formatter struct {
+	// Layout is the layout of formatted log entries
+	Layout Layout
+	// Color enables colorization of log levels, it should only be enabled when writing to a terminal (see IsTerminal)
+	Color bool
+	// TimeFormat is the format of timestamps, defaults to time.RFC3339
+	TimeFormat string
+
+	// widths of aligned columns, they grow with the largest value seen
+	widths []int
+}
+
+var levelColors = map[string]color.Attribute{
+	types.LogLevelDebug: color.FgBlue,
+	types.LogLevelInfo:  color.FgGreen,
+	types.LogLevelWarn:  color.FgYellow,
+	types.LogLevelError: color.FgRed,
+}
+
+// Format returns the given log entry formatted according to the formatter layout, without trailing new line.
+//
+// Columns are aligned on the largest value previously formatted by this Formatter.
+func (f *Formatter) Format(l types.Log) string {
+	timeFormat := f.TimeFormat
+	if timeFormat == "" {
+		timeFormat = time.RFC3339
+	}
+
+	var columns []string
+	switch f.Layout {
+	case LayoutFull:
+		columns = []string{l.DeploymentPaaSID, strings.ToUpper(l.Level), l.WorkflowID, l.NodeID, l.InstanceID, l.InterfaceName, l.OperationName}
+	default:
+		columns = []string{strings.ToUpper(l.Level), nodeInstance(l), operation(l)}
+	}
+
+	var b strings.Builder
+	b.WriteString(l.Timestamp.Format(timeFormat))
+	for i, c := range columns {
+		b.WriteString(" ")
+		padded := f.pad(i, c)
+		if f.isLevelColumn(i) {
+			padded = f.colorize(l.Level, padded)
+		}
+		b.WriteString(padded)
+	}
+	b.WriteString(" ")
+	b.WriteString(strings.TrimRight(l.Content, "\n"))
+	return b.String()
+}
+
+func (f *Formatter) isLevelColumn(i int) bool {
+	if f.Layout == LayoutFull {
+		return i == 1
+	}
+	return i == 0
+}
+
+// pad pads the value of the i-th column to the largest value seen on this column
+func (f *Formatter) pad(i int, value string) string {
+	for len(f.widths) <= i {
+		f.widths = append(f.widths, 0)
+	}
+	if len(value) > f.widths[i] {
+		f.widths[i] = len(value)
+	}
+	return value + strings.Repeat(" ", f.widths[i]-len(value))
+}
+
+func (f *Formatter) colorize(level, value string) string {
+	if !f.Color {
+		return value
+	}
+	attr, ok := levelColors[strings.ToLower(level)]
+	if !ok {
+		return value
+	}
+	c := color.New(attr)
+	c.EnableColor()
+	return c.Sprint(value)
+}
+
+// IsTerminal returns true if w is a terminal, colorization of log levels may then be enabled
+func IsTerminal(w io.Writer) bool {
+	f, ok := w.(interface{ Fd() uintptr })
+	if !ok {
+		return false
+	}
+	return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+}
+
+func nodeInstance(l types.Log) string {
+	if l.NodeID == "" {
+		return "-"
+	}
+	if l.InstanceID == "" {
+		return l.NodeID
+	}
+	return fmt.Sprintf("%s/%s", l.NodeID, l.InstanceID)
+}
+
+func operation(l types.Log) string {
+	if l.InterfaceName == "" && l.OperationName == "" {
+		return "-"
+	}
+	return fmt.Sprintf("%s.%s", l.InterfaceName, l.OperationName)
+}
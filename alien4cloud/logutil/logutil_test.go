@@ -0,0 +1,84 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logutil
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/alien4cloud/alien4cloud-go-client/v3/a4cmocks"
+	"github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud"
+	"github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/types"
+	"github.com/golang/mock/gomock"
+	"gotest.tools/v3/assert"
+)
+
+func testLogs() []types.Log {
+	ts := types.Time{Time: time.Date(2020, 1, 13, 21, 58, 27, 0, time.UTC)}
+	return []types.Log{
+		{Timestamp: ts, DeploymentPaaSID: "app-env", Level: "info", WorkflowID: "install", NodeID: "Compute", InstanceID: "0",
+			InterfaceName: "standard", OperationName: "create", Content: "creating\n"},
+		{Timestamp: ts, DeploymentPaaSID: "app-env", Level: "error", WorkflowID: "install", Content: "failed"},
+	}
+}
+
+func TestFormatter_Format(t *testing.T) {
+	logs := testLogs()
+
+	f := &Formatter{}
+	assert.Equal(t, f.Format(logs[0]), "2020-01-13T21:58:27Z INFO Compute/0 standard.create creating")
+	assert.Equal(t, f.Format(logs[1]), "2020-01-13T21:58:27Z ERROR -         -               failed")
+
+	f = &Formatter{Layout: LayoutFull}
+	assert.Equal(t, f.Format(logs[0]), "2020-01-13T21:58:27Z app-env INFO install Compute 0 standard create creating")
+
+	f = &Formatter{Color: true, TimeFormat: time.Kitchen}
+	assert.Equal(t, f.Format(logs[1]), "9:58PM \x1b[31mERROR\x1b[0m - - failed")
+}
+
+func TestIsTerminal(t *testing.T) {
+	var b bytes.Buffer
+	assert.Assert(t, !IsTerminal(&b))
+
+	f, err := ioutil.TempFile("", "logutil")
+	assert.NilError(t, err)
+	defer os.Remove(f.Name())
+	defer f.Close()
+	assert.Assert(t, !IsTerminal(f))
+}
+
+func TestTailWriter_Tail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logs := testLogs()
+	logServiceMock := a4cmocks.NewMockLogService(ctrl)
+	logServiceMock.EXPECT().StreamLogs(gomock.Any(), "app", "env", gomock.Any(), time.Millisecond, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, appID, envID string, filters alien4cloud.LogFilter, interval time.Duration, callback alien4cloud.LogCallback) error {
+			assert.NilError(t, callback(logs[:1]))
+			assert.NilError(t, callback(logs[1:]))
+			return context.Canceled
+		})
+
+	var b bytes.Buffer
+	err := NewTailWriter(&b, LayoutCompact, false).Tail(context.Background(), logServiceMock, "app", "env", alien4cloud.LogFilter{}, time.Millisecond)
+	assert.Equal(t, err, context.Canceled)
+	assert.Equal(t, b.String(), "2020-01-13T21:58:27Z INFO Compute/0 standard.create creating\n"+
+		"2020-01-13T21:58:27Z ERROR -         -               failed\n")
+}
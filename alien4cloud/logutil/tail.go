@@ -0,0 +1,58 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logutil
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud"
+	"github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/types"
+)
+
+// TailWriter writes formatted log entries to an io.Writer, one per line
+type TailWriter struct {
+	Writer    io.Writer
+	Formatter Formatter
+}
+
+// NewTailWriter returns a TailWriter writing log entries to w using the given layout.
+// Colors are enabled if color is true, IsTerminal(w) tells if they are supported.
+func NewTailWriter(w io.Writer, layout Layout, color bool) *TailWriter {
+	return &TailWriter{Writer: w, Formatter: Formatter{Layout: layout, Color: color}}
+}
+
+// WriteLogs writes the given log entries
+func (t *TailWriter) WriteLogs(logs ...types.Log) error {
+	for _, l := range logs {
+		_, err := io.WriteString(t.Writer, t.Formatter.Format(l)+"\n")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Tail writes logs of the given application environment streamed by LogService.StreamLogs until
+// the context is cancelled or an error occurs.
+//
+// The returned error is the context error when it is cancelled.
+func (t *TailWriter) Tail(ctx context.Context, logService alien4cloud.LogService, appID, envID string,
+	filters alien4cloud.LogFilter, interval time.Duration) error {
+	return logService.StreamLogs(ctx, appID, envID, filters, interval, func(logs []types.Log) error {
+		return t.WriteLogs(logs...)
+	})
+}
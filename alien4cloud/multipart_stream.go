@@ -0,0 +1,165 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// multipartStream is a multipart body made of a single file part.
+//
+// The file content is streamed instead of being buffered in memory, so that large files could be uploaded.
+// As requests bodies may be sent again on retries, the stream could be rewound as long as
+// the content is an io.Seeker. Otherwise reading a rewound stream fails.
+// Reading the stream fails once its context is cancelled, in order to stop uploads mid-transfer.
+type multipartStream struct {
+	ctx context.Context
+	// progress is called each time some content is read with the number of bytes read and the content size or -1 if unknown
+	progress func(sent, total int64)
+
+	contentType string
+	// size is the size of the whole body or -1 if unknown
+	size        int64
+	contentSize int64
+	header      []byte
+	trailer     []byte
+
+	content      io.Reader
+	contentStart int64
+	contentRead  int64
+
+	reader io.Reader
+	err    error
+}
+
+// newMultipartStream returns a multipart body holding content as a file part
+func newMultipartStream(fieldName, fileName string, content io.Reader) (*multipartStream, error) {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	_, err := w.CreateFormFile(fieldName, fileName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to create form file for %s", fileName)
+	}
+	s := &multipartStream{
+		ctx:         context.Background(),
+		contentType: w.FormDataContentType(),
+		size:        -1,
+		contentSize: -1,
+		header:      append([]byte(nil), b.Bytes()...),
+		content:     content,
+	}
+	b.Reset()
+	err = w.Close()
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to close form for %s", fileName)
+	}
+	s.trailer = b.Bytes()
+
+	if seeker, ok := content.(io.Seeker); ok {
+		s.contentStart, err = seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to get the size of %s", fileName)
+		}
+		end, err := seeker.Seek(0, io.SeekEnd)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to get the size of %s", fileName)
+		}
+		_, err = seeker.Seek(s.contentStart, io.SeekStart)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to get the size of %s", fileName)
+		}
+		s.contentSize = end - s.contentStart
+		s.size = int64(len(s.header)) + s.contentSize + int64(len(s.trailer))
+	}
+	s.reset()
+	return s, nil
+}
+
+func (s *multipartStream) reset() {
+	s.contentRead = 0
+	s.reader = io.MultiReader(bytes.NewReader(s.header), contentReader{s}, bytes.NewReader(s.trailer))
+}
+
+func (s *multipartStream) Read(p []byte) (int, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+	return s.reader.Read(p)
+}
+
+// Seek only supports rewinding the stream to its beginning
+func (s *multipartStream) Seek(offset int64, whence int) (int64, error) {
+	if offset != 0 || whence != io.SeekStart {
+		return 0, errors.New("multipart stream could only be rewound")
+	}
+	if seeker, ok := s.content.(io.Seeker); ok {
+		_, err := seeker.Seek(s.contentStart, io.SeekStart)
+		if err != nil {
+			s.err = errors.Wrap(err, "Failed to rewind multipart stream")
+			return 0, s.err
+		}
+	} else if s.contentRead > 0 {
+		s.err = errors.New("Failed to rewind multipart stream: content is not seekable")
+		return 0, s.err
+	}
+	s.reset()
+	return 0, nil
+}
+
+// contentReader reads the content of a multipart stream, checking its context and reporting progress
+type contentReader struct {
+	s *multipartStream
+}
+
+func (r contentReader) Read(p []byte) (int, error) {
+	s := r.s
+	if err := s.ctx.Err(); err != nil {
+		return 0, err
+	}
+	n, err := s.content.Read(p)
+	if s.progress != nil && n > 0 {
+		defer func() { s.progress(s.contentRead, s.contentSize) }()
+	}
+	s.contentRead += int64(n)
+	return n, err
+}
+
+// newMultipartStreamRequest creates a POST request streaming content as a multipart file part
+//
+// The progress function is optional.
+func (c *a4cClient) newMultipartStreamRequest(ctx context.Context, urlStr, fileName string, content io.Reader,
+	progress func(sent, total int64)) (*http.Request, error) {
+	body, err := newMultipartStream("file", fileName, content)
+	if err != nil {
+		return nil, err
+	}
+	body.ctx = ctx
+	body.progress = progress
+	request, err := c.NewRequest(ctx, "POST", urlStr, body)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", body.contentType)
+	if body.size >= 0 {
+		request.ContentLength = body.size
+	}
+	return request, nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"gotest.tools/v3/assert"
+)
+
+func readMultipartFile(t *testing.T, body io.Reader, contentType string) (string, string) {
+	t.Helper()
+	_, params, err := mime.ParseMediaType(contentType)
+	assert.NilError(t, err)
+	part, err := multipart.NewReader(body, params["boundary"]).NextPart()
+	assert.NilError(t, err)
+	content, err := ioutil.ReadAll(part)
+	assert.NilError(t, err)
+	return part.FileName(), string(content)
+}
+
+func Test_multipartStream(t *testing.T) {
+	s, err := newMultipartStream("file", "data.bin", strings.NewReader("some content"))
+	assert.NilError(t, err)
+
+	first, err := ioutil.ReadAll(s)
+	assert.NilError(t, err)
+	assert.Equal(t, int64(len(first)), s.size)
+	fileName, content := readMultipartFile(t, bytes.NewReader(first), s.contentType)
+	assert.Equal(t, fileName, "data.bin")
+	assert.Equal(t, content, "some content")
+
+	_, err = s.Seek(0, io.SeekStart)
+	assert.NilError(t, err)
+	second, err := ioutil.ReadAll(s)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, first, second)
+
+	_, err = s.Seek(10, io.SeekStart)
+	assert.ErrorContains(t, err, "could only be rewound")
+}
+
+func Test_multipartStreamNotSeekable(t *testing.T) {
+	s, err := newMultipartStream("file", "data.bin", ioutil.NopCloser(strings.NewReader("some content")))
+	assert.NilError(t, err)
+	assert.Equal(t, s.size, int64(-1))
+
+	// Rewinding is possible as long as the content was not read
+	_, err = s.Seek(0, io.SeekStart)
+	assert.NilError(t, err)
+	_, err = ioutil.ReadAll(s)
+	assert.NilError(t, err)
+
+	_, err = s.Seek(0, io.SeekStart)
+	assert.ErrorContains(t, err, "content is not seekable")
+	_, err = s.Read(make([]byte, 10))
+	assert.ErrorContains(t, err, "content is not seekable")
+}
+
+func Test_catalogService_UploadCSARStreaming(t *testing.T) {
+	var contentLength int64
+	var content string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentLength = r.ContentLength
+		_, content = readMultipartFile(t, r.Body, r.Header.Get("Content-Type"))
+		_, _ = w.Write([]byte(`{"data":{"csar":{"name":"test","version":"1.0.0"}}}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL, "", "", "", false)
+	assert.NilError(t, err)
+	csar, err := client.CatalogService().UploadCSAR(context.Background(), bytes.NewReader([]byte("zip content")), "")
+	assert.NilError(t, err)
+	assert.Equal(t, csar.Name, "test")
+	assert.Equal(t, content, "zip content")
+	assert.Assert(t, contentLength > int64(len("zip content")))
+
+	// Content of unknown size is sent using a chunked transfer encoding
+	_, err = client.CatalogService().UploadCSAR(context.Background(), ioutil.NopCloser(strings.NewReader("other content")), "")
+	assert.NilError(t, err)
+	assert.Equal(t, content, "other content")
+	assert.Equal(t, contentLength, int64(-1))
+}
+
+func Test_catalogService_UploadCSARWithProgress(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = ioutil.ReadAll(r.Body)
+		_, _ = w.Write([]byte(`{"data":{"csar":{"name":"test","version":"1.0.0"}}}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL, "", "", "", false)
+	assert.NilError(t, err)
+	archive := bytes.Repeat([]byte("a"), 100000)
+	var sent, total int64
+	calls := 0
+	_, err = client.CatalogService().UploadCSARWithProgress(context.Background(), bytes.NewReader(archive), "", func(s, tot int64) {
+		assert.Assert(t, s >= sent, "progress should not decrease")
+		sent, total = s, tot
+		calls++
+	})
+	assert.NilError(t, err)
+	assert.Assert(t, calls > 1)
+	assert.Equal(t, sent, int64(len(archive)))
+	assert.Equal(t, total, int64(len(archive)))
+}
+
+func Test_catalogService_UploadCSARCancel(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = ioutil.ReadAll(r.Body)
+		_, _ = w.Write([]byte(`{"data":{"csar":{"name":"test","version":"1.0.0"}}}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL, "", "", "", false)
+	assert.NilError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	archive := bytes.Repeat([]byte("a"), 1000000)
+	var sent int64
+	_, err = client.CatalogService().UploadCSARWithProgress(ctx, bytes.NewReader(archive), "", func(s, tot int64) {
+		sent = s
+		cancel()
+	})
+	assert.Assert(t, errors.Is(err, context.Canceled), "unexpected error %v", err)
+	assert.Assert(t, sent < int64(len(archive)), "upload should stop once cancelled")
+}
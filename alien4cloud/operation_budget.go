@@ -0,0 +1,118 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// BudgetStep records the timing of a step of an OperationBudget
+type BudgetStep struct {
+	Name string
+	// Allotted is the time allotted to the step, it is 0 if the operation has no deadline
+	Allotted time.Duration
+	// Elapsed is the time spent in the step, it is 0 while the step is running
+	Elapsed time.Duration
+	// Exceeded is true if the step was still running when its allotted time elapsed
+	Exceeded bool
+}
+
+// OperationBudget splits the deadline of a context across the successive steps of an operation made
+// of several calls to Alien4Cloud, for instance resolving IDs, updating inputs, deploying and waiting.
+//
+// Each step is allowed to use a fraction of the remaining time, so that early steps can't consume the whole
+// budget and the last steps, typically waits, get a predictable remainder. When the context has no deadline
+// steps are not limited but their timing is still recorded. Steps timings are available for diagnostics using Steps.
+//
+// An OperationBudget is safe for concurrent use.
+type OperationBudget struct {
+	deadline time.Time
+
+	lock  sync.Mutex
+	steps []BudgetStep
+}
+
+// NewOperationBudget returns an OperationBudget splitting the deadline of the given context
+func NewOperationBudget(ctx context.Context) *OperationBudget {
+	deadline, _ := ctx.Deadline()
+	return &OperationBudget{deadline: deadline}
+}
+
+// Remaining returns the time remaining before the deadline of the operation, the returned boolean is false
+// if the operation has no deadline
+func (b *OperationBudget) Remaining() (time.Duration, bool) {
+	if b.deadline.IsZero() {
+		return 0, false
+	}
+	remaining := time.Until(b.deadline)
+	if remaining < 0 {
+		remaining = 0
+	}
+	return remaining, true
+}
+
+// Step starts a step allowed to use the given fraction of the remaining time of the operation.
+//
+// A fraction lower or equal to 0 or greater or equal to 1 allows the step to use all the remaining time,
+// which is typically used for the last step. The returned context should be used by the calls of the step
+// and the returned function should be called once the step is done, it records the step timing and releases
+// resources associated with the context.
+func (b *OperationBudget) Step(ctx context.Context, name string, fraction float64) (context.Context, func()) {
+	step := BudgetStep{Name: name}
+	stepCtx, cancel := ctx, context.CancelFunc(func() {})
+	if remaining, ok := b.Remaining(); ok {
+		step.Allotted = remaining
+		if fraction > 0 && fraction < 1 {
+			step.Allotted = time.Duration(float64(remaining) * fraction)
+		}
+		stepCtx, cancel = context.WithTimeout(ctx, step.Allotted)
+	}
+
+	b.lock.Lock()
+	index := len(b.steps)
+	b.steps = append(b.steps, step)
+	b.lock.Unlock()
+
+	start := time.Now()
+	var once sync.Once
+	return stepCtx, func() {
+		once.Do(func() {
+			elapsed := time.Since(start)
+			exceeded := step.Allotted > 0 && stepCtx.Err() == context.DeadlineExceeded
+			cancel()
+			b.lock.Lock()
+			b.steps[index].Elapsed = elapsed
+			b.steps[index].Exceeded = exceeded
+			b.lock.Unlock()
+		})
+	}
+}
+
+// Run runs fn as a step allowed to use the given fraction of the remaining time of the operation,
+// see Step for details. It returns the error returned by fn.
+func (b *OperationBudget) Run(ctx context.Context, name string, fraction float64, fn func(ctx context.Context) error) error {
+	stepCtx, done := b.Step(ctx, name, fraction)
+	defer done()
+	return fn(stepCtx)
+}
+
+// Steps returns the steps started so far in their starting order
+func (b *OperationBudget) Steps() []BudgetStep {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return append([]BudgetStep(nil), b.steps...)
+}
@@ -0,0 +1,73 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestOperationBudget(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	budget := NewOperationBudget(ctx)
+
+	// A step blocking until its context is done can't consume the whole budget
+	err := budget.Run(ctx, "resolve", 0.25, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	assert.Assert(t, errors.Is(err, context.DeadlineExceeded))
+	assert.NilError(t, ctx.Err(), "the operation context should not be done")
+
+	remaining, ok := budget.Remaining()
+	assert.Assert(t, ok)
+	assert.Assert(t, remaining > 100*time.Millisecond, "remaining %s", remaining)
+
+	stepCtx, done := budget.Step(ctx, "wait", 1)
+	deadline, ok := stepCtx.Deadline()
+	assert.Assert(t, ok)
+	parentDeadline, _ := ctx.Deadline()
+	assert.Equal(t, deadline, parentDeadline, "last step should get the remainder")
+	done()
+	done()
+	assert.ErrorContains(t, stepCtx.Err(), "canceled")
+
+	steps := budget.Steps()
+	assert.Equal(t, len(steps), 2)
+	assert.Equal(t, steps[0].Name, "resolve")
+	assert.Assert(t, steps[0].Exceeded)
+	assert.Assert(t, steps[0].Allotted > 0 && steps[0].Allotted <= 50*time.Millisecond, "allotted %s", steps[0].Allotted)
+	assert.Assert(t, steps[0].Elapsed >= steps[0].Allotted)
+	assert.Equal(t, steps[1].Name, "wait")
+	assert.Assert(t, !steps[1].Exceeded)
+
+	// Without deadline steps are not limited but recorded
+	budget = NewOperationBudget(context.Background())
+	_, ok = budget.Remaining()
+	assert.Assert(t, !ok)
+	err = budget.Run(context.Background(), "deploy", 0.5, func(ctx context.Context) error {
+		_, hasDeadline := ctx.Deadline()
+		assert.Assert(t, !hasDeadline)
+		return errors.New("deployment failed")
+	})
+	assert.ErrorContains(t, err, "deployment failed")
+	assert.Equal(t, budget.Steps()[0].Name, "deploy")
+	assert.Equal(t, budget.Steps()[0].Allotted, time.Duration(0))
+}
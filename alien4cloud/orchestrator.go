@@ -31,6 +31,24 @@ type OrchestratorService interface {
 	GetOrchestratorLocations(ctx context.Context, orchestratorID string) ([]Location, error)
 	// Returns the Alien4Cloud orchestrator ID from a given orchestator name
 	GetOrchestratorIDbyName(ctx context.Context, orchestratorName string) (string, error)
+	// Returns all orchestrators with their state and a summary of their locations
+	ListOrchestrators(ctx context.Context) ([]OrchestratorSummary, error)
+	// Creates an orchestrator using the given plugin and returns its ID, the orchestrator is created disabled
+	CreateOrchestrator(ctx context.Context, name, pluginID, pluginBean string) (string, error)
+	// Deletes an orchestrator, it should be disabled first
+	DeleteOrchestrator(ctx context.Context, orchestratorID string) error
+	// Enables an orchestrator, it connects to the underlying orchestrator
+	EnableOrchestrator(ctx context.Context, orchestratorID string) error
+	// Disables an orchestrator, force allows to disable an orchestrator still having deployments
+	DisableOrchestrator(ctx context.Context, orchestratorID string, force bool) error
+	// Waits until the state of an orchestrator is one of the given states and returns the actual state
+	WaitForOrchestratorState(ctx context.Context, orchestratorID string, states ...string) (string, error)
+	// WaitForOrchestratorStateWithOptions is like WaitForOrchestratorState but allows to configure the poll interval
+	WaitForOrchestratorStateWithOptions(ctx context.Context, orchestratorID string, opts WaitForOrchestratorStateOptions, states ...string) (string, error)
+	// Returns the configuration properties of an orchestrator
+	GetOrchestratorConfiguration(ctx context.Context, orchestratorID string) (map[string]interface{}, error)
+	// Updates the configuration properties of an orchestrator
+	SetOrchestratorConfiguration(ctx context.Context, orchestratorID string, configuration map[string]interface{}) error
 }
 
 type orchestratorService struct {
@@ -83,7 +101,7 @@ func (o *orchestratorService) GetOrchestratorLocations(ctx context.Context, orch
 // GetOrchestratorIDbyName Return the Alien4Cloud orchestrator ID from a given orchestator name
 func (o *orchestratorService) GetOrchestratorIDbyName(ctx context.Context, orchestratorName string) (string, error) {
 
-	orchestratorsSearchBody, err := json.Marshal(SearchRequest{orchestratorName, 0, 1, nil})
+	orchestratorsSearchBody, err := json.Marshal(SearchRequest{Query: orchestratorName, From: 0, Size: 1})
 
 	if err != nil {
 		return "", errors.Wrap(err, "Cannot marshal a SearchRequest structure")
@@ -127,3 +145,25 @@ func (o *orchestratorService) GetOrchestratorIDbyName(ctx context.Context, orche
 	}
 	return orchestratorID, nil
 }
+
+// getOrchestrator returns an orchestrator given its ID
+func (o *orchestratorService) getOrchestrator(ctx context.Context, orchestratorID string) (Orchestrator, error) {
+	request, err := o.client.NewRequest(ctx,
+		"GET",
+		fmt.Sprintf("%s/orchestrators/%s", a4CRestAPIPrefix, orchestratorID),
+		nil,
+	)
+	if err != nil {
+		return Orchestrator{}, errors.Wrapf(err, "Unable to create request to get orchestrator '%s'", orchestratorID)
+	}
+
+	var res struct {
+		Data Orchestrator `json:"data"`
+	}
+	response, err := o.client.Do(request)
+	if err != nil {
+		return Orchestrator{}, errors.Wrapf(err, "Unable to send request to get orchestrator '%s'", orchestratorID)
+	}
+	err = ReadA4CResponse(response, &res)
+	return res.Data, errors.Wrapf(err, "Unable to get orchestrator '%s'", orchestratorID)
+}
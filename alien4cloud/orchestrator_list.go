@@ -0,0 +1,135 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const orchestratorsPageSize = 100
+
+// ListOrchestrators returns all orchestrators with their state and a summary of their locations.
+//
+// Locations of orchestrators are retrieved concurrently, a *BulkError is returned holding errors of
+// orchestrators whose locations could not be retrieved.
+func (o *orchestratorService) ListOrchestrators(ctx context.Context) ([]OrchestratorSummary, error) {
+	orchestrators, err := o.searchOrchestrators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]OrchestratorSummary, len(orchestrators))
+	ids := make([]string, len(orchestrators))
+	indexes := make(map[string]int, len(orchestrators))
+	for i := range orchestrators {
+		summaries[i].Orchestrator = orchestrators[i]
+		ids[i] = orchestrators[i].ID
+		indexes[orchestrators[i].ID] = i
+	}
+	err = runBulk(ctx, ids, func(ctx context.Context, orchestratorID string) error {
+		locations, err := o.getLocationsSummaries(ctx, orchestratorID)
+		summaries[indexes[orchestratorID]].Locations = locations
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return summaries, nil
+}
+
+// searchOrchestrators returns all orchestrators
+func (o *orchestratorService) searchOrchestrators(ctx context.Context) ([]Orchestrator, error) {
+	var orchestrators []Orchestrator
+	for {
+		request, err := o.client.NewRequest(ctx,
+			"GET",
+			fmt.Sprintf("%s/orchestrators?from=%d&size=%d", a4CRestAPIPrefix, len(orchestrators), orchestratorsPageSize),
+			nil,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "Unable to create request to list orchestrators")
+		}
+
+		var res struct {
+			Data struct {
+				Data         []Orchestrator `json:"data"`
+				TotalResults int            `json:"totalResults"`
+			} `json:"data"`
+		}
+		response, err := o.client.Do(request)
+		if err != nil {
+			return nil, errors.Wrap(err, "Unable to send request to list orchestrators")
+		}
+		err = ReadA4CResponse(response, &res)
+		if err != nil {
+			return nil, errors.Wrap(err, "Unable to list orchestrators")
+		}
+		orchestrators = append(orchestrators, res.Data.Data...)
+		if len(res.Data.Data) == 0 || len(orchestrators) >= res.Data.TotalResults {
+			return orchestrators, nil
+		}
+	}
+}
+
+// getLocationsSummaries returns a summary of the locations of an orchestrator
+func (o *orchestratorService) getLocationsSummaries(ctx context.Context, orchestratorID string) ([]LocationSummary, error) {
+	request, err := o.client.NewRequest(ctx,
+		"GET",
+		fmt.Sprintf("%s/orchestrators/%s/locations", a4CRestAPIPrefix, orchestratorID),
+		nil,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to create request to get orchestrator location for orchestrator '%s'", orchestratorID)
+	}
+
+	var res struct {
+		Data []struct {
+			Location  LocationConfiguration `json:"location"`
+			Resources struct {
+				NodeTemplates []LocationResourceTemplate `json:"nodeTemplates"`
+			} `json:"resources"`
+		} `json:"data"`
+	}
+	response, err := o.client.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to send request to get orchestrator location for orchestrator '%s'", orchestratorID)
+	}
+	err = ReadA4CResponse(response, &res)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get orchestrator location for orchestrator '%s'", orchestratorID)
+	}
+
+	summaries := make([]LocationSummary, 0, len(res.Data))
+	for _, l := range res.Data {
+		summary := LocationSummary{
+			ID:                 l.Location.ID,
+			Name:               l.Location.Name,
+			InfrastructureType: l.Location.InfrastructureType,
+			EnvironmentType:    l.Location.EnvironmentType,
+		}
+		for _, resource := range l.Resources.NodeTemplates {
+			if resource.Service {
+				summary.ServicesCount++
+			} else {
+				summary.OnDemandResourcesCount++
+			}
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, nil
+}
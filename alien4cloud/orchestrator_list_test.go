@@ -0,0 +1,63 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_orchestratorService_ListOrchestrators(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/orchestrators/orch1/locations`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":[{"location":{"id":"loc1","name":"openstack","infrastructureType":"openstack","environmentType":"OTHER"},
+				"resources":{"nodeTemplates":[{"id":"r1","service":false},{"id":"r2","service":false},{"id":"s1","service":true}]}}]}`))
+		case regexp.MustCompile(`.*/orchestrators/orch2/locations`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":[]}`))
+		case regexp.MustCompile(`.*/orchestrators$`).Match([]byte(r.URL.Path)):
+			if r.URL.Query().Get("from") == "0" {
+				_, _ = w.Write([]byte(`{"data":{"data":[{"id":"orch1","name":"yorc","state":"CONNECTED"}],"totalResults":2}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":{"data":[{"id":"orch2","name":"other","state":"DISABLED"}],"totalResults":2}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	o := &orchestratorService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	orchestrators, err := o.ListOrchestrators(context.Background())
+	assert.NilError(t, err)
+	assert.DeepEqual(t, orchestrators, []OrchestratorSummary{
+		{
+			Orchestrator: Orchestrator{ID: "orch1", Name: "yorc", State: OrchestratorConnected},
+			Locations: []LocationSummary{
+				{ID: "loc1", Name: "openstack", InfrastructureType: "openstack", EnvironmentType: "OTHER", OnDemandResourcesCount: 2, ServicesCount: 1},
+			},
+		},
+		{
+			Orchestrator: Orchestrator{ID: "orch2", Name: "other", State: OrchestratorDisabled},
+			Locations:    []LocationSummary{},
+		},
+	})
+}
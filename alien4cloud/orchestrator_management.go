@@ -0,0 +1,161 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// CreateOrchestrator creates an orchestrator using the given plugin and returns its ID
+func (o *orchestratorService) CreateOrchestrator(ctx context.Context, name, pluginID, pluginBean string) (string, error) {
+	body, err := json.Marshal(struct {
+		Name       string `json:"name"`
+		PluginID   string `json:"pluginId"`
+		PluginBean string `json:"pluginBean"`
+	}{
+		Name:       name,
+		PluginID:   pluginID,
+		PluginBean: pluginBean,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "Cannot marshal an orchestrator creation request")
+	}
+
+	request, err := o.client.NewRequest(ctx,
+		"POST",
+		fmt.Sprintf("%s/orchestrators", a4CRestAPIPrefix),
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return "", errors.Wrapf(err, "Unable to create request to create orchestrator '%s'", name)
+	}
+
+	var res struct {
+		Data string `json:"data"`
+	}
+	response, err := o.client.Do(request)
+	if err != nil {
+		return "", errors.Wrapf(err, "Unable to send request to create orchestrator '%s'", name)
+	}
+	err = ReadA4CResponse(response, &res)
+	return res.Data, errors.Wrapf(err, "Unable to create orchestrator '%s'", name)
+}
+
+// DeleteOrchestrator deletes an orchestrator
+func (o *orchestratorService) DeleteOrchestrator(ctx context.Context, orchestratorID string) error {
+	request, err := o.client.NewRequest(ctx,
+		"DELETE",
+		fmt.Sprintf("%s/orchestrators/%s", a4CRestAPIPrefix, orchestratorID),
+		nil,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to create request to delete orchestrator '%s'", orchestratorID)
+	}
+	response, err := o.client.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to send request to delete orchestrator '%s'", orchestratorID)
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Unable to delete orchestrator '%s'", orchestratorID)
+}
+
+// EnableOrchestrator enables an orchestrator
+func (o *orchestratorService) EnableOrchestrator(ctx context.Context, orchestratorID string) error {
+	request, err := o.client.NewRequest(ctx,
+		"POST",
+		fmt.Sprintf("%s/orchestrators/%s/instance", a4CRestAPIPrefix, orchestratorID),
+		nil,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to create request to enable orchestrator '%s'", orchestratorID)
+	}
+	response, err := o.client.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to send request to enable orchestrator '%s'", orchestratorID)
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Unable to enable orchestrator '%s'", orchestratorID)
+}
+
+// DisableOrchestrator disables an orchestrator
+func (o *orchestratorService) DisableOrchestrator(ctx context.Context, orchestratorID string, force bool) error {
+	request, err := o.client.NewRequest(ctx,
+		"DELETE",
+		fmt.Sprintf("%s/orchestrators/%s/instance?force=%s", a4CRestAPIPrefix, orchestratorID, strconv.FormatBool(force)),
+		nil,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to create request to disable orchestrator '%s'", orchestratorID)
+	}
+	response, err := o.client.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to send request to disable orchestrator '%s'", orchestratorID)
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Unable to disable orchestrator '%s'", orchestratorID)
+}
+
+// GetOrchestratorConfiguration returns the configuration properties of an orchestrator
+func (o *orchestratorService) GetOrchestratorConfiguration(ctx context.Context, orchestratorID string) (map[string]interface{}, error) {
+	request, err := o.client.NewRequest(ctx,
+		"GET",
+		fmt.Sprintf("%s/orchestrators/%s/configuration", a4CRestAPIPrefix, orchestratorID),
+		nil,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to create request to get configuration of orchestrator '%s'", orchestratorID)
+	}
+
+	var res struct {
+		Data struct {
+			Configuration map[string]interface{} `json:"configuration"`
+		} `json:"data"`
+	}
+	response, err := o.client.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to send request to get configuration of orchestrator '%s'", orchestratorID)
+	}
+	err = ReadA4CResponse(response, &res)
+	return res.Data.Configuration, errors.Wrapf(err, "Unable to get configuration of orchestrator '%s'", orchestratorID)
+}
+
+// SetOrchestratorConfiguration updates the configuration properties of an orchestrator
+func (o *orchestratorService) SetOrchestratorConfiguration(ctx context.Context, orchestratorID string, configuration map[string]interface{}) error {
+	body, err := json.Marshal(configuration)
+	if err != nil {
+		return errors.Wrap(err, "Cannot marshal an orchestrator configuration")
+	}
+
+	request, err := o.client.NewRequest(ctx,
+		"PUT",
+		fmt.Sprintf("%s/orchestrators/%s/configuration", a4CRestAPIPrefix, orchestratorID),
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to create request to update configuration of orchestrator '%s'", orchestratorID)
+	}
+	response, err := o.client.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to send request to update configuration of orchestrator '%s'", orchestratorID)
+	}
+	err = ReadA4CResponse(response, nil)
+	return errors.Wrapf(err, "Unable to update configuration of orchestrator '%s'", orchestratorID)
+}
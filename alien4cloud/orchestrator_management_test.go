@@ -0,0 +1,84 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_orchestratorService_Management(t *testing.T) {
+	var calls []string
+	var created map[string]string
+	var configuration map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path+"?"+r.URL.RawQuery)
+		switch {
+		case regexp.MustCompile(`.*/orchestrators/unknown.*`).Match([]byte(r.URL.Path)):
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		case regexp.MustCompile(`.*/orchestrators/orchID/configuration`).Match([]byte(r.URL.Path)):
+			if r.Method == http.MethodPut {
+				assert.NilError(t, json.NewDecoder(r.Body).Decode(&configuration))
+				_, _ = w.Write([]byte(`{}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":{"id":"orchID","configuration":{"urlYorc":"http://yorc:8800","insecureTLS":true}}}`))
+		case regexp.MustCompile(`.*/orchestrators$`).Match([]byte(r.URL.Path)) && r.Method == http.MethodPost:
+			assert.NilError(t, json.NewDecoder(r.Body).Decode(&created))
+			_, _ = w.Write([]byte(`{"data":"orchID"}`))
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer ts.Close()
+
+	o := &orchestratorService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	ctx := context.Background()
+
+	id, err := o.CreateOrchestrator(ctx, "yorc", "alien4cloud-yorc-provider:3.0.0", "yorc-orchestrator-factory")
+	assert.NilError(t, err)
+	assert.Equal(t, id, "orchID")
+	assert.DeepEqual(t, created, map[string]string{"name": "yorc", "pluginId": "alien4cloud-yorc-provider:3.0.0", "pluginBean": "yorc-orchestrator-factory"})
+
+	conf, err := o.GetOrchestratorConfiguration(ctx, "orchID")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, conf, map[string]interface{}{"urlYorc": "http://yorc:8800", "insecureTLS": true})
+
+	conf["urlYorc"] = "https://yorc:8800"
+	assert.NilError(t, o.SetOrchestratorConfiguration(ctx, "orchID", conf))
+	assert.DeepEqual(t, configuration, conf)
+
+	assert.NilError(t, o.EnableOrchestrator(ctx, "orchID"))
+	assert.NilError(t, o.DisableOrchestrator(ctx, "orchID", true))
+	assert.NilError(t, o.DeleteOrchestrator(ctx, "orchID"))
+	assert.DeepEqual(t, calls[len(calls)-3:], []string{
+		"POST /rest/latest/orchestrators/orchID/instance?",
+		"DELETE /rest/latest/orchestrators/orchID/instance?force=true",
+		"DELETE /rest/latest/orchestrators/orchID?",
+	})
+
+	assert.ErrorContains(t, o.EnableOrchestrator(ctx, "unknown"), "not found")
+	assert.ErrorContains(t, o.DisableOrchestrator(ctx, "unknown", false), "not found")
+	assert.ErrorContains(t, o.DeleteOrchestrator(ctx, "unknown"), "not found")
+	_, err = o.GetOrchestratorConfiguration(ctx, "unknown")
+	assert.ErrorContains(t, err, "not found")
+}
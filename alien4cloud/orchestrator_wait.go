@@ -0,0 +1,67 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// WaitForOrchestratorStateOptions allows to configure OrchestratorService.WaitForOrchestratorStateWithOptions()
+type WaitForOrchestratorStateOptions struct {
+	// PollInterval is the delay between two state checks, defaults to 1s
+	PollInterval time.Duration
+}
+
+// WaitForOrchestratorState waits until the state of an orchestrator is one of the given states and returns the actual state.
+//
+// Once enabled, an orchestrator goes through the OrchestratorConnecting state before reaching OrchestratorConnected,
+// so this should typically be called after EnableOrchestrator to wait for OrchestratorConnected before creating locations,
+// or after DisableOrchestrator to wait for OrchestratorDisabled.
+func (o *orchestratorService) WaitForOrchestratorState(ctx context.Context, orchestratorID string, states ...string) (string, error) {
+	return o.WaitForOrchestratorStateWithOptions(ctx, orchestratorID, WaitForOrchestratorStateOptions{}, states...)
+}
+
+// WaitForOrchestratorStateWithOptions is like WaitForOrchestratorState but allows to configure the poll interval
+func (o *orchestratorService) WaitForOrchestratorStateWithOptions(ctx context.Context, orchestratorID string, opts WaitForOrchestratorStateOptions, states ...string) (string, error) {
+	if len(states) == 0 {
+		return "", errors.New("at least one state should be given")
+	}
+	pollInterval := opts.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultWaitUntilStatePollInterval
+	}
+	for {
+		orchestrator, err := o.getOrchestrator(ctx, orchestratorID)
+		if err != nil {
+			return "", err
+		}
+		for _, state := range states {
+			if orchestrator.State == state {
+				return orchestrator.State, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", errors.Wrapf(classifyTimeout(fmt.Sprintf("wait for states %v of orchestrator %s", states, orchestratorID), ctx.Err()),
+				"Orchestrator '%s' is in state %s", orchestratorID, orchestrator.State)
+		case <-time.After(pollInterval):
+		}
+	}
+}
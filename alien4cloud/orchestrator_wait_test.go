@@ -0,0 +1,67 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_orchestratorService_WaitForOrchestratorState(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/orchestrators/orch$`).Match([]byte(r.URL.Path)):
+			if atomic.AddInt32(&calls, 1) == 1 {
+				_, _ = w.Write([]byte(`{"data":{"id":"orch","state":"CONNECTING"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":{"id":"orch","state":"CONNECTED"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	o := &orchestratorService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+
+	fastPoll := WaitForOrchestratorStateOptions{PollInterval: 10 * time.Millisecond}
+	state, err := o.WaitForOrchestratorStateWithOptions(context.Background(), "orch", fastPoll, OrchestratorConnected)
+	assert.NilError(t, err)
+	assert.Equal(t, state, OrchestratorConnected)
+	assert.Equal(t, atomic.LoadInt32(&calls), int32(2))
+
+	atomic.StoreInt32(&calls, 0)
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	_, err = o.WaitForOrchestratorStateWithOptions(ctx, "orch", fastPoll, OrchestratorDisabled)
+	assert.Assert(t, errors.Is(err, ErrTimeout), "unexpected error %v", err)
+	assert.Assert(t, atomic.LoadInt32(&calls) > 2, "state should be polled at the given interval")
+
+	_, err = o.WaitForOrchestratorState(context.Background(), "unknown", OrchestratorConnected)
+	assert.ErrorContains(t, err, "not found")
+
+	_, err = o.WaitForOrchestratorState(context.Background(), "orch")
+	assert.ErrorContains(t, err, "at least one state")
+}
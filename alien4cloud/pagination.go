@@ -0,0 +1,82 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultPageSize is the number of results requested by search functions when the SearchRequest size is not set
+	DefaultPageSize = 50
+	// MaxPageSize is the maximum number of results requested at once, larger sizes are capped to this value
+	// and a TruncatedResultsError is returned along with results when more resources match the request
+	MaxPageSize = 1000
+)
+
+// ErrTruncatedResults is returned by search functions when the requested size was capped to MaxPageSize
+// and more resources match the request than were returned.
+// It may be retrieved from returned errors using errors.Is.
+var ErrTruncatedResults = errors.New("results truncated")
+
+// TruncatedResultsError is returned by search functions along with the returned results when the requested
+// size was capped to MaxPageSize and more resources match the request than were returned.
+// Callers should then request next pages using the From field of the search request.
+//
+// It may be retrieved from returned errors using errors.As.
+type TruncatedResultsError struct {
+	// Returned is the number of results actually returned
+	Returned int
+	// Total is the number of resources matching the request
+	Total int
+}
+
+func (e *TruncatedResultsError) Error() string {
+	return fmt.Sprintf("%s: %d results returned out of %d, use pagination", ErrTruncatedResults, e.Returned, e.Total)
+}
+
+// Is allows to match a TruncatedResultsError with ErrTruncatedResults using errors.Is
+func (e *TruncatedResultsError) Is(target error) bool {
+	return target == ErrTruncatedResults
+}
+
+// checkTruncated returns a *TruncatedResultsError if the search request size was capped by paginate
+// and fewer results than requested were returned while more resources match the request
+func checkTruncated(searchRequest SearchRequest, returned, total int) error {
+	if searchRequest.Size <= MaxPageSize {
+		return nil
+	}
+	from := paginate(searchRequest).From
+	if from+returned < total && returned < searchRequest.Size {
+		return errors.WithStack(&TruncatedResultsError{Returned: returned, Total: total})
+	}
+	return nil
+}
+
+// paginate applies default and maximum page sizes to a search request.
+// Search functions report sizes capped to MaxPageSize using checkTruncated.
+func paginate(searchRequest SearchRequest) SearchRequest {
+	if searchRequest.From < 0 {
+		searchRequest.From = 0
+	}
+	if searchRequest.Size <= 0 {
+		searchRequest.Size = DefaultPageSize
+	} else if searchRequest.Size > MaxPageSize {
+		searchRequest.Size = MaxPageSize
+	}
+	return searchRequest
+}
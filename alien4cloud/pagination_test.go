@@ -0,0 +1,114 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"gotest.tools/v3/assert"
+)
+
+func Test_paginate(t *testing.T) {
+	assert.DeepEqual(t, paginate(SearchRequest{}), SearchRequest{Size: DefaultPageSize})
+	assert.DeepEqual(t, paginate(SearchRequest{From: -1, Size: 10}), SearchRequest{Size: 10})
+	assert.DeepEqual(t, paginate(SearchRequest{From: 20, Size: 100000}), SearchRequest{From: 20, Size: MaxPageSize})
+}
+
+func Test_applicationService_GetApplicationsIDPaging(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var searchReq SearchRequest
+		assert.NilError(t, json.NewDecoder(r.Body).Decode(&searchReq))
+		assert.Equal(t, searchReq.Size, MaxPageSize)
+		switch searchReq.From {
+		case 0:
+			_, _ = w.Write([]byte(`{"data":{"data":[{"id":"app1"},{"id":"app2"}],"totalResults":3}}`))
+		case 2:
+			_, _ = w.Write([]byte(`{"data":{"data":[{"id":"app3"}],"totalResults":3}}`))
+		default:
+			t.Errorf("unexpected search request %+v", searchReq)
+		}
+	}))
+	defer ts.Close()
+
+	a := &applicationService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	ids, err := a.GetApplicationsID(context.Background(), "")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, ids, []string{"app1", "app2", "app3"})
+}
+
+func Test_topologyService_GetTopologiesPaging(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var searchReq SearchRequest
+		assert.NilError(t, json.NewDecoder(r.Body).Decode(&searchReq))
+		assert.Equal(t, searchReq.Query, "web")
+		switch searchReq.From {
+		case 0:
+			_, _ = w.Write([]byte(`{"data":{"data":[{"id":"t1:1.0.0"}],"totalResults":2}}`))
+		case 1:
+			_, _ = w.Write([]byte(`{"data":{"data":[{"id":"t2:1.0.0"}],"totalResults":2}}`))
+		default:
+			t.Errorf("unexpected search request %+v", searchReq)
+		}
+	}))
+	defer ts.Close()
+
+	topologyService := &topologyService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	topologies, err := topologyService.GetTopologies(context.Background(), "web")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, topologies, []BasicTopologyInfo{{ID: "t1:1.0.0"}, {ID: "t2:1.0.0"}})
+}
+
+func Test_checkTruncated(t *testing.T) {
+	assert.NilError(t, checkTruncated(SearchRequest{Size: MaxPageSize}, MaxPageSize, 5000))
+	assert.NilError(t, checkTruncated(SearchRequest{Size: 5000}, 800, 800))
+	assert.NilError(t, checkTruncated(SearchRequest{From: 4500, Size: 5000}, 500, 5000))
+
+	err := checkTruncated(SearchRequest{From: 10, Size: 5000}, MaxPageSize, 3000)
+	assert.Assert(t, errors.Is(err, ErrTruncatedResults))
+	var truncatedErr *TruncatedResultsError
+	assert.Assert(t, errors.As(err, &truncatedErr))
+	assert.DeepEqual(t, *truncatedErr, TruncatedResultsError{Returned: MaxPageSize, Total: 3000})
+}
+
+func Test_catalogService_SearchCSARsTruncated(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var searchReq SearchRequest
+		assert.NilError(t, json.NewDecoder(r.Body).Decode(&searchReq))
+		assert.Equal(t, searchReq.Size, MaxPageSize)
+		switch searchReq.Query {
+		case "many":
+			_, _ = w.Write([]byte(`{"data":{"data":[{"id":"c1:1.0.0"},{"id":"c2:1.0.0"}],"totalResults":1500}}`))
+		default:
+			_, _ = w.Write([]byte(`{"data":{"data":[{"id":"c1:1.0.0"},{"id":"c2:1.0.0"}],"totalResults":2}}`))
+		}
+	}))
+	defer ts.Close()
+
+	cs := &catalogService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	csars, total, err := cs.SearchCSARs(context.Background(), SearchRequest{Query: "many", Size: 5000})
+	assert.Assert(t, errors.Is(err, ErrTruncatedResults), "unexpected error %v", err)
+	assert.Equal(t, len(csars), 2)
+	assert.Equal(t, total, 1500)
+
+	csars, total, err = cs.SearchCSARs(context.Background(), SearchRequest{Query: "few", Size: 5000})
+	assert.NilError(t, err)
+	assert.Equal(t, len(csars), 2)
+	assert.Equal(t, total, 2)
+}
@@ -0,0 +1,34 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import "github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/types"
+
+// DecodeConfiguration decodes a plugin configuration as returned by OrchestratorService.GetOrchestratorConfiguration()
+// into a typed configuration model like YorcOrchestratorConfiguration
+func DecodeConfiguration(configuration interface{}, v interface{}) error {
+	return types.DecodeConfiguration(configuration, v)
+}
+
+// EncodeConfiguration encodes a typed configuration model into a map as expected by OrchestratorService.SetOrchestratorConfiguration()
+func EncodeConfiguration(v interface{}) (map[string]interface{}, error) {
+	return types.EncodeConfiguration(v)
+}
+
+// EncodeMetaProperties encodes a typed model of meta-properties into meta-properties values indexed by
+// the ID of their definition, as expected by LocationService.UpdateLocationMetaProperties()
+func EncodeMetaProperties(definitions []MetaPropertyDefinition, v interface{}) (map[string]string, error) {
+	return types.EncodeMetaProperties(definitions, v)
+}
@@ -0,0 +1,216 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// PreflightConnectivity is the name of the check verifying that Alien4Cloud is reachable
+	PreflightConnectivity = "connectivity"
+	// PreflightLogin is the name of the check verifying that the client is able to log in
+	PreflightLogin = "login"
+	// PreflightRoles is the name of the check verifying that the user has the required roles
+	PreflightRoles = "roles"
+	// PreflightOrchestrator is the name of the check verifying that the orchestrator is enabled
+	PreflightOrchestrator = "orchestrator"
+	// PreflightLocation is the name of the check verifying that the location is available
+	PreflightLocation = "location"
+)
+
+// PreflightSpec describes requirements verified by Client.Preflight()
+type PreflightSpec struct {
+	// Roles the user should have. Users with the ADMIN role are considered as having all roles.
+	Roles []string
+	// OrchestratorName is the name of an orchestrator that should be enabled and connected
+	OrchestratorName string
+	// LocationName is the name of a location that should exist on the orchestrator named OrchestratorName
+	LocationName string
+}
+
+// PreflightCheck is the result of a single preflight check
+type PreflightCheck struct {
+	Name    string
+	Passed  bool
+	Message string
+}
+
+// PreflightReport holds the results of preflight checks
+type PreflightReport struct {
+	Checks []PreflightCheck
+}
+
+// Passed returns true if all checks passed
+func (r PreflightReport) Passed() bool {
+	for _, c := range r.Checks {
+		if !c.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns a human-readable report with a line per check
+func (r PreflightReport) String() string {
+	var b strings.Builder
+	for i, c := range r.Checks {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		status := "OK"
+		if !c.Passed {
+			status = "FAILED"
+		}
+		fmt.Fprintf(&b, "[%s] %s: %s", status, c.Name, c.Message)
+	}
+	return b.String()
+}
+
+func (r *PreflightReport) add(name string, passed bool, format string, args ...interface{}) bool {
+	r.Checks = append(r.Checks, PreflightCheck{Name: name, Passed: passed, Message: fmt.Sprintf(format, args...)})
+	return passed
+}
+
+// authStatus holds the response of the authentication status endpoint
+type authStatus struct {
+	IsLogged bool     `json:"isLogged"`
+	Username string   `json:"username"`
+	Roles    []string `json:"roles"`
+	Groups   []string `json:"groups"`
+}
+
+func (c *a4cClient) getAuthStatus(ctx context.Context) (authStatus, error) {
+	request, err := c.NewRequest(ctx, "GET", fmt.Sprintf("%s/auth/status", a4CRestAPIPrefix), nil)
+	if err != nil {
+		return authStatus{}, errors.Wrap(err, "Cannot create a request to get authentication status")
+	}
+	var res struct {
+		Data authStatus `json:"data"`
+	}
+	response, err := c.Do(request)
+	if err != nil {
+		return authStatus{}, errors.Wrap(err, "Cannot send a request to get authentication status")
+	}
+	err = ReadA4CResponse(response, &res)
+	return res.Data, errors.Wrap(err, "Cannot get authentication status")
+}
+
+// Preflight verifies connectivity and requirements described in the given PreflightSpec
+func (c *a4cClient) Preflight(ctx context.Context, spec PreflightSpec) (PreflightReport, error) {
+	var report PreflightReport
+
+	request, err := c.NewRequest(ctx, "GET", fmt.Sprintf("%s/auth/status", a4CRestAPIPrefix), nil)
+	if err != nil {
+		return report, errors.Wrap(err, "Cannot create a request to check connectivity")
+	}
+	// Do not use c.Do() here as we do not want to retry on forbidden errors
+	response, err := c.client.Do(request)
+	if !report.add(PreflightConnectivity, err == nil, "%s", connectivityMessage(c.baseURL, err)) {
+		return report, nil
+	}
+	discardHTTPResponseBody(response)
+
+	err = c.Login(ctx)
+	if !report.add(PreflightLogin, err == nil, "%s", loginMessage(c.username, err)) {
+		return report, nil
+	}
+
+	if len(spec.Roles) > 0 {
+		status, err := c.getAuthStatus(ctx)
+		if err != nil {
+			return report, err
+		}
+		missing := missingRoles(status.Roles, spec.Roles)
+		if len(missing) > 0 {
+			report.add(PreflightRoles, false, "user %q is missing roles %s", status.Username, strings.Join(missing, ", "))
+		} else {
+			report.add(PreflightRoles, true, "user %q has roles %s", status.Username, strings.Join(spec.Roles, ", "))
+		}
+	}
+
+	if spec.OrchestratorName == "" {
+		if spec.LocationName != "" {
+			report.add(PreflightLocation, false, "an orchestrator name is required to check location %q", spec.LocationName)
+		}
+		return report, nil
+	}
+
+	orchestratorID, err := c.orchestratorService.GetOrchestratorIDbyName(ctx, spec.OrchestratorName)
+	if err != nil {
+		report.add(PreflightOrchestrator, false, "orchestrator %q not found: %v", spec.OrchestratorName, err)
+		return report, nil
+	}
+	orchestrator, err := c.orchestratorService.getOrchestrator(ctx, orchestratorID)
+	if err != nil {
+		return report, err
+	}
+	report.add(PreflightOrchestrator, orchestrator.State == OrchestratorConnected,
+		"orchestrator %q is in state %s", spec.OrchestratorName, orchestrator.State)
+
+	if spec.LocationName == "" {
+		return report, nil
+	}
+	locations, err := c.orchestratorService.GetOrchestratorLocations(ctx, orchestratorID)
+	if err != nil {
+		return report, err
+	}
+	var names []string
+	for _, l := range locations {
+		if l.Name == spec.LocationName {
+			report.add(PreflightLocation, true, "location %q is available on orchestrator %q", spec.LocationName, spec.OrchestratorName)
+			return report, nil
+		}
+		names = append(names, l.Name)
+	}
+	report.add(PreflightLocation, false, "location %q not found on orchestrator %q, available locations: %s",
+		spec.LocationName, spec.OrchestratorName, strings.Join(names, ", "))
+	return report, nil
+}
+
+func connectivityMessage(baseURL string, err error) string {
+	if err != nil {
+		return fmt.Sprintf("unable to reach %s: %v", baseURL, err)
+	}
+	return fmt.Sprintf("%s is reachable", baseURL)
+}
+
+func loginMessage(username string, err error) string {
+	if err != nil {
+		return fmt.Sprintf("unable to log in as %q: %v", username, err)
+	}
+	return fmt.Sprintf("logged in as %q", username)
+}
+
+func missingRoles(userRoles, requiredRoles []string) []string {
+	has := make(map[string]bool, len(userRoles))
+	for _, r := range userRoles {
+		has[r] = true
+	}
+	if has[ROLE_ADMIN] {
+		return nil
+	}
+	var missing []string
+	for _, r := range requiredRoles {
+		if !has[r] {
+			missing = append(missing, r)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,89 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func newHTTPServerTestPreflight(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/login`).Match([]byte(r.URL.Path)):
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{}`))
+		case regexp.MustCompile(`.*/auth/status`).Match([]byte(r.URL.Path)):
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"data":{"isLogged":true,"username":"user","roles":["APPLICATIONS_MANAGER"]}}`))
+		case regexp.MustCompile(`.*/orchestrators/orchID/locations`).Match([]byte(r.URL.Path)):
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"data":[{"location":{"id":"locID","name":"myLocation"}}]}`))
+		case regexp.MustCompile(`.*/orchestrators/orchID`).Match([]byte(r.URL.Path)):
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"data":{"id":"orchID","name":"myOrch","state":"CONNECTED"}}`))
+		case regexp.MustCompile(`.*/orchestrators`).Match([]byte(r.URL.Path)):
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"data":{"data":[{"id":"orchID","name":"myOrch"}],"totalResults":1}}`))
+		default:
+			t.Errorf("Unexpected call for request %+v", r)
+		}
+	}))
+}
+
+func Test_a4cClient_Preflight(t *testing.T) {
+	ts := newHTTPServerTestPreflight(t)
+	defer ts.Close()
+
+	tests := []struct {
+		name       string
+		spec       PreflightSpec
+		wantPassed bool
+		wantChecks int
+	}{
+		{"ConnectivityOnly", PreflightSpec{}, true, 2},
+		{"AllOK", PreflightSpec{Roles: []string{ROLE_APPLICATIONS_MANAGER}, OrchestratorName: "myOrch", LocationName: "myLocation"}, true, 5},
+		{"MissingRole", PreflightSpec{Roles: []string{ROLE_ARCHITECT}}, false, 3},
+		{"UnknownLocation", PreflightSpec{OrchestratorName: "myOrch", LocationName: "unknown"}, false, 4},
+		{"LocationWithoutOrchestrator", PreflightSpec{LocationName: "myLocation"}, false, 3},
+	}
+	client, err := NewClient(ts.URL, "user", "password", "", true)
+	assert.NilError(t, err)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := client.Preflight(context.Background(), tt.spec)
+			assert.NilError(t, err)
+			assert.Equal(t, report.Passed(), tt.wantPassed, report.String())
+			assert.Equal(t, len(report.Checks), tt.wantChecks, report.String())
+		})
+	}
+}
+
+func Test_a4cClient_PreflightUnreachable(t *testing.T) {
+	ts := newHTTPServerTestPreflight(t)
+	ts.Close()
+
+	client, err := NewClient(ts.URL, "user", "password", "", true)
+	assert.NilError(t, err)
+	report, err := client.Preflight(context.Background(), PreflightSpec{})
+	assert.NilError(t, err)
+	assert.Equal(t, report.Passed(), false)
+	assert.Equal(t, report.Checks[0].Name, PreflightConnectivity)
+}
@@ -0,0 +1,62 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestWithRateLimit(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL, "", "", "", false, WithRateLimit(20, 2))
+	assert.NilError(t, err)
+
+	send := func(ctx context.Context) error {
+		request, err := client.NewRequest(ctx, "GET", "/", nil)
+		assert.NilError(t, err)
+		response, err := client.Do(request)
+		if err != nil {
+			return err
+		}
+		return ReadA4CResponse(response, nil)
+	}
+
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		assert.NilError(t, send(context.Background()))
+	}
+	// The 2 first requests are sent immediately then one request every 50ms
+	elapsed := time.Since(start)
+	assert.Assert(t, elapsed >= 190*time.Millisecond, "requests sent too fast: %v", elapsed)
+
+	// Waiting is interrupted by context cancellation
+	client, err = NewClient(ts.URL, "", "", "", false, WithRateLimit(0.1, 1))
+	assert.NilError(t, err)
+	assert.NilError(t, send(context.Background()))
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.Assert(t, errors.Is(send(ctx), ErrTimeout))
+}
@@ -0,0 +1,236 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// RecorderMode defines whether a Recorder records or replays interactions
+type RecorderMode int
+
+const (
+	// RecorderModeRecord sends requests to Alien4Cloud and records interactions
+	RecorderModeRecord RecorderMode = iota
+	// RecorderModeReplay replays recorded interactions without contacting Alien4Cloud
+	RecorderModeReplay
+)
+
+// RedactedValue replaces sensitive values of recorded interactions
+const RedactedValue = "REDACTED"
+
+// RecordedRequest is a request of a recorded interaction
+type RecordedRequest struct {
+	Method string `json:"method"`
+	// URL is the request URI, without the Alien4Cloud address, so that interactions
+	// could be replayed against any address
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response of a recorded interaction
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Interaction is a request and its response recorded by a Recorder
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// Recorder is an HTTP transport recording interactions with Alien4Cloud into a cassette file,
+// or replaying them from this file.
+//
+// It allows to record integration tests against a real Alien4Cloud once and to replay them
+// deterministically without a live server. A Recorder is set on a client using WithRecorder.
+//
+// Interactions are sanitized before being recorded: the Authorization and Cookie headers,
+// the Set-Cookie header and the password of login requests are redacted. Additional
+// sanitization may be done using the Sanitize function.
+// Requests and responses bodies are buffered in memory, so a Recorder is not suited for large uploads.
+type Recorder struct {
+	// Sanitize is an optional function called on each interaction, before it is recorded and before
+	// a request is matched against recorded interactions when replaying.
+	// It could be used to redact sensitive values from bodies.
+	Sanitize func(*Interaction)
+
+	mode      RecorderMode
+	path      string
+	transport http.RoundTripper
+
+	lock         sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewRecorder returns a Recorder using the given cassette file.
+//
+// In replay mode, interactions are loaded from the file which should exist.
+// In record mode, interactions are written to the file by Save.
+func NewRecorder(path string, mode RecorderMode) (*Recorder, error) {
+	r := &Recorder{mode: mode, path: path}
+	if mode == RecorderModeReplay {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to read cassette %q", path)
+		}
+		err = json.Unmarshal(b, &r.interactions)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to parse cassette %q", path)
+		}
+		r.used = make([]bool, len(r.interactions))
+	}
+	return r, nil
+}
+
+// WithRecorder configures the client to record or replay its interactions with Alien4Cloud using the given Recorder
+func WithRecorder(r *Recorder) ClientOption {
+	return func(c *a4cClient) {
+		r.transport = c.client.Transport
+		c.client.Transport = r
+	}
+}
+
+// Save writes recorded interactions to the cassette file, it does nothing in replay mode
+func (r *Recorder) Save() error {
+	if r.mode != RecorderModeRecord {
+		return nil
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	b, err := json.MarshalIndent(r.interactions, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "Failed to marshal recorded interactions")
+	}
+	err = ioutil.WriteFile(r.path, b, 0644)
+	return errors.Wrapf(err, "Failed to write cassette %q", r.path)
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(request *http.Request) (*http.Response, error) {
+	var body []byte
+	if request.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to read request body")
+		}
+	}
+	interaction := Interaction{
+		Request: RecordedRequest{
+			Method:  request.Method,
+			URL:     request.URL.RequestURI(),
+			Headers: request.Header.Clone(),
+			Body:    string(body),
+		},
+	}
+
+	if r.mode == RecorderModeReplay {
+		r.sanitize(&interaction)
+		recorded, err := r.match(interaction.Request)
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			Status:        http.StatusText(recorded.Response.StatusCode),
+			StatusCode:    recorded.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        recorded.Response.Headers.Clone(),
+			Body:          ioutil.NopCloser(strings.NewReader(recorded.Response.Body)),
+			ContentLength: int64(len(recorded.Response.Body)),
+			Request:       request,
+		}, nil
+	}
+
+	outRequest := request.Clone(request.Context())
+	if body != nil {
+		outRequest.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	transport := r.transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	response, err := transport.RoundTrip(outRequest)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to read response body")
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	interaction.Response = RecordedResponse{
+		StatusCode: response.StatusCode,
+		Headers:    response.Header.Clone(),
+		Body:       string(respBody),
+	}
+	r.sanitize(&interaction)
+	r.lock.Lock()
+	r.interactions = append(r.interactions, interaction)
+	r.lock.Unlock()
+	return response, nil
+}
+
+// match returns the first unused recorded interaction matching the given request
+func (r *Recorder) match(request RecordedRequest) (Interaction, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for i, interaction := range r.interactions {
+		if r.used[i] || interaction.Request.Method != request.Method ||
+			interaction.Request.URL != request.URL || interaction.Request.Body != request.Body {
+			continue
+		}
+		r.used[i] = true
+		return interaction, nil
+	}
+	return Interaction{}, errors.Errorf("No recorded interaction in cassette %q matches request %s %s", r.path, request.Method, request.URL)
+}
+
+func (r *Recorder) sanitize(interaction *Interaction) {
+	for _, h := range []string{authorizationHeaderName, "Cookie"} {
+		if interaction.Request.Headers.Get(h) != "" {
+			interaction.Request.Headers.Set(h, RedactedValue)
+		}
+	}
+	if interaction.Response.Headers.Get("Set-Cookie") != "" {
+		interaction.Response.Headers.Set("Set-Cookie", RedactedValue)
+	}
+	if strings.HasSuffix(strings.SplitN(interaction.Request.URL, "?", 2)[0], "/login") {
+		if values, err := url.ParseQuery(interaction.Request.Body); err == nil && values.Get("password") != "" {
+			values.Set("password", RedactedValue)
+			interaction.Request.Body = values.Encode()
+		}
+	}
+	if r.Sanitize != nil {
+		r.Sanitize(interaction)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestRecorder(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/login":
+			http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: "secret-session"})
+			w.WriteHeader(http.StatusOK)
+		case "/rest/latest/applications/app":
+			_, _ = w.Write([]byte(`{"data":{"id":"app","name":"My App"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":504,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "a4c-cassette")
+	assert.NilError(t, err)
+	defer os.RemoveAll(dir)
+	cassette := filepath.Join(dir, "cassette.json")
+	ctx := context.Background()
+
+	recorder, err := NewRecorder(cassette, RecorderModeRecord)
+	assert.NilError(t, err)
+	recorder.Sanitize = func(i *Interaction) {
+		i.Response.Body = strings.Replace(i.Response.Body, "My App", "Sanitized App", -1)
+	}
+	client, err := NewClient(ts.URL, "user", "s3cr3t", "", false, WithRecorder(recorder))
+	assert.NilError(t, err)
+	assert.NilError(t, client.Login(ctx))
+	app, err := client.ApplicationService().GetApplicationByID(ctx, "app")
+	assert.NilError(t, err)
+	assert.Equal(t, app.Name, "My App", "the live response should not be sanitized")
+	_, err = client.ApplicationService().GetApplicationByID(ctx, "unknown")
+	assert.ErrorContains(t, err, "not found")
+	assert.NilError(t, recorder.Save())
+
+	content, err := ioutil.ReadFile(cassette)
+	assert.NilError(t, err)
+	assert.Assert(t, !strings.Contains(string(content), "s3cr3t"))
+	assert.Assert(t, !strings.Contains(string(content), "secret-session"))
+
+	// Replay against an address where no server is listening
+	replayer, err := NewRecorder(cassette, RecorderModeReplay)
+	assert.NilError(t, err)
+	client, err = NewClient("http://127.0.0.1:1", "user", "other-password", "", false, WithRecorder(replayer))
+	assert.NilError(t, err)
+	assert.NilError(t, client.Login(ctx))
+	app, err = client.ApplicationService().GetApplicationByID(ctx, "app")
+	assert.NilError(t, err)
+	assert.Equal(t, app.Name, "Sanitized App")
+	_, err = client.ApplicationService().GetApplicationByID(ctx, "unknown")
+	assert.ErrorContains(t, err, "not found")
+
+	// Interactions are consumed once replayed
+	_, err = client.ApplicationService().GetApplicationByID(ctx, "app")
+	assert.ErrorContains(t, err, "No recorded interaction")
+
+	_, err = NewRecorder(filepath.Join(dir, "missing.json"), RecorderModeReplay)
+	assert.ErrorContains(t, err, "Failed to read cassette")
+}
@@ -0,0 +1,141 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy defines how requests sent by Client Do are automatically retried on transient errors,
+// for instance while Alien4Cloud is restarting.
+//
+// This is applied before Retry functions given to Do: those functions are called with the response of the
+// last attempt.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is sent, values lower than 2 disable retries
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum delay between two attempts
+	MaxBackoff time.Duration
+	// Multiplier is the factor applied to the delay after each retry, defaults to 2
+	Multiplier float64
+	// RetryableStatusCodes are HTTP status codes of responses to retry
+	RetryableStatusCodes []int
+	// RetryNetworkErrors allows to retry requests which failed without response, like on connection refused errors.
+	// Note that the request may have been processed by Alien4Cloud in this case, so this should only be enabled
+	// when all requests sent by the client are safe to replay, see WithIdempotencyKey.
+	RetryNetworkErrors bool
+}
+
+// DefaultRetryPolicy returns a retry policy sending requests up to 5 times on 429, 502, 503 and 504 status codes,
+// with a delay starting at 500ms and doubling up to 10s.
+// Network errors are not retried as non-idempotent requests may have been processed before the failure.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+		Multiplier:     2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// WithRetryPolicy configures the client to automatically retry requests according to the given policy.
+// By default requests are not retried, except for the 403 Forbidden re-login described in Client Do.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *a4cClient) {
+		c.retryPolicy = policy
+	}
+}
+
+// shouldRetry returns true if a request which got the given response or error should be retried
+func (p RetryPolicy) shouldRetry(response *http.Response, err error) bool {
+	if err != nil {
+		return p.RetryNetworkErrors
+	}
+	for _, code := range p.RetryableStatusCodes {
+		if response.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay to wait before the given retry (starting at 1)
+func (p RetryPolicy) backoff(retry int, response *http.Response) time.Duration {
+	if response != nil {
+		// Honor the delay requested by the server if any
+		if seconds, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			delay := time.Duration(seconds) * time.Second
+			if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+				return p.MaxBackoff
+			}
+			return delay
+		}
+	}
+	multiplier := p.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+	delay := float64(p.InitialBackoff)
+	for i := 1; i < retry; i++ {
+		delay *= multiplier
+		if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+			return p.MaxBackoff
+		}
+	}
+	return time.Duration(delay)
+}
+
+// doWithRetryPolicy sends a request retrying it according to the client retry policy.
+// body is the request body if any, it is rewound before each retry.
+func (c *a4cClient) doWithRetryPolicy(request *http.Request, body io.Seeker) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		if c.rateLimiter != nil {
+			if err := c.rateLimiter.wait(request.Context()); err != nil {
+				return nil, err
+			}
+		}
+		response, err := c.client.Do(request)
+		if attempt >= c.retryPolicy.MaxAttempts || !c.retryPolicy.shouldRetry(response, err) {
+			return response, err
+		}
+		delay := c.retryPolicy.backoff(attempt, response)
+		if response != nil {
+			discardHTTPResponseBody(response)
+		}
+		select {
+		case <-request.Context().Done():
+			return nil, request.Context().Err()
+		case <-time.After(delay):
+		}
+		if body != nil {
+			// Restart reading request body from the beginning
+			_, err = body.Seek(0, io.SeekStart)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+}
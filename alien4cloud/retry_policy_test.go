@@ -0,0 +1,141 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	assert.Equal(t, p.backoff(1, nil), 100*time.Millisecond)
+	assert.Equal(t, p.backoff(2, nil), 200*time.Millisecond)
+	assert.Equal(t, p.backoff(4, nil), 800*time.Millisecond)
+	assert.Equal(t, p.backoff(5, nil), time.Second)
+
+	response := &http.Response{Header: http.Header{}}
+	response.Header.Set("Retry-After", "0")
+	assert.Equal(t, p.backoff(3, response), time.Duration(0))
+	response.Header.Set("Retry-After", "120")
+	assert.Equal(t, p.backoff(3, response), time.Second)
+}
+
+func TestWithRetryPolicy(t *testing.T) {
+	var attempts int32
+	var bodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		switch r.URL.Path {
+		case "/flaky":
+			if atomic.AddInt32(&attempts, 1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":"ok"}`))
+		case "/down":
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer ts.Close()
+
+	policy := DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	client, err := NewClient(ts.URL, "", "", "", false, WithRetryPolicy(policy))
+	assert.NilError(t, err)
+
+	send := func(ctx context.Context, path string) (*http.Response, error) {
+		request, err := client.NewRequest(ctx, "POST", path, strings.NewReader("body"))
+		assert.NilError(t, err)
+		return client.Do(request)
+	}
+
+	response, err := send(context.Background(), "/flaky")
+	assert.NilError(t, err)
+	var res struct {
+		Data string `json:"data"`
+	}
+	assert.NilError(t, ReadA4CResponse(response, &res))
+	assert.Equal(t, res.Data, "ok")
+	// Body is sent again on each attempt
+	assert.DeepEqual(t, bodies, []string{"body", "body", "body"})
+
+	bodies = nil
+	response, err = send(context.Background(), "/down")
+	assert.NilError(t, err)
+	assert.Equal(t, response.StatusCode, http.StatusBadGateway)
+	discardHTTPResponseBody(response)
+	assert.Equal(t, len(bodies), policy.MaxAttempts)
+
+	// Non retryable status codes are not retried
+	bodies = nil
+	response, err = send(context.Background(), "/error")
+	assert.NilError(t, err)
+	assert.Equal(t, response.StatusCode, http.StatusInternalServerError)
+	discardHTTPResponseBody(response)
+	assert.Equal(t, len(bodies), 1)
+
+	// Waiting for a retry is interrupted by context cancellation
+	policy.InitialBackoff = time.Hour
+	client, err = NewClient(ts.URL, "", "", "", false, WithRetryPolicy(policy))
+	assert.NilError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = send(ctx, "/down")
+	assert.Assert(t, errors.Is(err, ErrTimeout))
+}
+
+func TestWithRetryPolicy_NetworkErrors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := ts.URL
+	// Nothing listens on this address anymore
+	ts.Close()
+
+	policy := DefaultRetryPolicy()
+	policy.InitialBackoff = 10 * time.Millisecond
+	policy.MaxAttempts = 3
+	policy.RetryNetworkErrors = true
+	client, err := NewClient(url, "", "", "", false, WithRetryPolicy(policy))
+	assert.NilError(t, err)
+	request, err := client.NewRequest(context.Background(), "GET", "/", nil)
+	assert.NilError(t, err)
+	start := time.Now()
+	_, err = client.Do(request)
+	assert.Assert(t, err != nil)
+	// Two retries waiting respectively 10ms and 20ms
+	assert.Assert(t, time.Since(start) >= 30*time.Millisecond)
+
+	// Network errors are not retried by default
+	client, err = NewClient(url, "", "", "", false, WithRetryPolicy(DefaultRetryPolicy()))
+	assert.NilError(t, err)
+	request, err = client.NewRequest(context.Background(), "POST", "/", nil)
+	assert.NilError(t, err)
+	start = time.Now()
+	_, err = client.Do(request)
+	assert.Assert(t, err != nil)
+	assert.Assert(t, time.Since(start) < 500*time.Millisecond)
+}
@@ -0,0 +1,57 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// MissingRolesError is returned by Client.RequireRoles when the logged in user lacks some roles.
+//
+// It may be retrieved from returned errors using errors.As.
+type MissingRolesError struct {
+	// Username is the name of the logged in user
+	Username string
+	// Roles are the required roles the user does not have
+	Roles []string
+}
+
+func (e *MissingRolesError) Error() string {
+	return fmt.Sprintf("user %q is missing roles %s", e.Username, strings.Join(e.Roles, ", "))
+}
+
+// HasRole returns true if the logged in user has the given role
+func (c *a4cClient) HasRole(ctx context.Context, role string) (bool, error) {
+	status, err := c.getAuthStatus(ctx)
+	if err != nil {
+		return false, err
+	}
+	return len(missingRoles(status.Roles, []string{role})) == 0, nil
+}
+
+// RequireRoles returns a *MissingRolesError if the logged in user does not have all the given roles
+func (c *a4cClient) RequireRoles(ctx context.Context, roles ...string) error {
+	status, err := c.getAuthStatus(ctx)
+	if err != nil {
+		return err
+	}
+	missing := missingRoles(status.Roles, roles)
+	if len(missing) > 0 {
+		return &MissingRolesError{Username: status.Username, Roles: missing}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_a4cClient_Roles(t *testing.T) {
+	ts := newHTTPServerTestPreflight(t)
+	defer ts.Close()
+
+	c := &a4cClient{client: http.DefaultClient, baseURL: ts.URL}
+
+	has, err := c.HasRole(context.Background(), ROLE_APPLICATIONS_MANAGER)
+	assert.NilError(t, err)
+	assert.Assert(t, has)
+	has, err = c.HasRole(context.Background(), ROLE_ARCHITECT)
+	assert.NilError(t, err)
+	assert.Assert(t, !has)
+
+	assert.NilError(t, c.RequireRoles(context.Background(), ROLE_APPLICATIONS_MANAGER))
+	err = c.RequireRoles(context.Background(), ROLE_APPLICATIONS_MANAGER, ROLE_ARCHITECT, ROLE_COMPONENTS_MANAGER)
+	var missingErr *MissingRolesError
+	assert.Assert(t, errors.As(err, &missingErr))
+	assert.Equal(t, missingErr.Username, "user")
+	assert.DeepEqual(t, missingErr.Roles, []string{ROLE_ARCHITECT, ROLE_COMPONENTS_MANAGER})
+}
@@ -0,0 +1,62 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// defaultSessionKeeperInterval is the period at which sessions are renewed when no valid interval is given,
+// a third of the default Alien4Cloud session timeout
+var defaultSessionKeeperInterval = 10 * time.Minute
+
+// StartSessionKeeper starts a goroutine logging in again every interval until the given context is cancelled.
+//
+// This allows long-running programs to renew their session before it expires, instead of relying on the
+// automatic login done by Do on 403 Forbidden responses. The interval should be lower than the session
+// timeout configured on Alien4Cloud (30 minutes by default), a zero or negative interval renews the session
+// every 10 minutes.
+//
+// Login errors are sent on the returned channel without blocking: an error is dropped if the previous one
+// was not received yet. The channel is closed once the context is cancelled.
+func (c *a4cClient) StartSessionKeeper(ctx context.Context, interval time.Duration) <-chan error {
+	if interval <= 0 {
+		interval = defaultSessionKeeperInterval
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(errCh)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			err := c.Login(ctx)
+			if err != nil && ctx.Err() == nil {
+				select {
+				case errCh <- errors.Wrap(err, "Failed to renew Alien4Cloud session"):
+				default:
+				}
+			}
+		}
+	}()
+	return errCh
+}
@@ -0,0 +1,90 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestStartSessionKeeper(t *testing.T) {
+	var logins int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/login" {
+			t.Errorf("Unexpected call for request %+v", r)
+		}
+		if atomic.AddInt32(&logins, 1) == 2 {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"code": 401,"message":"bad credentials"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL, "user", "password", "", false)
+	assert.NilError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := client.StartSessionKeeper(ctx, 10*time.Millisecond)
+
+	select {
+	case err := <-errCh:
+		assert.ErrorContains(t, err, "bad credentials")
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for a login error")
+	}
+	// Logins continue after an error
+	for atomic.LoadInt32(&logins) < 3 {
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	cancel()
+	for range errCh {
+	}
+	nbLogins := atomic.LoadInt32(&logins)
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(t, atomic.LoadInt32(&logins), nbLogins)
+}
+
+func TestStartSessionKeeperDefaultInterval(t *testing.T) {
+	var logins int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&logins, 1)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	defer func(interval time.Duration) { defaultSessionKeeperInterval = interval }(defaultSessionKeeperInterval)
+	defaultSessionKeeperInterval = 10 * time.Millisecond
+
+	client, err := NewClient(ts.URL, "user", "password", "", false)
+	assert.NilError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := client.StartSessionKeeper(ctx, 0)
+	for atomic.LoadInt32(&logins) < 2 {
+		time.Sleep(5 * time.Millisecond)
+	}
+	cancel()
+	for range errCh {
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/pkg/errors"
+)
+
+// ErrTimeout matches errors returned when an operation could not complete before the deadline of its context
+// or before a timeout given to the client (for instance to RunWorkflow or to WaitUntilStateIsWithOptions).
+//
+// It allows to distinguish a slow Alien4Cloud from an Alien4Cloud rejecting a request, it may be checked using errors.Is.
+var ErrTimeout = errors.New("timeout")
+
+// TimeoutError is returned when an operation could not complete in time.
+//
+// It matches ErrTimeout using errors.Is and may be retrieved from returned errors using errors.As.
+// The underlying error, typically context.DeadlineExceeded or a network timeout, is available using errors.Unwrap.
+type TimeoutError struct {
+	// Operation is the attempted operation, for instance "GET /rest/latest/applications/myapp"
+	Operation string
+	Err       error
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("%s: timeout: %v", e.Operation, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *TimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// Is returns true if target is ErrTimeout
+func (e *TimeoutError) Is(target error) bool {
+	return target == ErrTimeout
+}
+
+// classifyTimeout returns a *TimeoutError if err is caused by a context deadline or a network timeout, err otherwise
+func classifyTimeout(operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+	var timeoutErr *TimeoutError
+	if errors.As(err, &timeoutErr) {
+		return err
+	}
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return errors.WithStack(&TimeoutError{Operation: operation, Err: err})
+	}
+	return err
+}
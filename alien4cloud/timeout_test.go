@@ -0,0 +1,107 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+type testNetError struct {
+	timeout bool
+}
+
+func (e testNetError) Error() string   { return "network error" }
+func (e testNetError) Timeout() bool   { return e.timeout }
+func (e testNetError) Temporary() bool { return false }
+
+func Test_classifyTimeout(t *testing.T) {
+	otherErr := errors.New("other")
+	tests := []struct {
+		name          string
+		err           error
+		wantTimeout   bool
+		wantUnchanged bool
+	}{
+		{"NilError", nil, false, true},
+		{"DeadlineExceeded", context.DeadlineExceeded, true, false},
+		{"WrappedDeadlineExceeded", fmt.Errorf("request failed: %w", context.DeadlineExceeded), true, false},
+		{"NetTimeout", testNetError{timeout: true}, true, false},
+		{"NetNonTimeout", testNetError{timeout: false}, false, true},
+		{"Canceled", context.Canceled, false, true},
+		{"OtherError", otherErr, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := classifyTimeout("GET /rest/latest/applications/app", tt.err)
+			assert.Equal(t, errors.Is(got, ErrTimeout), tt.wantTimeout)
+			if tt.wantUnchanged {
+				assert.Equal(t, got, tt.err)
+				return
+			}
+			var timeoutErr *TimeoutError
+			assert.Assert(t, errors.As(got, &timeoutErr))
+			assert.Equal(t, timeoutErr.Operation, "GET /rest/latest/applications/app")
+			assert.Equal(t, errors.Unwrap(timeoutErr), tt.err)
+			assert.Assert(t, errors.Is(got, tt.err))
+		})
+	}
+}
+
+func Test_classifyTimeout_NotDoubleWrapped(t *testing.T) {
+	first := classifyTimeout("first operation", context.DeadlineExceeded)
+	second := classifyTimeout("second operation", first)
+	assert.Equal(t, second, first)
+
+	var timeoutErr *TimeoutError
+	assert.Assert(t, errors.As(second, &timeoutErr))
+	assert.Equal(t, timeoutErr.Operation, "first operation")
+	assert.Equal(t, timeoutErr.Err, context.DeadlineExceeded)
+}
+
+func TestReadA4CResponse_BodyReadTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "1024")
+		_, _ = w.Write([]byte(`{"data":`))
+		w.(http.Flusher).Flush()
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL, "", "", "", false)
+	assert.NilError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	request, err := client.NewRequest(ctx, "GET", "/rest/latest/applications/app", nil)
+	assert.NilError(t, err)
+	response, err := client.Do(request)
+	assert.NilError(t, err)
+
+	err = ReadA4CResponse(response, nil)
+	assert.Assert(t, errors.Is(err, ErrTimeout), "unexpected error %v", err)
+	var timeoutErr *TimeoutError
+	assert.Assert(t, errors.As(err, &timeoutErr))
+	assert.Equal(t, timeoutErr.Operation, "GET /rest/latest/applications/app")
+}
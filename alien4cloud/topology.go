@@ -19,6 +19,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"reflect"
 	"strings"
 
@@ -31,10 +33,16 @@ import (
 type TopologyService interface {
 	// Returns the topology ID on a given application and environment
 	GetTopologyID(ctx context.Context, appID string, envID string) (string, error)
+	// Returns an editor context synchronized on the last operation applied to the topology of the given application environment
+	//
+	// Editor operations using this context fail with an error matching ErrConcurrentEdit if the topology is modified by someone else in the meantime.
+	NewTopologyEditorContext(ctx context.Context, appID, envID string) (*TopologyEditorContext, error)
 	// Returns the topology template ID for the given topologyName
 	GetTopologyTemplateIDByName(ctx context.Context, topologyName string) (string, error)
 	// Returns Topology details for a given application and environment
-	GetTopology(ctx context.Context, appID string, envID string) (*Topology, error)
+	//
+	// Secret properties are returned in clear unless the WithSecretsMasked or WithSecretsExcluded option is given.
+	GetTopology(ctx context.Context, appID string, envID string, opts ...TopologyOption) (*Topology, error)
 	// Updates the property value (type string) of a component of an application
 	UpdateComponentProperty(ctx context.Context, a4cCtx *TopologyEditorContext, componentName string, propertyName string, propertyValue string) error
 	// Updates the property value (type tosca complex) of a component of an application
@@ -43,26 +51,114 @@ type TopologyService interface {
 	UpdateCapabilityProperty(ctx context.Context, a4cCtx *TopologyEditorContext, componentName string, propertyName string, propertyValue string, capabilityName string) error
 	// Adds a new node in the A4C topology
 	AddNodeInA4CTopology(ctx context.Context, a4cCtx *TopologyEditorContext, nodeTypeID string, nodeName string) error
+	// Adds a node of the given type version, typically an abstract type to be matched against location resources
+	AddAbstractNode(ctx context.Context, a4cCtx *TopologyEditorContext, nodeTypeID, nodeTypeVersion, nodeName string) error
+	// Deletes a node from the A4C topology, relationships targeting this node are deleted too
+	DeleteNode(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName string) error
+	// Renames a node of the A4C topology
+	RenameNode(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, newName string) error
+	// Sets the coordinates of a node in the Alien4Cloud topology editor
+	UpdateNodePosition(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName string, x, y int) error
+	// Uploads content as a deployment artifact of a node of the A4C topology
+	UpdateNodeDeploymentArtifact(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, artifactName, fileName string, content io.Reader) error
+	// Resets a deployment artifact of a node of the A4C topology to the one defined by its node type
+	ResetNodeDeploymentArtifact(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, artifactName string) error
+	// Adds a node to a group of the topology, the group is created if it does not exist
+	AddNodeToGroup(ctx context.Context, a4cCtx *TopologyEditorContext, groupName, nodeName string) error
+	// Removes a node from a group of the topology
+	RemoveNodeFromGroup(ctx context.Context, a4cCtx *TopologyEditorContext, groupName, nodeName string) error
+	// Deletes a group from the topology, nodes of the group are kept
+	DeleteGroup(ctx context.Context, a4cCtx *TopologyEditorContext, groupName string) error
+	// Creates a group of the topology made of the given nodes
+	CreateGroup(ctx context.Context, a4cCtx *TopologyEditorContext, groupName string, nodeNames ...string) error
+	// Renames a group of the topology
+	RenameGroup(ctx context.Context, a4cCtx *TopologyEditorContext, groupName, newName string) error
+	// Returns the sorted names of the nodes members of the given groups of the topology
+	GetGroupMembers(ctx context.Context, a4cCtx *TopologyEditorContext, groupNames ...string) ([]string, error)
 	// Adds a new relationship in the A4C topology
 	AddRelationship(ctx context.Context, a4cCtx *TopologyEditorContext, sourceNodeName string, targetNodeName string, relType string) error
+	// Deletes a relationship of a node of the A4C topology
+	DeleteRelationship(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, relationshipName string) error
+	// Updates the property value (type string) of a relationship of a node of the A4C topology
+	UpdateRelationshipProperty(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, relationshipName, propertyName, propertyValue string) error
+	// Undoes or redoes pending operations of the edit session so that the operation at the given index is the last one applied
+	UndoRedo(ctx context.Context, a4cCtx *TopologyEditorContext, at int) error
+	// Recovers an edit session whose pending operations can't be applied anymore
+	Recover(ctx context.Context, a4cCtx *TopologyEditorContext) error
 	// Saves the topology context
 	SaveA4CTopology(ctx context.Context, a4cCtx *TopologyEditorContext) error
 	// Creates an empty workflow in the given topology
 	CreateWorkflow(ctx context.Context, a4cCtx *TopologyEditorContext, workflowName string) error
 	// Deletes a workflow in the given topology
 	DeleteWorkflow(ctx context.Context, a4cCtx *TopologyEditorContext, workflowName string) error
+	// Renames a workflow in the given topology
+	RenameWorkflow(ctx context.Context, a4cCtx *TopologyEditorContext, workflowName, newName string) error
+	// Duplicates a workflow in the given topology under a new name
+	DuplicateWorkflow(ctx context.Context, a4cCtx *TopologyEditorContext, workflowName, newName string) error
 	// Adds an activity to a workflow
 	AddWorkflowActivity(ctx context.Context, a4cCtx *TopologyEditorContext, workflowName string, activity *WorkflowActivity) error
+	// Connects two steps of a workflow, toStepID being executed after fromStepID
+	AddWorkflowEdge(ctx context.Context, a4cCtx *TopologyEditorContext, workflowName, fromStepID, toStepID string) error
+	// Removes the connection between two steps of a workflow
+	RemoveWorkflowEdge(ctx context.Context, a4cCtx *TopologyEditorContext, workflowName, fromStepID, toStepID string) error
+	// Removes a step of a workflow
+	RemoveWorkflowStep(ctx context.Context, a4cCtx *TopologyEditorContext, workflowName, stepID string) error
+	// Renames a step of a workflow
+	RenameWorkflowStep(ctx context.Context, a4cCtx *TopologyEditorContext, workflowName, stepID, newName string) error
+	// Swaps the positions of two steps of a workflow
+	SwapWorkflowSteps(ctx context.Context, a4cCtx *TopologyEditorContext, workflowName, stepID, targetStepID string) error
 	// Adds a policy to the topology
 	AddPolicy(ctx context.Context, a4cCtx *TopologyEditorContext, policyName, policyTypeID string) error
 	// Adds targets to a previously created policy
 	AddTargetsToPolicy(ctx context.Context, a4cCtx *TopologyEditorContext, policyName string, targets []string) error
 	// Deletes a policy from the topology
 	DeletePolicy(ctx context.Context, a4cCtx *TopologyEditorContext, policyName string) error
+	// Sets the targets of a policy to the nodes members of the given groups
+	SetPolicyTargetsFromGroups(ctx context.Context, a4cCtx *TopologyEditorContext, policyName string, groupNames ...string) error
+	// Adds a policy targeting the nodes members of the given groups, typically a placement policy
+	AddGroupPolicy(ctx context.Context, a4cCtx *TopologyEditorContext, policyName, policyTypeID string, groupNames ...string) error
+	// Defines a new input of the topology
+	AddInput(ctx context.Context, a4cCtx *TopologyEditorContext, inputName string, definition PropertyDefinition) error
+	// Deletes an input of the topology
+	DeleteInput(ctx context.Context, a4cCtx *TopologyEditorContext, inputName string) error
+	// Renames an input of the topology
+	RenameInput(ctx context.Context, a4cCtx *TopologyEditorContext, inputName, newInputName string) error
+	// Sets a property of a node to a get_input function on the given input
+	SetNodePropertyAsInput(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, propertyName, inputName string) error
+	// Resets a property of a node previously set as an input
+	UnsetNodePropertyAsInput(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, propertyName string) error
+	// Sets a property of a node capability to a get_input function on the given input
+	SetNodeCapabilityPropertyAsInput(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, capabilityName, propertyName, inputName string) error
+	// Resets a property of a node capability previously set as an input
+	UnsetNodeCapabilityPropertyAsInput(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, capabilityName, propertyName string) error
+	// Declares an attribute of a node as an output of the topology
+	SetOutputAttribute(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, attributeName string) error
+	// Removes an attribute of a node from the outputs of the topology
+	RemoveOutputAttribute(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, attributeName string) error
+	// Declares a property of a node as an output of the topology
+	SetOutputProperty(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, propertyName string) error
+	// Removes a property of a node from the outputs of the topology
+	RemoveOutputProperty(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, propertyName string) error
+	// Declares a property of a node capability as an output of the topology
+	SetOutputCapabilityProperty(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, capabilityName, propertyName string) error
+	// Removes a property of a node capability from the outputs of the topology
+	RemoveOutputCapabilityProperty(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, capabilityName, propertyName string) error
 	// Returns a list of topologyIDs available topologies
+	//
+	// All matching topologies are returned, paging through search results as needed.
 	GetTopologies(ctx context.Context, query string) ([]BasicTopologyInfo, error)
 	// Returns Topology details for a given TopologyID
 	GetTopologyByID(ctx context.Context, a4cTopologyID string) (*Topology, error)
+	// Returns only the given sections of the topology with the given TopologyID, other sections are left empty
+	//
+	// Archive name, version and description of the topology are always returned.
+	GetTopologyByIDWithSections(ctx context.Context, a4cTopologyID string, sections TopologySections) (*Topology, error)
+	// Validates the topology with the given TopologyID and returns the tasks to complete before it could be deployed
+	ValidateTopology(ctx context.Context, a4cTopologyID string) (*TopologyValidationResult, error)
+	// Returns the operations available on a node template of the topology of the given application and environment
+	//
+	// Operations defined by the node type hierarchy are returned along with operations overridden by the node template.
+	GetNodeOperations(ctx context.Context, appID, envID, nodeName string) ([]NodeOperationDefinition, error)
 }
 
 type topologyService struct {
@@ -101,7 +197,7 @@ func (t *topologyService) GetTopologyID(ctx context.Context, appID string, envID
 // GetTopologyTemplateIDByName return the topology template ID for the given topologyName
 func (t *topologyService) GetTopologyTemplateIDByName(ctx context.Context, topologyName string) (string, error) {
 
-	toposSearchBody, err := json.Marshal(SearchRequest{topologyName, 0, 1, nil})
+	toposSearchBody, err := json.Marshal(SearchRequest{Query: topologyName, From: 0, Size: 1})
 	if err != nil {
 		return "", errors.Wrap(err, "Cannot marshal a SearchRequest structure")
 	}
@@ -143,16 +239,8 @@ func (t *topologyService) GetTopologyTemplateIDByName(ctx context.Context, topol
 // editTopology Edit the topology of an application
 func (t *topologyService) editTopology(ctx context.Context, a4cCtx *TopologyEditorContext, a4cTopoEditorExecute TopologyEditor) error {
 
-	if a4cCtx == nil {
-		return errors.New("Context object must be defined")
-	}
-
-	if a4cCtx.TopologyID == "" {
-		var err error
-		a4cCtx.TopologyID, err = t.GetTopologyID(ctx, a4cCtx.AppID, a4cCtx.EnvID)
-		if err != nil {
-			return errors.Wrapf(err, "Unable to get A4C application topology for app %s and env %s", a4cCtx.AppID, a4cCtx.EnvID)
-		}
+	if err := t.resolveEditorContext(ctx, a4cCtx); err != nil {
+		return err
 	}
 
 	topoEditorExecuteBody, err := json.Marshal(a4cTopoEditorExecute)
@@ -171,6 +259,29 @@ func (t *topologyService) editTopology(ctx context.Context, a4cCtx *TopologyEdit
 		return errors.Wrap(err, "Unable to create the request edit an A4C topology")
 	}
 
+	return t.sendTopologyEdit(a4cCtx, request, a4cTopoEditorExecute, a4cTopoEditorExecute.getPreviousOperationID())
+}
+
+// resolveEditorContext checks the editor context and retrieves its topology ID if not yet known
+func (t *topologyService) resolveEditorContext(ctx context.Context, a4cCtx *TopologyEditorContext) error {
+	if a4cCtx == nil {
+		return errors.New("Context object must be defined")
+	}
+
+	if a4cCtx.TopologyID == "" {
+		var err error
+		a4cCtx.TopologyID, err = t.GetTopologyID(ctx, a4cCtx.AppID, a4cCtx.EnvID)
+		if err != nil {
+			return errors.Wrapf(err, "Unable to get A4C application topology for app %s and env %s", a4cCtx.AppID, a4cCtx.EnvID)
+		}
+	}
+	return nil
+}
+
+// sendTopologyEdit sends a topology editor request and updates the editor context with the last operation applied.
+//
+// editorRequest is the executed operation if any, used to report property update errors.
+func (t *topologyService) sendTopologyEdit(a4cCtx *TopologyEditorContext, request *http.Request, editorRequest TopologyEditor, previousOperationID string) error {
 	var resExec struct {
 		Data struct {
 			LastOperationIndex int `json:"lastOperationIndex"`
@@ -184,7 +295,10 @@ func (t *topologyService) editTopology(ctx context.Context, a4cCtx *TopologyEdit
 	if err != nil {
 		return errors.Wrap(err, "Unable to send the request edit an A4C topology")
 	}
-	err = ReadA4CResponse(response, &resExec)
+	if err = checkConcurrentEdit(response, a4cCtx.TopologyID, previousOperationID); err != nil {
+		return errors.Wrap(err, "Unable to edit an A4C topology")
+	}
+	err = readTopologyEditorResponse(response, editorRequest, &resExec)
 	if err != nil {
 		return errors.Wrap(err, "Unable to edit an A4C topology")
 	}
@@ -198,7 +312,7 @@ func (t *topologyService) editTopology(ctx context.Context, a4cCtx *TopologyEdit
 }
 
 // GetTopology method returns topology details for a given application and environment
-func (t *topologyService) GetTopology(ctx context.Context, appID string, envID string) (*Topology, error) {
+func (t *topologyService) GetTopology(ctx context.Context, appID string, envID string, opts ...TopologyOption) (*Topology, error) {
 
 	a4cTopologyID, err := t.GetTopologyID(ctx, appID, envID)
 
@@ -212,6 +326,7 @@ func (t *topologyService) GetTopology(ctx context.Context, appID string, envID s
 		return nil, errors.Wrapf(err, "Unable to get A4C application topology for app %s and env %s", appID, envID)
 	}
 
+	applyTopologyOptions(res, opts)
 	return res, nil
 }
 
@@ -516,86 +631,77 @@ func (t *topologyService) SaveA4CTopology(ctx context.Context, a4cCtx *TopologyE
 	}
 
 	// After saving topology, get come back to a clear state.
+	previousOperationID := a4cCtx.PreviousOperationID
 	a4cCtx.PreviousOperationID = ""
 
 	response, err := t.client.Do(request)
 	if err != nil {
 		return errors.Wrap(err, "Unable to send request to save an A4C topology")
 	}
+	if err = checkConcurrentEdit(response, a4cCtx.TopologyID, previousOperationID); err != nil {
+		a4cCtx.PreviousOperationID = previousOperationID
+		return errors.Wrap(err, "Unable to save an A4C topology")
+	}
 	err = ReadA4CResponse(response, nil)
 	return errors.Wrap(err, "Unable to save an A4C topology")
 }
 
 func (t *topologyService) GetTopologies(ctx context.Context, query string) ([]BasicTopologyInfo, error) {
-
-	getTopoJSON, err := json.Marshal(
-		SearchRequest{
-			From:  0,
-			Query: query,
-			Size:  0,
-		},
-	)
-
-	if err != nil {
-		return nil, errors.Wrap(err, "Cannot marshal an a4cgetTopologiesCreateRequest structure")
+	var topologyInfo []BasicTopologyInfo
+	searchRequest := SearchRequest{
+		From:  0,
+		Query: query,
+		Size:  MaxPageSize,
 	}
+	for {
+		getTopoJSON, err := json.Marshal(searchRequest)
+		if err != nil {
+			return nil, errors.Wrap(err, "Cannot marshal an a4cgetTopologiesCreateRequest structure")
+		}
 
-	request, err := t.client.NewRequest(ctx,
-		"POST",
-		fmt.Sprintf("%s/catalog/topologies/search", a4CRestAPIPrefix),
-		bytes.NewReader(getTopoJSON))
+		request, err := t.client.NewRequest(ctx,
+			"POST",
+			fmt.Sprintf("%s/catalog/topologies/search", a4CRestAPIPrefix),
+			bytes.NewReader(getTopoJSON))
 
-	if err != nil {
-		return nil, errors.Wrapf(err, "Cannot create request to get topologies with query %q", query)
-	}
+		if err != nil {
+			return nil, errors.Wrapf(err, "Cannot create request to get topologies with query %q", query)
+		}
 
-	var res struct {
-		Data struct {
-			Types []string `json:"types"`
-			Data  []struct {
-				ArchiveName string `json:"archiveName"`
-				Workspace   string `json:"workspace"`
-				ID          string `json:"id"`
+		var res struct {
+			Data struct {
+				Types []string `json:"types"`
+				Data  []struct {
+					ArchiveName string `json:"archiveName"`
+					Workspace   string `json:"workspace"`
+					ID          string `json:"id"`
+				} `json:"data"`
+				FacetedSearchResult
 			} `json:"data"`
-		} `json:"data"`
-	}
+		}
 
-	response, err := t.client.Do(request)
-	if err != nil {
-		return nil, errors.Wrapf(err, "Cannot send request to get topologies with query %q", query)
-	}
-	err = ReadA4CResponse(response, &res)
-	if err != nil {
-		return nil, errors.Wrapf(err, "Cannot get topologies with query %q", query)
-	}
-	var topologyInfo []BasicTopologyInfo
+		response, err := t.client.Do(request)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Cannot send request to get topologies with query %q", query)
+		}
+		err = ReadA4CResponse(response, &res)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Cannot get topologies with query %q", query)
+		}
 
-	for i := range res.Data.Data {
-		temp := BasicTopologyInfo{ArchiveName: res.Data.Data[i].ArchiveName, Workspace: res.Data.Data[i].Workspace, ID: res.Data.Data[i].ID}
-		topologyInfo = append(topologyInfo, temp)
-	}
+		for i := range res.Data.Data {
+			temp := BasicTopologyInfo{ArchiveName: res.Data.Data[i].ArchiveName, Workspace: res.Data.Data[i].Workspace, ID: res.Data.Data[i].ID}
+			topologyInfo = append(topologyInfo, temp)
+		}
 
-	return topologyInfo, nil
+		searchRequest.From += len(res.Data.Data)
+		if len(res.Data.Data) == 0 || searchRequest.From >= res.Data.TotalResults {
+			return topologyInfo, nil
+		}
+	}
 }
 
+// GetTopologyByID returns Topology details for a given TopologyID
 func (t *topologyService) GetTopologyByID(ctx context.Context, a4cTopologyID string) (*Topology, error) {
-
-	request, err := t.client.NewRequest(ctx,
-		"GET",
-		fmt.Sprintf("%s/topologies/%s", a4CRestAPIPrefix, a4cTopologyID),
-		nil,
-	)
-
-	if err != nil {
-		return nil, errors.Wrapf(err, "Cannot get the topology content for topologyID '%s'", a4cTopologyID)
-	}
-
-	res := new(Topology)
-	response, err := t.client.Do(request)
-	if err != nil {
-		return nil, errors.Wrapf(err, "Cannot get the topology content for topologyID '%s'", a4cTopologyID)
-	}
-	err = ReadA4CResponse(response, res)
-
-	return res, errors.Wrapf(err, "Cannot get the topology content for topologyID '%s'", a4cTopologyID)
+	return t.GetTopologyByIDWithSections(ctx, a4cTopologyID, TopologyAllSections)
 }
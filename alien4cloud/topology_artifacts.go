@@ -0,0 +1,65 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// UpdateNodeDeploymentArtifact uploads the given content as the deployment artifact artifactName of the node nodeName.
+//
+// The content is streamed to the topology editor as a multipart file named fileName, the uploaded file
+// is stored in the topology archive and the node artifact is updated to reference it.
+func (t *topologyService) UpdateNodeDeploymentArtifact(ctx context.Context, a4cCtx *TopologyEditorContext,
+	nodeName, artifactName, fileName string, content io.Reader) error {
+
+	if err := t.resolveEditorContext(ctx, a4cCtx); err != nil {
+		return err
+	}
+
+	request, err := t.client.newMultipartStreamRequest(ctx,
+		fmt.Sprintf("%s/editor/%s/nodetemplates/%s/artifacts/%s?lastOperationId=%s", a4CRestAPIPrefix, a4cCtx.TopologyID,
+			url.PathEscape(nodeName), url.PathEscape(artifactName), url.QueryEscape(a4cCtx.PreviousOperationID)),
+		fileName, content, nil,
+	)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to create the request to update artifact %q of node %q", artifactName, nodeName)
+	}
+
+	err = t.sendTopologyEdit(a4cCtx, request, nil, a4cCtx.PreviousOperationID)
+	return errors.Wrapf(err, "Unable to update artifact %q of node %q", artifactName, nodeName)
+}
+
+// ResetNodeDeploymentArtifact resets the deployment artifact artifactName of the node nodeName
+// to the artifact defined by the node type
+func (t *topologyService) ResetNodeDeploymentArtifact(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, artifactName string) error {
+	req := topologyEditorNodeArtifact{
+		topologyEditorExecuteRequest: topologyEditorExecuteRequest{
+			OperationType: a4cNodeTemplateOperationsPackage + "ResetNodeDeploymentArtifactOperation",
+		},
+		NodeName:     nodeName,
+		ArtifactName: artifactName,
+	}
+	if a4cCtx != nil && a4cCtx.PreviousOperationID != "" {
+		req.topologyEditorExecuteRequest.PreviousOperationID = &a4cCtx.PreviousOperationID
+	}
+	err := t.editTopology(ctx, a4cCtx, req)
+	return errors.Wrapf(err, "Unable to reset artifact %q of node %q", artifactName, nodeName)
+}
@@ -0,0 +1,97 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_topologyService_UpdateNodeDeploymentArtifact(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/editor/tid/nodetemplates/Web/artifacts/war`).Match([]byte(r.URL.Path)):
+			assert.Equal(t, r.URL.Query().Get("lastOperationId"), "prevID")
+			file, header, err := r.FormFile("file")
+			assert.NilError(t, err)
+			defer file.Close()
+			assert.Equal(t, header.Filename, "app.war")
+			b, err := ioutil.ReadAll(file)
+			assert.NilError(t, err)
+			assert.Equal(t, string(b), "binary content")
+			_, _ = w.Write([]byte(`{"data":{"lastOperationIndex":1,"operations":[{"id":"prevID"},{"id":"opID"}]}}`))
+		case regexp.MustCompile(`.*/editor/tid/nodetemplates/Conflict/artifacts/war`).Match([]byte(r.URL.Path)):
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error":{"code": 860,"message":"topology modified"}}`))
+		case regexp.MustCompile(`.*/applications/notfound/environments/.*/topology`).Match([]byte(r.URL.Path)):
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		case regexp.MustCompile(`.*/applications/.*/environments/.*/topology`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":"tid"}`))
+		default:
+			t.Errorf("Unexpected call for request %+v", r)
+		}
+	}))
+	defer ts.Close()
+
+	tServ := &topologyService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	ctx := context.Background()
+
+	a4cCtx := &TopologyEditorContext{AppID: "app", EnvID: "env", PreviousOperationID: "prevID"}
+	err := tServ.UpdateNodeDeploymentArtifact(ctx, a4cCtx, "Web", "war", "app.war", strings.NewReader("binary content"))
+	assert.NilError(t, err)
+	assert.Equal(t, a4cCtx.TopologyID, "tid")
+	assert.Equal(t, a4cCtx.PreviousOperationID, "opID")
+
+	a4cCtx = &TopologyEditorContext{AppID: "app", EnvID: "env", PreviousOperationID: "prevID"}
+	err = tServ.UpdateNodeDeploymentArtifact(ctx, a4cCtx, "Conflict", "war", "app.war", strings.NewReader("binary content"))
+	assert.Assert(t, errors.Is(err, ErrConcurrentEdit), "unexpected error %v", err)
+
+	err = tServ.UpdateNodeDeploymentArtifact(ctx, &TopologyEditorContext{AppID: "notfound", EnvID: "env"}, "Web", "war", "app.war", strings.NewReader(""))
+	assert.ErrorContains(t, err, "not found")
+
+	err = tServ.UpdateNodeDeploymentArtifact(ctx, nil, "Web", "war", "app.war", strings.NewReader(""))
+	assert.ErrorContains(t, err, "Context object must be defined")
+}
+
+func Test_topologyService_ResetNodeDeploymentArtifact(t *testing.T) {
+	var editorRequests []map[string]interface{}
+	ts := newHTTPServerTestEditor(t, &editorRequests)
+	defer ts.Close()
+
+	tServ := &topologyService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	a4cCtx := &TopologyEditorContext{AppID: "app", EnvID: "env"}
+	ctx := context.Background()
+
+	assert.NilError(t, tServ.ResetNodeDeploymentArtifact(ctx, a4cCtx, "Web", "war"))
+	assert.NilError(t, tServ.ResetNodeDeploymentArtifact(ctx, a4cCtx, "Web", "conf"))
+	assert.Equal(t, a4cCtx.PreviousOperationID, "opID")
+
+	assert.DeepEqual(t, editorRequests, []map[string]interface{}{
+		{"type": a4cNodeTemplateOperationsPackage + "ResetNodeDeploymentArtifactOperation", "previousOperationId": nil, "nodeName": "Web", "artifactName": "war"},
+		{"type": a4cNodeTemplateOperationsPackage + "ResetNodeDeploymentArtifactOperation", "previousOperationId": "opID", "nodeName": "Web", "artifactName": "conf"},
+	})
+
+	err := tServ.ResetNodeDeploymentArtifact(ctx, &TopologyEditorContext{AppID: "notfound", EnvID: "env"}, "Web", "war")
+	assert.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,100 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// ErrConcurrentEdit is returned by topology editor operations when the topology was modified
+// by someone else since the operation identified by the editor context PreviousOperationID.
+// It may be retrieved from returned errors using errors.Is.
+var ErrConcurrentEdit = errors.New("concurrent topology edit")
+
+// ConcurrentEditError is returned by topology editor operations when Alien4Cloud rejects an operation
+// because the topology was modified since the operation identified by the editor context PreviousOperationID.
+// It matches both ErrConcurrentEdit and ErrConflict using errors.Is.
+// Callers should get a fresh context using TopologyService.NewTopologyEditorContext and check
+// the topology before applying their changes again.
+//
+// It may be retrieved from returned errors using errors.As.
+type ConcurrentEditError struct {
+	// TopologyID is the ID of the edited topology
+	TopologyID string
+	// PreviousOperationID is the outdated operation ID sent along with the rejected operation
+	PreviousOperationID string
+	// Message is the Alien4Cloud error message
+	Message string
+	// Err is the underlying *A4CError, it is available using errors.Unwrap
+	Err error
+}
+
+func (e *ConcurrentEditError) Error() string {
+	msg := fmt.Sprintf("%s: topology %q was modified since operation %q", ErrConcurrentEdit, e.TopologyID, e.PreviousOperationID)
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// Unwrap returns the underlying *A4CError which matches ErrConflict
+func (e *ConcurrentEditError) Unwrap() error {
+	return e.Err
+}
+
+// Is allows to match a ConcurrentEditError with ErrConcurrentEdit using errors.Is
+func (e *ConcurrentEditError) Is(target error) bool {
+	return target == ErrConcurrentEdit
+}
+
+// NewTopologyEditorContext returns an editor context for the topology of the given application
+// environment, synchronized on the last operation applied to the topology.
+//
+// Editor operations using this context fail with a *ConcurrentEditError if the topology
+// is modified by someone else in the meantime.
+func (t *topologyService) NewTopologyEditorContext(ctx context.Context, appID, envID string) (*TopologyEditorContext, error) {
+	topologyID, err := t.GetTopologyID(ctx, appID, envID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get A4C application topology for app %s and env %s", appID, envID)
+	}
+	topology, err := t.GetTopologyByIDWithSections(ctx, topologyID, 0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get the editor state of A4C application topology for app %s and env %s", appID, envID)
+	}
+	return &TopologyEditorContext{
+		AppID:               appID,
+		EnvID:               envID,
+		TopologyID:          topologyID,
+		PreviousOperationID: topology.LastOperationID(),
+	}, nil
+}
+
+// checkConcurrentEdit returns a *ConcurrentEditError if Alien4Cloud rejected an editor request
+// because of a concurrent modification, the response body is consumed in this case
+func checkConcurrentEdit(response *http.Response, topologyID, previousOperationID string) error {
+	if response.StatusCode != http.StatusConflict {
+		return nil
+	}
+	editErr := &ConcurrentEditError{TopologyID: topologyID, PreviousOperationID: previousOperationID}
+	if err := ReadA4CResponse(response, nil); err != nil {
+		editErr.Message = err.Error()
+		editErr.Err = err
+	}
+	return errors.WithStack(editErr)
+}
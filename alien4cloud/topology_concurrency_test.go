@@ -0,0 +1,82 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_topologyService_ConcurrentEdit(t *testing.T) {
+	lastOperationID := "op2"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/applications/app/environments/env/topology`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":"app:0.1.0"}`))
+		case regexp.MustCompile(`.*/topologies/app:0.1.0`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":{"topology":{"archiveName":"app"},"lastOperationIndex":1,"operations":[{"id":"op1"},{"id":"op2"}]}}`))
+		case regexp.MustCompile(`.*/editor/app:0.1.0/execute`).Match([]byte(r.URL.Path)):
+			var req struct {
+				PreviousOperationID string `json:"previousOperationId"`
+			}
+			assert.NilError(t, json.NewDecoder(r.Body).Decode(&req))
+			if req.PreviousOperationID != lastOperationID {
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"error":{"code":870,"message":"Another user has changed the topology"}}`))
+				return
+			}
+			lastOperationID = "op3"
+			_, _ = w.Write([]byte(`{"data":{"lastOperationIndex":2,"operations":[{"id":"op1"},{"id":"op2"},{"id":"op3"}]}}`))
+		default:
+			t.Errorf("Unexpected call for request %+v", r)
+		}
+	}))
+	defer ts.Close()
+
+	topoService := &topologyService{
+		client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL},
+	}
+	ctx := context.Background()
+
+	agent1, err := topoService.NewTopologyEditorContext(ctx, "app", "env")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, agent1, &TopologyEditorContext{AppID: "app", EnvID: "env", TopologyID: "app:0.1.0", PreviousOperationID: "op2"})
+	agent2, err := topoService.NewTopologyEditorContext(ctx, "app", "env")
+	assert.NilError(t, err)
+
+	err = topoService.UpdateComponentProperty(ctx, agent1, "Compute", "name", "c1")
+	assert.NilError(t, err)
+	assert.Equal(t, agent1.PreviousOperationID, "op3")
+
+	err = topoService.UpdateComponentProperty(ctx, agent2, "Compute", "name", "c2")
+	assert.Assert(t, errors.Is(err, ErrConcurrentEdit), "unexpected error %v", err)
+	var editErr *ConcurrentEditError
+	assert.Assert(t, errors.As(err, &editErr))
+	assert.Equal(t, editErr.TopologyID, "app:0.1.0")
+	assert.Equal(t, editErr.PreviousOperationID, "op2")
+	assert.Equal(t, editErr.Message, "Another user has changed the topology")
+	assert.Assert(t, errors.Is(err, ErrConflict), "unexpected error %v", err)
+	var a4cErr *A4CError
+	assert.Assert(t, errors.As(err, &a4cErr))
+	assert.Equal(t, a4cErr.StatusCode, http.StatusConflict)
+	assert.Equal(t, agent2.PreviousOperationID, "op2")
+}
@@ -0,0 +1,135 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// PropertyUpdateError is returned by the topology editor operations updating a property
+// when Alien4Cloud rejects the provided value (constraint or type violation, unknown property...).
+//
+// It may be retrieved from errors returned by TopologyService using errors.As.
+type PropertyUpdateError struct {
+	// Code is the Alien4Cloud error code
+	Code int
+	// Message is the Alien4Cloud error message
+	Message string
+	// NodeName is the name of the node template holding the property
+	NodeName string
+	// CapabilityName is the name of the capability holding the property if any
+	CapabilityName string
+	// RelationshipName is the name of the relationship holding the property if any
+	RelationshipName string
+	// PropertyPath is the path of the rejected property, for complex properties it may point to a nested field
+	PropertyPath string
+	// Constraint is the name of the violated constraint if any (e.g. "validValues", "greaterThan")
+	Constraint string
+	// Reference is the constraint reference value if any (e.g. the list of valid values)
+	Reference interface{}
+	// Value is the rejected value
+	Value interface{}
+}
+
+func (e *PropertyUpdateError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "invalid value %v for property %q of node %q", e.Value, e.PropertyPath, e.NodeName)
+	if e.CapabilityName != "" {
+		fmt.Fprintf(&b, " capability %q", e.CapabilityName)
+	}
+	if e.RelationshipName != "" {
+		fmt.Fprintf(&b, " relationship %q", e.RelationshipName)
+	}
+	if e.Constraint != "" {
+		fmt.Fprintf(&b, ": constraint %q violated", e.Constraint)
+		if e.Reference != nil {
+			fmt.Fprintf(&b, " (expected %v)", e.Reference)
+		}
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&b, ": %s", e.Message)
+	}
+	return b.String()
+}
+
+// constraintInformation is the representation of the data returned by the editor on a property value violation
+type constraintInformation struct {
+	Path      string      `json:"path"`
+	Name      string      `json:"name"`
+	Reference interface{} `json:"reference"`
+	Value     interface{} `json:"value"`
+	Type      string      `json:"type"`
+}
+
+// propertyUpdateTarget returns a PropertyUpdateError filled with the node, capability or relationship, property and value
+// updated by an editor request, ok is false if the request does not update a property
+func propertyUpdateTarget(editorRequest TopologyEditor) (propErr *PropertyUpdateError, ok bool) {
+	switch r := editorRequest.(type) {
+	case TopologyEditorUpdateNodeProperty:
+		return &PropertyUpdateError{NodeName: r.NodeName, PropertyPath: r.PropertyName, Value: r.PropertyValue}, true
+	case TopologyEditorUpdateNodePropertyComplexType:
+		return &PropertyUpdateError{NodeName: r.NodeName, PropertyPath: r.PropertyName, Value: r.PropertyValue}, true
+	case TopologyEditorUpdateCapabilityProperty:
+		return &PropertyUpdateError{NodeName: r.NodeName, CapabilityName: r.CapabilityName, PropertyPath: r.PropertyName, Value: r.PropertyValue}, true
+	case topologyEditorRelationshipProperty:
+		return &PropertyUpdateError{NodeName: r.NodeName, RelationshipName: r.RelationshipName, PropertyPath: r.PropertyName, Value: r.PropertyValue}, true
+	}
+	return nil, false
+}
+
+// readTopologyEditorResponse reads the response of a topology editor operation
+// and returns a PropertyUpdateError when a property update is rejected
+func readTopologyEditorResponse(response *http.Response, editorRequest TopologyEditor, data interface{}) error {
+	propErr, ok := propertyUpdateTarget(editorRequest)
+	if !ok || response.StatusCode < 400 {
+		return ReadA4CResponse(response, data)
+	}
+
+	defer response.Body.Close()
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return errors.Wrap(err, "Cannot read the response from Alien4Cloud")
+	}
+	var res struct {
+		Data  *constraintInformation `json:"data"`
+		Error Error                  `json:"error"`
+	}
+	if json.Unmarshal(responseBody, &res) != nil || res.Error.Message == "" && res.Data == nil {
+		// Not an editor error payload let the generic reader report it
+		response.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+		return ReadA4CResponse(response, data)
+	}
+
+	propErr.Code = res.Error.Code
+	propErr.Message = res.Error.Message
+	if res.Data != nil {
+		if res.Data.Path != "" {
+			propErr.PropertyPath = res.Data.Path
+		}
+		propErr.Constraint = res.Data.Name
+		propErr.Reference = res.Data.Reference
+		if res.Data.Value != nil {
+			propErr.Value = res.Data.Value
+		}
+	}
+	return propErr
+}
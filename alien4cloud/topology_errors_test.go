@@ -0,0 +1,68 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_topologyService_PropertyUpdateError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/editor/constraint/execute`).Match([]byte(r.URL.Path)):
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"data":{"path":"port","name":"inRange","reference":[1,65535],"value":"70000","type":"integer"},"error":{"code":804,"message":"Property constraint violated"}}`))
+		case regexp.MustCompile(`.*/editor/plain/execute`).Match([]byte(r.URL.Path)):
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"code":806,"message":"Unknown property"}}`))
+		default:
+			t.Errorf("Unexpected call for request %+v", r)
+		}
+	}))
+	defer ts.Close()
+
+	topoService := &topologyService{
+		client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL},
+	}
+
+	err := topoService.UpdateComponentProperty(context.Background(), &TopologyEditorContext{TopologyID: "constraint"}, "Web", "port", "70000")
+	var propErr *PropertyUpdateError
+	assert.Assert(t, errors.As(err, &propErr), "unexpected error %v", err)
+	assert.DeepEqual(t, propErr, &PropertyUpdateError{
+		Code:         804,
+		Message:      "Property constraint violated",
+		NodeName:     "Web",
+		PropertyPath: "port",
+		Constraint:   "inRange",
+		Reference:    []interface{}{float64(1), float64(65535)},
+		Value:        "70000",
+	})
+	assert.ErrorContains(t, err, `constraint "inRange" violated`)
+
+	err = topoService.UpdateCapabilityProperty(context.Background(), &TopologyEditorContext{TopologyID: "plain"}, "Web", "secure", "maybe", "endpoint")
+	assert.Assert(t, errors.As(err, &propErr), "unexpected error %v", err)
+	assert.Equal(t, propErr.CapabilityName, "endpoint")
+	assert.Equal(t, propErr.PropertyPath, "secure")
+	assert.Equal(t, propErr.Value, "maybe")
+	assert.Equal(t, propErr.Constraint, "")
+	assert.ErrorContains(t, err, "Unknown property")
+}
@@ -0,0 +1,131 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+const a4cGroupsOperationsPackage = "org.alien4cloud.tosca.editor.operations.groups."
+
+// AddAbstractNode adds a node of the given type version to the topology.
+//
+// This is typically used to add nodes of abstract types which have no implementation: at deployment time
+// they are matched against on-demand resources or services of the location, allowing to delegate entire
+// subsystems to the location. Unlike AddNodeInA4CTopology, the type does not need to be already used in the
+// topology, but its archive should be a dependency of the topology.
+func (t *topologyService) AddAbstractNode(ctx context.Context, a4cCtx *TopologyEditorContext, nodeTypeID, nodeTypeVersion, nodeName string) error {
+	req := topologyEditorAddNode{
+		topologyEditorExecuteRequest: topologyEditorExecuteRequest{
+			OperationType: a4cNodeTemplateOperationsPackage + "AddNodeOperation",
+		},
+		NodeName:          nodeName,
+		IndexedNodeTypeID: nodeTypeID + ":" + nodeTypeVersion,
+	}
+	if a4cCtx != nil && a4cCtx.PreviousOperationID != "" {
+		req.topologyEditorExecuteRequest.PreviousOperationID = &a4cCtx.PreviousOperationID
+	}
+	err := t.editTopology(ctx, a4cCtx, req)
+	return errors.Wrapf(err, "Unable to add node %q of type %s:%s", nodeName, nodeTypeID, nodeTypeVersion)
+}
+
+// editGroups executes the given groups editor operation
+func (t *topologyService) editGroups(ctx context.Context, a4cCtx *TopologyEditorContext, operation string, req topologyEditorGroups) error {
+	req.OperationType = a4cGroupsOperationsPackage + operation
+	if a4cCtx != nil && a4cCtx.PreviousOperationID != "" {
+		req.topologyEditorExecuteRequest.PreviousOperationID = &a4cCtx.PreviousOperationID
+	}
+	return t.editTopology(ctx, a4cCtx, req)
+}
+
+// AddNodeToGroup adds a node to a group of the topology, the group is created if it does not exist
+func (t *topologyService) AddNodeToGroup(ctx context.Context, a4cCtx *TopologyEditorContext, groupName, nodeName string) error {
+	err := t.editGroups(ctx, a4cCtx, "AddGroupMemberOperation", topologyEditorGroups{GroupName: groupName, NodeName: nodeName})
+	return errors.Wrapf(err, "Unable to add node %q to group %q", nodeName, groupName)
+}
+
+// RemoveNodeFromGroup removes a node from a group of the topology
+func (t *topologyService) RemoveNodeFromGroup(ctx context.Context, a4cCtx *TopologyEditorContext, groupName, nodeName string) error {
+	err := t.editGroups(ctx, a4cCtx, "RemoveGroupMemberOperation", topologyEditorGroups{GroupName: groupName, NodeName: nodeName})
+	return errors.Wrapf(err, "Unable to remove node %q from group %q", nodeName, groupName)
+}
+
+// DeleteGroup deletes a group from the topology, nodes of the group are kept
+func (t *topologyService) DeleteGroup(ctx context.Context, a4cCtx *TopologyEditorContext, groupName string) error {
+	err := t.editGroups(ctx, a4cCtx, "DeleteGroupOperation", topologyEditorGroups{GroupName: groupName})
+	return errors.Wrapf(err, "Unable to delete group %q", groupName)
+}
+
+// CreateGroup creates a group of the topology made of the given nodes.
+//
+// Alien4Cloud groups exist only through their members, so at least one node should be given.
+func (t *topologyService) CreateGroup(ctx context.Context, a4cCtx *TopologyEditorContext, groupName string, nodeNames ...string) error {
+	if len(nodeNames) == 0 {
+		return errors.Errorf("Unable to create group %q: at least one node is required", groupName)
+	}
+	for _, nodeName := range nodeNames {
+		err := t.AddNodeToGroup(ctx, a4cCtx, groupName, nodeName)
+		if err != nil {
+			return errors.Wrapf(err, "Unable to create group %q", groupName)
+		}
+	}
+	return nil
+}
+
+// RenameGroup renames a group of the topology
+func (t *topologyService) RenameGroup(ctx context.Context, a4cCtx *TopologyEditorContext, groupName, newName string) error {
+	err := t.editGroups(ctx, a4cCtx, "RenameGroupOperation", topologyEditorGroups{GroupName: groupName, NewName: newName})
+	return errors.Wrapf(err, "Unable to rename group %q to %q", groupName, newName)
+}
+
+// GetGroupMembers returns the sorted names of the nodes members of the given groups of the topology edited in a4cCtx
+func (t *topologyService) GetGroupMembers(ctx context.Context, a4cCtx *TopologyEditorContext, groupNames ...string) ([]string, error) {
+	if a4cCtx == nil {
+		return nil, errors.New("Context object must be defined")
+	}
+	if a4cCtx.TopologyID == "" {
+		var err error
+		a4cCtx.TopologyID, err = t.GetTopologyID(ctx, a4cCtx.AppID, a4cCtx.EnvID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Unable to get A4C application topology for app %s and env %s", a4cCtx.AppID, a4cCtx.EnvID)
+		}
+	}
+	topology, err := t.GetTopologyByIDWithSections(ctx, a4cCtx.TopologyID, TopologyNodeTemplates)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get members of groups %v", groupNames)
+	}
+
+	found := make(map[string]bool, len(groupNames))
+	var members []string
+	for nodeName, node := range topology.Data.Topology.NodeTemplates {
+		for _, group := range node.Groups {
+			if containsString(groupNames, group) {
+				found[group] = true
+				members = append(members, nodeName)
+				break
+			}
+		}
+	}
+	for _, group := range groupNames {
+		if !found[group] {
+			return nil, errors.Errorf("Unable to get members of groups %v: group %q not found in topology %s", groupNames, group, a4cCtx.TopologyID)
+		}
+	}
+	sort.Strings(members)
+	return members, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_topologyService_AbstractNodesAndGroups(t *testing.T) {
+	var editorRequests []map[string]interface{}
+	ts := newHTTPServerTestEditor(t, &editorRequests)
+	defer ts.Close()
+
+	tServ := &topologyService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	a4cCtx := &TopologyEditorContext{AppID: "app", EnvID: "env"}
+	ctx := context.Background()
+
+	assert.NilError(t, tServ.AddAbstractNode(ctx, a4cCtx, "tosca.nodes.Database", "1.0.0", "DB"))
+	assert.NilError(t, tServ.AddNodeToGroup(ctx, a4cCtx, "backend", "DB"))
+	assert.NilError(t, tServ.RemoveNodeFromGroup(ctx, a4cCtx, "backend", "DB"))
+	assert.NilError(t, tServ.DeleteGroup(ctx, a4cCtx, "backend"))
+
+	assert.DeepEqual(t, editorRequests, []map[string]interface{}{
+		{"type": "org.alien4cloud.tosca.editor.operations.nodetemplate.AddNodeOperation", "previousOperationId": nil, "nodeName": "DB", "indexedNodeTypeId": "tosca.nodes.Database:1.0.0"},
+		{"type": a4cGroupsOperationsPackage + "AddGroupMemberOperation", "previousOperationId": "opID", "groupName": "backend", "nodeName": "DB"},
+		{"type": a4cGroupsOperationsPackage + "RemoveGroupMemberOperation", "previousOperationId": "opID", "groupName": "backend", "nodeName": "DB"},
+		{"type": a4cGroupsOperationsPackage + "DeleteGroupOperation", "previousOperationId": "opID", "groupName": "backend"},
+	})
+
+	err := tServ.AddNodeToGroup(ctx, &TopologyEditorContext{AppID: "notfound", EnvID: "env"}, "backend", "DB")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func Test_topologyService_GroupPolicies(t *testing.T) {
+	var editorRequests []map[string]interface{}
+	ts := newHTTPServerTestEditor(t, &editorRequests)
+	defer ts.Close()
+
+	tServ := &topologyService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	a4cCtx := &TopologyEditorContext{AppID: "app", EnvID: "env"}
+	ctx := context.Background()
+
+	assert.NilError(t, tServ.CreateGroup(ctx, a4cCtx, "frontend", "Web1", "Web2"))
+	assert.NilError(t, tServ.RenameGroup(ctx, a4cCtx, "backend", "database"))
+
+	members, err := tServ.GetGroupMembers(ctx, a4cCtx, "frontend")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, members, []string{"Web1", "Web2"})
+	members, err = tServ.GetGroupMembers(ctx, a4cCtx, "frontend", "monitored")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, members, []string{"DB", "Web1", "Web2"})
+
+	assert.NilError(t, tServ.AddGroupPolicy(ctx, a4cCtx, "anti_affinity", "org.alien4cloud.policies.AntiAffinity:1.0.0", "frontend"))
+	assert.NilError(t, tServ.SetPolicyTargetsFromGroups(ctx, a4cCtx, "anti_affinity", "monitored"))
+
+	assert.DeepEqual(t, editorRequests, []map[string]interface{}{
+		{"type": a4cGroupsOperationsPackage + "AddGroupMemberOperation", "previousOperationId": nil, "groupName": "frontend", "nodeName": "Web1"},
+		{"type": a4cGroupsOperationsPackage + "AddGroupMemberOperation", "previousOperationId": "opID", "groupName": "frontend", "nodeName": "Web2"},
+		{"type": a4cGroupsOperationsPackage + "RenameGroupOperation", "previousOperationId": "opID", "groupName": "backend", "newName": "database"},
+		{"type": "org.alien4cloud.tosca.editor.operations.policies.AddPolicyOperation", "previousOperationId": "opID",
+			"policyName": "anti_affinity", "policyTypeId": "org.alien4cloud.policies.AntiAffinity:1.0.0"},
+		{"type": "org.alien4cloud.tosca.editor.operations.policies.UpdatePolicyTargetsOperation", "previousOperationId": "opID",
+			"policyName": "anti_affinity", "targets": []interface{}{"Web1", "Web2"}},
+		{"type": "org.alien4cloud.tosca.editor.operations.policies.UpdatePolicyTargetsOperation", "previousOperationId": "opID",
+			"policyName": "anti_affinity", "targets": []interface{}{"DB", "Web2"}},
+	})
+
+	err = tServ.CreateGroup(ctx, a4cCtx, "empty")
+	assert.ErrorContains(t, err, "at least one node is required")
+	err = tServ.SetPolicyTargetsFromGroups(ctx, a4cCtx, "anti_affinity", "unknown")
+	assert.ErrorContains(t, err, `group "unknown" not found`)
+}
@@ -0,0 +1,96 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	a4cInputsOperationsPackage             = "org.alien4cloud.tosca.editor.operations.inputs."
+	a4cNodeTemplateInputsOperationsPackage = "org.alien4cloud.tosca.editor.operations.nodetemplate.inputs."
+)
+
+// editInputs executes the given inputs editor operation, operation is the fully qualified operation type
+func (t *topologyService) editInputs(ctx context.Context, a4cCtx *TopologyEditorContext, operation string, req topologyEditorInputs) error {
+	req.OperationType = operation
+	if a4cCtx != nil && a4cCtx.PreviousOperationID != "" {
+		req.topologyEditorExecuteRequest.PreviousOperationID = &a4cCtx.PreviousOperationID
+	}
+	return t.editTopology(ctx, a4cCtx, req)
+}
+
+// AddInput defines a new input of the topology
+func (t *topologyService) AddInput(ctx context.Context, a4cCtx *TopologyEditorContext, inputName string, definition PropertyDefinition) error {
+	propDef := &topologyEditorPropertyDefinition{
+		Type:        definition.Type,
+		Required:    definition.Required,
+		Description: definition.Description,
+		Password:    definition.Password,
+	}
+	if definition.EntrySchema.Type != "" {
+		propDef.EntrySchema = &definition.EntrySchema
+	}
+	if !reflect.DeepEqual(definition.DefaultValue, PropertyValue{}) {
+		propDef.Default = &definition.DefaultValue
+	}
+	err := t.editInputs(ctx, a4cCtx, a4cInputsOperationsPackage+"AddInputOperation",
+		topologyEditorInputs{InputName: inputName, PropertyDefinition: propDef})
+	return errors.Wrapf(err, "Unable to add input %q", inputName)
+}
+
+// DeleteInput deletes an input of the topology, properties referencing this input are reset
+func (t *topologyService) DeleteInput(ctx context.Context, a4cCtx *TopologyEditorContext, inputName string) error {
+	err := t.editInputs(ctx, a4cCtx, a4cInputsOperationsPackage+"DeleteInputOperation", topologyEditorInputs{InputName: inputName})
+	return errors.Wrapf(err, "Unable to delete input %q", inputName)
+}
+
+// RenameInput renames an input of the topology, properties referencing this input are updated accordingly
+func (t *topologyService) RenameInput(ctx context.Context, a4cCtx *TopologyEditorContext, inputName, newInputName string) error {
+	err := t.editInputs(ctx, a4cCtx, a4cInputsOperationsPackage+"RenameInputOperation",
+		topologyEditorInputs{InputName: inputName, NewInputName: newInputName})
+	return errors.Wrapf(err, "Unable to rename input %q to %q", inputName, newInputName)
+}
+
+// SetNodePropertyAsInput sets the value of a property of a node to a get_input function on the given input
+func (t *topologyService) SetNodePropertyAsInput(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, propertyName, inputName string) error {
+	err := t.editInputs(ctx, a4cCtx, a4cNodeTemplateInputsOperationsPackage+"SetNodePropertyAsInputOperation",
+		topologyEditorInputs{NodeName: nodeName, PropertyName: propertyName, InputName: inputName})
+	return errors.Wrapf(err, "Unable to set property %q of node %q as input %q", propertyName, nodeName, inputName)
+}
+
+// UnsetNodePropertyAsInput resets the value of a property of a node previously set as an input
+func (t *topologyService) UnsetNodePropertyAsInput(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, propertyName string) error {
+	err := t.editInputs(ctx, a4cCtx, a4cNodeTemplateInputsOperationsPackage+"UnsetNodePropertyAsInputOperation",
+		topologyEditorInputs{NodeName: nodeName, PropertyName: propertyName})
+	return errors.Wrapf(err, "Unable to unset input of property %q of node %q", propertyName, nodeName)
+}
+
+// SetNodeCapabilityPropertyAsInput sets the value of a property of a node capability to a get_input function on the given input
+func (t *topologyService) SetNodeCapabilityPropertyAsInput(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, capabilityName, propertyName, inputName string) error {
+	err := t.editInputs(ctx, a4cCtx, a4cNodeTemplateInputsOperationsPackage+"SetNodeCapabilityPropertyAsInputOperation",
+		topologyEditorInputs{NodeName: nodeName, CapabilityName: capabilityName, PropertyName: propertyName, InputName: inputName})
+	return errors.Wrapf(err, "Unable to set property %q of capability %q of node %q as input %q", propertyName, capabilityName, nodeName, inputName)
+}
+
+// UnsetNodeCapabilityPropertyAsInput resets the value of a property of a node capability previously set as an input
+func (t *topologyService) UnsetNodeCapabilityPropertyAsInput(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, capabilityName, propertyName string) error {
+	err := t.editInputs(ctx, a4cCtx, a4cNodeTemplateInputsOperationsPackage+"UnsetNodeCapabilityPropertyAsInputOperation",
+		topologyEditorInputs{NodeName: nodeName, CapabilityName: capabilityName, PropertyName: propertyName})
+	return errors.Wrapf(err, "Unable to unset input of property %q of capability %q of node %q", propertyName, capabilityName, nodeName)
+}
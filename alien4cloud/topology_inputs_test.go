@@ -0,0 +1,56 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_topologyService_Inputs(t *testing.T) {
+	var editorRequests []map[string]interface{}
+	ts := newHTTPServerTestEditor(t, &editorRequests)
+	defer ts.Close()
+
+	tServ := &topologyService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	a4cCtx := &TopologyEditorContext{AppID: "app", EnvID: "env"}
+	ctx := context.Background()
+
+	assert.NilError(t, tServ.AddInput(ctx, a4cCtx, "port", PropertyDefinition{Type: "integer", Required: true, DefaultValue: PropertyValue{Value: "8080"}}))
+	assert.NilError(t, tServ.RenameInput(ctx, a4cCtx, "port", "http_port"))
+	assert.NilError(t, tServ.SetNodePropertyAsInput(ctx, a4cCtx, "Web", "port", "http_port"))
+	assert.NilError(t, tServ.UnsetNodePropertyAsInput(ctx, a4cCtx, "Web", "port"))
+	assert.NilError(t, tServ.SetNodeCapabilityPropertyAsInput(ctx, a4cCtx, "Web", "endpoint", "port", "http_port"))
+	assert.NilError(t, tServ.UnsetNodeCapabilityPropertyAsInput(ctx, a4cCtx, "Web", "endpoint", "port"))
+	assert.NilError(t, tServ.DeleteInput(ctx, a4cCtx, "http_port"))
+	assert.Equal(t, a4cCtx.PreviousOperationID, "opID")
+
+	assert.DeepEqual(t, editorRequests, []map[string]interface{}{
+		{"type": a4cInputsOperationsPackage + "AddInputOperation", "previousOperationId": nil, "inputName": "port",
+			"propertyDefinition": map[string]interface{}{"type": "integer", "required": true, "default": map[string]interface{}{"value": "8080"}}},
+		{"type": a4cInputsOperationsPackage + "RenameInputOperation", "previousOperationId": "opID", "inputName": "port", "newInputName": "http_port"},
+		{"type": a4cNodeTemplateInputsOperationsPackage + "SetNodePropertyAsInputOperation", "previousOperationId": "opID", "nodeName": "Web", "propertyName": "port", "inputName": "http_port"},
+		{"type": a4cNodeTemplateInputsOperationsPackage + "UnsetNodePropertyAsInputOperation", "previousOperationId": "opID", "nodeName": "Web", "propertyName": "port", "inputName": ""},
+		{"type": a4cNodeTemplateInputsOperationsPackage + "SetNodeCapabilityPropertyAsInputOperation", "previousOperationId": "opID", "nodeName": "Web", "capabilityName": "endpoint", "propertyName": "port", "inputName": "http_port"},
+		{"type": a4cNodeTemplateInputsOperationsPackage + "UnsetNodeCapabilityPropertyAsInputOperation", "previousOperationId": "opID", "nodeName": "Web", "capabilityName": "endpoint", "propertyName": "port", "inputName": ""},
+		{"type": a4cInputsOperationsPackage + "DeleteInputOperation", "previousOperationId": "opID", "inputName": "http_port"},
+	})
+
+	err := tServ.AddInput(ctx, &TopologyEditorContext{AppID: "notfound", EnvID: "env"}, "port", PropertyDefinition{Type: "integer"})
+	assert.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,42 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// GetNodeOperations returns the operations available on a node template of the topology of the given application
+// and environment, sorted by interface and operation names.
+//
+// Operations are collected along the node type hierarchy, a node template overriding an operation of its type
+// takes precedence over the type definition.
+func (t *topologyService) GetNodeOperations(ctx context.Context, appID, envID, nodeName string) ([]NodeOperationDefinition, error) {
+	topologyID, err := t.GetTopologyID(ctx, appID, envID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get A4C application topology for app %s and env %s", appID, envID)
+	}
+	topology, err := t.GetTopologyByIDWithSections(ctx, topologyID, TopologyNodeTemplates|TopologyTypes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get operations of node %q", nodeName)
+	}
+	operations, ok := topology.NodeOperations(nodeName)
+	if !ok {
+		return nil, errors.Errorf("Unable to get operations of node %q: node not found in topology %s", nodeName, topologyID)
+	}
+	return operations, nil
+}
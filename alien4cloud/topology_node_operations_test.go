@@ -0,0 +1,57 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_topologyService_GetNodeOperations(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/latest/applications/app/environments/env/topology":
+			_, _ = w.Write([]byte(`{"data":"app:0.1.0-SNAPSHOT"}`))
+		case "/rest/latest/topologies/app:0.1.0-SNAPSHOT":
+			_, _ = w.Write([]byte(`{"data":{
+				"nodeTypes":{
+					"tosca.nodes.Root":{"elementId":"tosca.nodes.Root","interfaces":{"tosca.interfaces.node.lifecycle.Standard":{"operations":{"create":{}}}}},
+					"tosca.nodes.Compute":{"elementId":"tosca.nodes.Compute","derivedFrom":["tosca.nodes.Root"]}
+				},
+				"topology":{"nodeTemplates":{"Compute":{"name":"Compute","type":"tosca.nodes.Compute"}}}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	topologyService := &topologyService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	ctx := context.Background()
+
+	operations, err := topologyService.GetNodeOperations(ctx, "app", "env", "Compute")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, operations, []NodeOperationDefinition{{InterfaceName: StandardNodeInterface, OperationName: "create", DefinedBy: "tosca.nodes.Root"}})
+
+	_, err = topologyService.GetNodeOperations(ctx, "app", "env", "unknown")
+	assert.ErrorContains(t, err, `node "unknown": node not found`)
+
+	_, err = topologyService.GetNodeOperations(ctx, "app", "other", "Compute")
+	assert.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,52 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+const a4cNodeTemplateOperationsPackage = "org.alien4cloud.tosca.editor.operations.nodetemplate."
+
+// editNode executes the given node template editor operation
+func (t *topologyService) editNode(ctx context.Context, a4cCtx *TopologyEditorContext, operation string, req topologyEditorNode) error {
+	req.OperationType = a4cNodeTemplateOperationsPackage + operation
+	if a4cCtx != nil && a4cCtx.PreviousOperationID != "" {
+		req.topologyEditorExecuteRequest.PreviousOperationID = &a4cCtx.PreviousOperationID
+	}
+	return t.editTopology(ctx, a4cCtx, req)
+}
+
+// DeleteNode deletes a node from the topology, relationships targeting this node are deleted too
+func (t *topologyService) DeleteNode(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName string) error {
+	err := t.editNode(ctx, a4cCtx, "DeleteNodeOperation", topologyEditorNode{NodeName: nodeName})
+	return errors.Wrapf(err, "Unable to delete node %q", nodeName)
+}
+
+// RenameNode renames a node of the topology, relationships, groups, policies and workflows referencing
+// this node are updated accordingly
+func (t *topologyService) RenameNode(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, newName string) error {
+	err := t.editNode(ctx, a4cCtx, "RenameNodeOperation", topologyEditorNode{NodeName: nodeName, NewName: newName})
+	return errors.Wrapf(err, "Unable to rename node %q to %q", nodeName, newName)
+}
+
+// UpdateNodePosition sets the coordinates of a node in the Alien4Cloud topology editor, so that topologies
+// built programmatically get a readable layout when opened in the UI
+func (t *topologyService) UpdateNodePosition(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName string, x, y int) error {
+	err := t.editNode(ctx, a4cCtx, "UpdateNodePositionOperation", topologyEditorNode{NodeName: nodeName, Coords: &topologyEditorCoords{X: x, Y: y}})
+	return errors.Wrapf(err, "Unable to update position of node %q", nodeName)
+}
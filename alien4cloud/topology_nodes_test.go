@@ -0,0 +1,67 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_topologyService_DeleteAndRenameNode(t *testing.T) {
+	var editorRequests []map[string]interface{}
+	ts := newHTTPServerTestEditor(t, &editorRequests)
+	defer ts.Close()
+
+	tServ := &topologyService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	a4cCtx := &TopologyEditorContext{AppID: "app", EnvID: "env"}
+	ctx := context.Background()
+
+	assert.NilError(t, tServ.RenameNode(ctx, a4cCtx, "Compute", "Server"))
+	assert.NilError(t, tServ.DeleteNode(ctx, a4cCtx, "Server"))
+
+	assert.DeepEqual(t, editorRequests, []map[string]interface{}{
+		{"type": a4cNodeTemplateOperationsPackage + "RenameNodeOperation", "previousOperationId": nil, "nodeName": "Compute", "newName": "Server"},
+		{"type": a4cNodeTemplateOperationsPackage + "DeleteNodeOperation", "previousOperationId": "opID", "nodeName": "Server"},
+	})
+
+	err := tServ.DeleteNode(ctx, &TopologyEditorContext{AppID: "notfound", EnvID: "env"}, "Server")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func Test_topologyService_UpdateNodePosition(t *testing.T) {
+	var editorRequests []map[string]interface{}
+	ts := newHTTPServerTestEditor(t, &editorRequests)
+	defer ts.Close()
+
+	tServ := &topologyService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	a4cCtx := &TopologyEditorContext{AppID: "app", EnvID: "env"}
+	ctx := context.Background()
+
+	assert.NilError(t, tServ.UpdateNodePosition(ctx, a4cCtx, "Compute", 0, 0))
+	assert.NilError(t, tServ.UpdateNodePosition(ctx, a4cCtx, "Web", 200, -120))
+
+	assert.DeepEqual(t, editorRequests, []map[string]interface{}{
+		{"type": a4cNodeTemplateOperationsPackage + "UpdateNodePositionOperation", "previousOperationId": nil, "nodeName": "Compute",
+			"coords": map[string]interface{}{"x": float64(0), "y": float64(0)}},
+		{"type": a4cNodeTemplateOperationsPackage + "UpdateNodePositionOperation", "previousOperationId": "opID", "nodeName": "Web",
+			"coords": map[string]interface{}{"x": float64(200), "y": float64(-120)}},
+	})
+
+	err := tServ.UpdateNodePosition(ctx, &TopologyEditorContext{AppID: "notfound", EnvID: "env"}, "Web", 1, 1)
+	assert.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+// TopologyOption is an option of functions returning topologies like TopologyService.GetTopology
+// and ApplicationService.GetDeploymentTopology
+type TopologyOption func(*topologyOptions)
+
+type topologyOptions struct {
+	maskSecrets    bool
+	excludeSecrets bool
+}
+
+// WithSecretsMasked replaces values of secret properties of returned topologies by SecretMask,
+// see Topology.MaskSecrets()
+func WithSecretsMasked() TopologyOption {
+	return func(o *topologyOptions) {
+		o.maskSecrets = true
+	}
+}
+
+// WithSecretsExcluded removes secret properties from returned topologies, see Topology.ExcludeSecrets().
+// It takes precedence over WithSecretsMasked.
+func WithSecretsExcluded() TopologyOption {
+	return func(o *topologyOptions) {
+		o.excludeSecrets = true
+	}
+}
+
+// applyTopologyOptions applies the given options to a retrieved topology
+func applyTopologyOptions(topology *Topology, opts []TopologyOption) {
+	var o topologyOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	switch {
+	case o.excludeSecrets:
+		topology.ExcludeSecrets()
+	case o.maskSecrets:
+		topology.MaskSecrets()
+	}
+}
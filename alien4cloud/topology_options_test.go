@@ -0,0 +1,73 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestTopologyOptions_Secrets(t *testing.T) {
+	const topology = `{"data":{
+		"nodeTypes":{"org.DB":{"elementId":"org.DB","properties":[
+			{"key":"user","value":{"type":"string"}},
+			{"key":"password","value":{"type":"string","password":true}}
+		]}},
+		"topology":{
+			"nodeTemplates":{"DB":{"name":"DB","type":"org.DB","properties":[
+				{"key":"user","value":{"value":"admin"}},
+				{"key":"password","value":{"value":"s3cr3t"}}
+			]}},
+			"inputs":{"token":{"type":"string","password":true}},
+			"deployerInputProperties":{"token":{"value":"t0k3n"}}
+		}
+	}}`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/latest/applications/app/environments/env/topology":
+			_, _ = w.Write([]byte(`{"data":"app:0.1.0"}`))
+		case "/rest/latest/topologies/app:0.1.0", "/rest/latest/applications/app/environments/env/deployment-topology":
+			_, _ = w.Write([]byte(topology))
+		default:
+			t.Errorf("Unexpected call for request %+v", r)
+		}
+	}))
+	defer ts.Close()
+
+	client := &a4cClient{client: http.DefaultClient, baseURL: ts.URL}
+	topologyService := &topologyService{client: client}
+	appService := &applicationService{client: client}
+	ctx := context.Background()
+
+	res, err := topologyService.GetTopology(ctx, "app", "env")
+	assert.NilError(t, err)
+	assert.Equal(t, res.Data.Topology.NodeTemplates["DB"].Properties[1].Value.Value, "s3cr3t")
+
+	res, err = topologyService.GetTopology(ctx, "app", "env", WithSecretsMasked())
+	assert.NilError(t, err)
+	assert.Equal(t, res.Data.Topology.NodeTemplates["DB"].Properties[0].Value.Value, "admin")
+	assert.Equal(t, res.Data.Topology.NodeTemplates["DB"].Properties[1].Value.Value, SecretMask)
+	assert.Equal(t, res.Data.Topology.DeployerInputProperties["token"].Value, SecretMask)
+
+	res, err = appService.GetDeploymentTopology(ctx, "app", "env", WithSecretsMasked(), WithSecretsExcluded())
+	assert.NilError(t, err)
+	assert.Equal(t, len(res.Data.Topology.NodeTemplates["DB"].Properties), 1)
+	_, ok := res.Data.Topology.DeployerInputProperties["token"]
+	assert.Assert(t, !ok)
+}
@@ -0,0 +1,70 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+const a4cOutputsOperationsPackage = "org.alien4cloud.tosca.editor.operations.nodetemplate.outputs."
+
+// editOutputs executes the given outputs editor operation
+func (t *topologyService) editOutputs(ctx context.Context, a4cCtx *TopologyEditorContext, operation string, req topologyEditorOutputs) error {
+	req.OperationType = a4cOutputsOperationsPackage + operation
+	if a4cCtx != nil && a4cCtx.PreviousOperationID != "" {
+		req.topologyEditorExecuteRequest.PreviousOperationID = &a4cCtx.PreviousOperationID
+	}
+	return t.editTopology(ctx, a4cCtx, req)
+}
+
+// SetOutputAttribute declares an attribute of a node as an output of the topology
+func (t *topologyService) SetOutputAttribute(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, attributeName string) error {
+	err := t.editOutputs(ctx, a4cCtx, "SetNodeAttributeAsOutputOperation", topologyEditorOutputs{NodeName: nodeName, AttributeName: attributeName})
+	return errors.Wrapf(err, "Unable to set attribute %q of node %q as output", attributeName, nodeName)
+}
+
+// RemoveOutputAttribute removes an attribute of a node from the outputs of the topology
+func (t *topologyService) RemoveOutputAttribute(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, attributeName string) error {
+	err := t.editOutputs(ctx, a4cCtx, "UnSetNodeAttributeAsOutputOperation", topologyEditorOutputs{NodeName: nodeName, AttributeName: attributeName})
+	return errors.Wrapf(err, "Unable to remove attribute %q of node %q from outputs", attributeName, nodeName)
+}
+
+// SetOutputProperty declares a property of a node as an output of the topology
+func (t *topologyService) SetOutputProperty(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, propertyName string) error {
+	err := t.editOutputs(ctx, a4cCtx, "SetNodePropertyAsOutputOperation", topologyEditorOutputs{NodeName: nodeName, PropertyName: propertyName})
+	return errors.Wrapf(err, "Unable to set property %q of node %q as output", propertyName, nodeName)
+}
+
+// RemoveOutputProperty removes a property of a node from the outputs of the topology
+func (t *topologyService) RemoveOutputProperty(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, propertyName string) error {
+	err := t.editOutputs(ctx, a4cCtx, "UnSetNodePropertyAsOutputOperation", topologyEditorOutputs{NodeName: nodeName, PropertyName: propertyName})
+	return errors.Wrapf(err, "Unable to remove property %q of node %q from outputs", propertyName, nodeName)
+}
+
+// SetOutputCapabilityProperty declares a property of a node capability as an output of the topology
+func (t *topologyService) SetOutputCapabilityProperty(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, capabilityName, propertyName string) error {
+	err := t.editOutputs(ctx, a4cCtx, "SetNodeCapabilityPropertyAsOutputOperation",
+		topologyEditorOutputs{NodeName: nodeName, CapabilityName: capabilityName, PropertyName: propertyName})
+	return errors.Wrapf(err, "Unable to set property %q of capability %q of node %q as output", propertyName, capabilityName, nodeName)
+}
+
+// RemoveOutputCapabilityProperty removes a property of a node capability from the outputs of the topology
+func (t *topologyService) RemoveOutputCapabilityProperty(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, capabilityName, propertyName string) error {
+	err := t.editOutputs(ctx, a4cCtx, "UnSetNodeCapabilityPropertyAsOutputOperation",
+		topologyEditorOutputs{NodeName: nodeName, CapabilityName: capabilityName, PropertyName: propertyName})
+	return errors.Wrapf(err, "Unable to remove property %q of capability %q of node %q from outputs", propertyName, capabilityName, nodeName)
+}
@@ -0,0 +1,82 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+// newHTTPServerTestEditor returns a server recording topology editor requests into the given slice.
+// The topology of application "notfound" does not exist.
+func newHTTPServerTestEditor(t *testing.T, editorRequests *[]map[string]interface{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case regexp.MustCompile(`.*/editor/.*/execute`).Match([]byte(r.URL.Path)):
+			var req map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Errorf("Failed to decode request body %+v", r)
+			}
+			*editorRequests = append(*editorRequests, req)
+			_, _ = w.Write([]byte(`{"data":{"lastOperationIndex":0,"operations":[{"id":"opID"}]}}`))
+		case regexp.MustCompile(`.*/topologies/tid$`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":{"topology":{"nodeTemplates":{"Web1":{"name":"Web1","groups":["frontend"]},
+				"Web2":{"name":"Web2","groups":["frontend","monitored"]},"DB":{"name":"DB","groups":["backend","monitored"]},"LB":{"name":"LB"}}}}}`))
+		case regexp.MustCompile(`.*/applications/notfound/environments/.*/topology`).Match([]byte(r.URL.Path)):
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+		case regexp.MustCompile(`.*/applications/.*/environments/.*/topology`).Match([]byte(r.URL.Path)):
+			_, _ = w.Write([]byte(`{"data":"tid"}`))
+		default:
+			t.Errorf("Unexpected call for request %+v", r)
+		}
+	}))
+}
+
+func Test_topologyService_Outputs(t *testing.T) {
+	var editorRequests []map[string]interface{}
+	ts := newHTTPServerTestEditor(t, &editorRequests)
+	defer ts.Close()
+
+	tServ := &topologyService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	a4cCtx := &TopologyEditorContext{AppID: "app", EnvID: "env"}
+	ctx := context.Background()
+
+	assert.NilError(t, tServ.SetOutputAttribute(ctx, a4cCtx, "Compute", "public_address"))
+	assert.NilError(t, tServ.RemoveOutputAttribute(ctx, a4cCtx, "Compute", "public_address"))
+	assert.NilError(t, tServ.SetOutputProperty(ctx, a4cCtx, "Compute", "user"))
+	assert.NilError(t, tServ.RemoveOutputProperty(ctx, a4cCtx, "Compute", "user"))
+	assert.NilError(t, tServ.SetOutputCapabilityProperty(ctx, a4cCtx, "Compute", "endpoint", "port"))
+	assert.NilError(t, tServ.RemoveOutputCapabilityProperty(ctx, a4cCtx, "Compute", "endpoint", "port"))
+	assert.Equal(t, a4cCtx.PreviousOperationID, "opID")
+
+	assert.DeepEqual(t, editorRequests, []map[string]interface{}{
+		{"type": a4cOutputsOperationsPackage + "SetNodeAttributeAsOutputOperation", "previousOperationId": nil, "nodeName": "Compute", "attributeName": "public_address"},
+		{"type": a4cOutputsOperationsPackage + "UnSetNodeAttributeAsOutputOperation", "previousOperationId": "opID", "nodeName": "Compute", "attributeName": "public_address"},
+		{"type": a4cOutputsOperationsPackage + "SetNodePropertyAsOutputOperation", "previousOperationId": "opID", "nodeName": "Compute", "propertyName": "user"},
+		{"type": a4cOutputsOperationsPackage + "UnSetNodePropertyAsOutputOperation", "previousOperationId": "opID", "nodeName": "Compute", "propertyName": "user"},
+		{"type": a4cOutputsOperationsPackage + "SetNodeCapabilityPropertyAsOutputOperation", "previousOperationId": "opID", "nodeName": "Compute", "capabilityName": "endpoint", "propertyName": "port"},
+		{"type": a4cOutputsOperationsPackage + "UnSetNodeCapabilityPropertyAsOutputOperation", "previousOperationId": "opID", "nodeName": "Compute", "capabilityName": "endpoint", "propertyName": "port"},
+	})
+
+	err := tServ.SetOutputAttribute(ctx, &TopologyEditorContext{AppID: "notfound", EnvID: "env"}, "Compute", "public_address")
+	assert.ErrorContains(t, err, "not found")
+}
@@ -53,3 +53,29 @@ func (t *topologyService) DeletePolicy(ctx context.Context, a4cCtx *TopologyEdit
 	err := t.editTopology(ctx, a4cCtx, req)
 	return errors.Wrapf(err, "Unable to delete policy %q in topology of application %q and environment %q", policyName, a4cCtx.AppID, a4cCtx.EnvID)
 }
+
+// SetPolicyTargetsFromGroups sets the targets of a policy of the topology to the nodes members of the given groups.
+//
+// This allows to define group-based policies, like anti-affinity placement policies, Alien4Cloud policies
+// targeting nodes only. Nodes added to the groups afterwards are not targeted by the policy until this function
+// is called again.
+func (t *topologyService) SetPolicyTargetsFromGroups(ctx context.Context, a4cCtx *TopologyEditorContext, policyName string, groupNames ...string) error {
+	targets, err := t.GetGroupMembers(ctx, a4cCtx, groupNames...)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to set targets of policy %q", policyName)
+	}
+	return t.AddTargetsToPolicy(ctx, a4cCtx, policyName, targets)
+}
+
+// AddGroupPolicy adds a policy to the topology targeting the nodes members of the given groups
+func (t *topologyService) AddGroupPolicy(ctx context.Context, a4cCtx *TopologyEditorContext, policyName, policyTypeID string, groupNames ...string) error {
+	targets, err := t.GetGroupMembers(ctx, a4cCtx, groupNames...)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to add policy %q", policyName)
+	}
+	err = t.AddPolicy(ctx, a4cCtx, policyName, policyTypeID)
+	if err != nil {
+		return err
+	}
+	return t.AddTargetsToPolicy(ctx, a4cCtx, policyName, targets)
+}
@@ -0,0 +1,64 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+const a4cRelationshipOperationsPackage = "org.alien4cloud.tosca.editor.operations.relationshiptemplate."
+
+// DeleteRelationship deletes a relationship of a node of the topology.
+//
+// Relationships created by AddRelationship are named after the source node, the relationship type
+// and the target node, names of existing relationships are available in node templates of the topology.
+func (t *topologyService) DeleteRelationship(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, relationshipName string) error {
+	req := topologyEditorRelationship{
+		topologyEditorExecuteRequest: topologyEditorExecuteRequest{
+			OperationType: a4cRelationshipOperationsPackage + "DeleteRelationshipOperation",
+		},
+		NodeName:         nodeName,
+		RelationshipName: relationshipName,
+	}
+	if a4cCtx != nil && a4cCtx.PreviousOperationID != "" {
+		req.topologyEditorExecuteRequest.PreviousOperationID = &a4cCtx.PreviousOperationID
+	}
+	err := t.editTopology(ctx, a4cCtx, req)
+	return errors.Wrapf(err, "Unable to delete relationship %q of node %q", relationshipName, nodeName)
+}
+
+// UpdateRelationshipProperty updates the value of a property of a relationship of a node of the topology.
+//
+// A *PropertyUpdateError is returned if Alien4Cloud rejects the value.
+func (t *topologyService) UpdateRelationshipProperty(ctx context.Context, a4cCtx *TopologyEditorContext, nodeName, relationshipName, propertyName, propertyValue string) error {
+	req := topologyEditorRelationshipProperty{
+		topologyEditorRelationship: topologyEditorRelationship{
+			topologyEditorExecuteRequest: topologyEditorExecuteRequest{
+				OperationType: a4cRelationshipOperationsPackage + "UpdateRelationshipPropertyValueOperation",
+			},
+			NodeName:         nodeName,
+			RelationshipName: relationshipName,
+		},
+		PropertyName:  propertyName,
+		PropertyValue: propertyValue,
+	}
+	if a4cCtx != nil && a4cCtx.PreviousOperationID != "" {
+		req.topologyEditorExecuteRequest.PreviousOperationID = &a4cCtx.PreviousOperationID
+	}
+	err := t.editTopology(ctx, a4cCtx, req)
+	return errors.Wrapf(err, "Unable to update property %q of relationship %q of node %q", propertyName, relationshipName, nodeName)
+}
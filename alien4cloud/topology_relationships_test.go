@@ -0,0 +1,72 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_topologyService_Relationships(t *testing.T) {
+	var editorRequests []map[string]interface{}
+	ts := newHTTPServerTestEditor(t, &editorRequests)
+	defer ts.Close()
+
+	tServ := &topologyService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	a4cCtx := &TopologyEditorContext{AppID: "app", EnvID: "env"}
+	ctx := context.Background()
+
+	assert.NilError(t, tServ.UpdateRelationshipProperty(ctx, a4cCtx, "Web", "WebConnectsToDB", "timeout", "30"))
+	assert.NilError(t, tServ.DeleteRelationship(ctx, a4cCtx, "Web", "WebConnectsToDB"))
+
+	assert.DeepEqual(t, editorRequests, []map[string]interface{}{
+		{"type": a4cRelationshipOperationsPackage + "UpdateRelationshipPropertyValueOperation", "previousOperationId": nil,
+			"nodeName": "Web", "relationshipName": "WebConnectsToDB", "propertyName": "timeout", "propertyValue": "30"},
+		{"type": a4cRelationshipOperationsPackage + "DeleteRelationshipOperation", "previousOperationId": "opID",
+			"nodeName": "Web", "relationshipName": "WebConnectsToDB"},
+	})
+
+	err := tServ.DeleteRelationship(ctx, &TopologyEditorContext{AppID: "notfound", EnvID: "env"}, "Web", "WebConnectsToDB")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func Test_topologyService_UpdateRelationshipPropertyError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"data":{"name":"greaterOrEqual","reference":"0","value":"-1"},"error":{"code":804,"message":"Property constraint violated"}}`))
+	}))
+	defer ts.Close()
+
+	tServ := &topologyService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	err := tServ.UpdateRelationshipProperty(context.Background(), &TopologyEditorContext{TopologyID: "tid"}, "Web", "WebConnectsToDB", "timeout", "-1")
+	var propErr *PropertyUpdateError
+	assert.Assert(t, errors.As(err, &propErr), "unexpected error %v", err)
+	assert.DeepEqual(t, propErr, &PropertyUpdateError{
+		Code:             804,
+		Message:          "Property constraint violated",
+		NodeName:         "Web",
+		RelationshipName: "WebConnectsToDB",
+		PropertyPath:     "timeout",
+		Constraint:       "greaterOrEqual",
+		Reference:        "0",
+		Value:            "-1",
+	})
+	assert.ErrorContains(t, err, `relationship "WebConnectsToDB"`)
+}
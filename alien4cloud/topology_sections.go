@@ -0,0 +1,132 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// TopologySections is a set of sections of a topology, sections could be combined using a bitwise OR
+type TopologySections uint
+
+const (
+	// TopologyNodeTemplates selects node templates of a topology
+	TopologyNodeTemplates TopologySections = 1 << iota
+	// TopologyInputs selects inputs definitions, deployer input properties and input artifacts of a topology
+	TopologyInputs
+	// TopologyWorkflows selects workflows of a topology
+	TopologyWorkflows
+	// TopologyTypes selects node, relationship and capability types used by a topology
+	TopologyTypes
+
+	// TopologyAllSections selects all sections of a topology
+	TopologyAllSections = TopologyNodeTemplates | TopologyInputs | TopologyWorkflows | TopologyTypes
+)
+
+// GetTopologyByIDWithSections returns only the given sections of the topology with the given TopologyID.
+//
+// Alien4Cloud does not support projections so the whole topology is still retrieved, but only the given
+// sections are decoded which saves time and memory on large topologies.
+func (t *topologyService) GetTopologyByIDWithSections(ctx context.Context, a4cTopologyID string, sections TopologySections) (*Topology, error) {
+	request, err := t.client.NewRequest(ctx,
+		"GET",
+		fmt.Sprintf("%s/topologies/%s", a4CRestAPIPrefix, a4cTopologyID),
+		nil,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot get the topology content for topologyID '%s'", a4cTopologyID)
+	}
+
+	response, err := t.client.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot get the topology content for topologyID '%s'", a4cTopologyID)
+	}
+
+	res := new(Topology)
+	if sections&TopologyAllSections == TopologyAllSections {
+		err = ReadA4CResponse(response, res)
+		return res, errors.Wrapf(err, "Cannot get the topology content for topologyID '%s'", a4cTopologyID)
+	}
+
+	// Sections are kept raw and only selected ones are decoded
+	var raw struct {
+		Data struct {
+			NodeTypes          json.RawMessage            `json:"nodeTypes"`
+			RelationshipTypes  json.RawMessage            `json:"relationshipTypes"`
+			CapabilityTypes    json.RawMessage            `json:"capabilityTypes"`
+			Topology           map[string]json.RawMessage `json:"topology"`
+			LastOperationIndex int                        `json:"lastOperationIndex"`
+			Operations         []TopologyEditorOperation  `json:"operations"`
+		} `json:"data"`
+	}
+	err = ReadA4CResponse(response, &raw)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot get the topology content for topologyID '%s'", a4cTopologyID)
+	}
+
+	res.Data.LastOperationIndex = raw.Data.LastOperationIndex
+	res.Data.Operations = raw.Data.Operations
+	topology := &res.Data.Topology
+	fields := map[string]interface{}{
+		"archiveName":    &topology.ArchiveName,
+		"archiveVersion": &topology.ArchiveVersion,
+		"description":    &topology.Description,
+	}
+	if sections&TopologyNodeTemplates != 0 {
+		fields["nodeTemplates"] = &topology.NodeTemplates
+	}
+	if sections&TopologyInputs != 0 {
+		fields["inputs"] = &topology.Inputs
+		fields["inputArtifacts"] = &topology.InputArtifacts
+		fields["deployerInputProperties"] = &topology.DeployerInputProperties
+		fields["uploadedinputArtifacts"] = &topology.UploadedInputArtifacts
+	}
+	if sections&TopologyWorkflows != 0 {
+		fields["workflows"] = &topology.Workflows
+	}
+	for name, field := range fields {
+		if err = decodeRawSection(raw.Data.Topology[name], field); err != nil {
+			return nil, errors.Wrapf(err, "Cannot decode %s of topology '%s'", name, a4cTopologyID)
+		}
+	}
+
+	if sections&TopologyTypes != 0 {
+		for _, section := range []struct {
+			raw   json.RawMessage
+			field interface{}
+		}{
+			{raw.Data.NodeTypes, &res.Data.NodeTypes},
+			{raw.Data.RelationshipTypes, &res.Data.RelationshipTypes},
+			{raw.Data.CapabilityTypes, &res.Data.CapabilityTypes},
+		} {
+			if err = decodeRawSection(section.raw, section.field); err != nil {
+				return nil, errors.Wrapf(err, "Cannot decode types of topology '%s'", a4cTopologyID)
+			}
+		}
+	}
+	return res, nil
+}
+
+// decodeRawSection decodes a raw JSON section into v, a missing section is ignored
+func decodeRawSection(raw json.RawMessage, v interface{}) error {
+	if len(raw) == 0 {
+		return nil
+	}
+	return json.Unmarshal(raw, v)
+}
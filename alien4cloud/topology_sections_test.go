@@ -0,0 +1,75 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_topologyService_GetTopologyByIDWithSections(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !regexp.MustCompile(`.*/topologies/app:0.1.0-SNAPSHOT$`).Match([]byte(r.URL.Path)) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code": 404,"message":"not found"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{
+			"nodeTypes":{"tosca.nodes.Compute":{"elementId":"tosca.nodes.Compute"}},
+			"topology":{
+				"archiveName":"app","archiveVersion":"0.1.0-SNAPSHOT","description":"My app",
+				"nodeTemplates":{"Compute":{"name":"Compute","type":"tosca.nodes.Compute"}},
+				"inputs":{"size":{"type":"integer"}},
+				"deployerInputProperties":{"size":{"value":3}},
+				"workflows":{"install":{"name":"install"}},
+				"unknownSection":{"ignored":true}
+			}}}`))
+	}))
+	defer ts.Close()
+
+	topologyService := &topologyService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	ctx := context.Background()
+
+	topology, err := topologyService.GetTopologyByIDWithSections(ctx, "app:0.1.0-SNAPSHOT", TopologyInputs)
+	assert.NilError(t, err)
+	assert.Equal(t, topology.Data.Topology.ArchiveName, "app")
+	assert.Equal(t, topology.Data.Topology.ArchiveVersion, "0.1.0-SNAPSHOT")
+	assert.Equal(t, topology.Data.Topology.Description, "My app")
+	assert.DeepEqual(t, topology.Data.Topology.Inputs, map[string]PropertyDefinition{"size": {Type: "integer"}})
+	assert.DeepEqual(t, topology.Data.Topology.DeployerInputProperties, map[string]PropertyValue{"size": {Value: float64(3)}})
+	assert.Assert(t, topology.Data.Topology.NodeTemplates == nil)
+	assert.Assert(t, topology.Data.Topology.Workflows == nil)
+	assert.Assert(t, topology.Data.NodeTypes == nil)
+
+	topology, err = topologyService.GetTopologyByIDWithSections(ctx, "app:0.1.0-SNAPSHOT", TopologyNodeTemplates|TopologyTypes)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, topology.Data.Topology.NodeTemplates, map[string]NodeTemplate{"Compute": {Name: "Compute", Type: "tosca.nodes.Compute"}})
+	assert.Equal(t, topology.Data.NodeTypes["tosca.nodes.Compute"].ElementID, "tosca.nodes.Compute")
+	assert.Assert(t, topology.Data.Topology.Inputs == nil)
+	assert.Assert(t, topology.Data.Topology.Workflows == nil)
+
+	topology, err = topologyService.GetTopologyByID(ctx, "app:0.1.0-SNAPSHOT")
+	assert.NilError(t, err)
+	assert.Equal(t, len(topology.Data.Topology.Workflows), 1)
+	assert.Equal(t, len(topology.Data.Topology.NodeTemplates), 1)
+
+	_, err = topologyService.GetTopologyByIDWithSections(ctx, "unknown", TopologyWorkflows)
+	assert.ErrorContains(t, err, "not found")
+}
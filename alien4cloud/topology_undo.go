@@ -0,0 +1,90 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// UndoRedo moves the topology editor to the state following the operation at the given index of the
+// pending operations of the edit session, operations after this index are undone and operations up to this
+// index which were previously undone are redone. An index of -1 undoes all pending operations.
+//
+// The editor context PreviousOperationID is updated accordingly. Operations are only applied to the topology
+// once saved using SaveA4CTopology.
+func (t *topologyService) UndoRedo(ctx context.Context, a4cCtx *TopologyEditorContext, at int) error {
+	err := t.executeEditorAction(ctx, a4cCtx, "undo", url.Values{"at": []string{strconv.Itoa(at)}})
+	return errors.Wrapf(err, "Unable to move the topology editor to operation %d", at)
+}
+
+// Recover recovers an edit session of the topology whose pending operations can't be applied anymore,
+// typically because an archive used by the topology changed in the meantime, so that the topology can be edited again.
+//
+// The editor context PreviousOperationID is updated accordingly.
+func (t *topologyService) Recover(ctx context.Context, a4cCtx *TopologyEditorContext) error {
+	err := t.executeEditorAction(ctx, a4cCtx, "recover", nil)
+	return errors.Wrap(err, "Unable to recover the topology edit session")
+}
+
+// executeEditorAction posts a request on the given action endpoint of the topology editor and synchronizes
+// the editor context on the returned topology state
+func (t *topologyService) executeEditorAction(ctx context.Context, a4cCtx *TopologyEditorContext, action string, query url.Values) error {
+	if a4cCtx == nil {
+		return errors.New("Context object must be defined")
+	}
+
+	if a4cCtx.TopologyID == "" {
+		var err error
+		a4cCtx.TopologyID, err = t.GetTopologyID(ctx, a4cCtx.AppID, a4cCtx.EnvID)
+		if err != nil {
+			return errors.Wrapf(err, "Unable to get A4C application topology for app %s and env %s", a4cCtx.AppID, a4cCtx.EnvID)
+		}
+	}
+
+	if query == nil {
+		query = url.Values{}
+	}
+	if a4cCtx.PreviousOperationID != "" {
+		query.Set("lastOperationId", a4cCtx.PreviousOperationID)
+	}
+	urlStr := fmt.Sprintf("%s/editor/%s/%s", a4CRestAPIPrefix, a4cCtx.TopologyID, action)
+	if len(query) > 0 {
+		urlStr += "?" + query.Encode()
+	}
+	request, err := t.client.NewRequest(ctx, "POST", urlStr, nil)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to create the request to %s the A4C topology edit session", action)
+	}
+
+	response, err := t.client.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to send the request to %s the A4C topology edit session", action)
+	}
+	if err = checkConcurrentEdit(response, a4cCtx.TopologyID, a4cCtx.PreviousOperationID); err != nil {
+		return err
+	}
+	var res Topology
+	err = ReadA4CResponse(response, &res)
+	if err != nil {
+		return err
+	}
+	a4cCtx.PreviousOperationID = res.LastOperationID()
+	return nil
+}
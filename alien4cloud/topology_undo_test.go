@@ -0,0 +1,75 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_topologyService_UndoRedoAndRecover(t *testing.T) {
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "POST")
+		requests = append(requests, r.URL.RequestURI())
+		switch r.URL.Path {
+		case "/rest/latest/editor/tid/undo":
+			switch r.URL.Query().Get("at") {
+			case "0":
+				_, _ = w.Write([]byte(`{"data":{"lastOperationIndex":0,"operations":[{"id":"op1"},{"id":"op2"}]}}`))
+			case "-1":
+				_, _ = w.Write([]byte(`{"data":{"lastOperationIndex":-1,"operations":[{"id":"op1"},{"id":"op2"}]}}`))
+			default:
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"error":{"code":860,"message":"topology modified"}}`))
+			}
+		case "/rest/latest/editor/tid/recover":
+			_, _ = w.Write([]byte(`{"data":{"lastOperationIndex":0,"operations":[{"id":"op3"}]}}`))
+		default:
+			t.Errorf("Unexpected call for request %+v", r)
+		}
+	}))
+	defer ts.Close()
+
+	tServ := &topologyService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	a4cCtx := &TopologyEditorContext{AppID: "app", EnvID: "env", TopologyID: "tid", PreviousOperationID: "op2"}
+	ctx := context.Background()
+
+	assert.NilError(t, tServ.UndoRedo(ctx, a4cCtx, 0))
+	assert.Equal(t, a4cCtx.PreviousOperationID, "op1")
+	assert.NilError(t, tServ.UndoRedo(ctx, a4cCtx, -1))
+	assert.Equal(t, a4cCtx.PreviousOperationID, "")
+	assert.NilError(t, tServ.Recover(ctx, a4cCtx))
+	assert.Equal(t, a4cCtx.PreviousOperationID, "op3")
+
+	err := tServ.UndoRedo(ctx, a4cCtx, 5)
+	assert.Assert(t, errors.Is(err, ErrConcurrentEdit), "unexpected error %v", err)
+	assert.Equal(t, a4cCtx.PreviousOperationID, "op3")
+
+	assert.DeepEqual(t, requests, []string{
+		"/rest/latest/editor/tid/undo?at=0&lastOperationId=op2",
+		"/rest/latest/editor/tid/undo?at=-1&lastOperationId=op1",
+		"/rest/latest/editor/tid/recover",
+		"/rest/latest/editor/tid/undo?at=5&lastOperationId=op3",
+	})
+
+	err = tServ.Recover(ctx, nil)
+	assert.ErrorContains(t, err, "Context object must be defined")
+}
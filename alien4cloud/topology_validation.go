@@ -0,0 +1,51 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// ValidateTopology validates the topology with the given TopologyID.
+//
+// The returned result is not valid if some tasks have to be completed before the topology could be deployed,
+// like missing required properties or unsatisfied requirements. Those tasks are listed in the result TaskList.
+func (t *topologyService) ValidateTopology(ctx context.Context, a4cTopologyID string) (*TopologyValidationResult, error) {
+	request, err := t.client.NewRequest(ctx,
+		"GET",
+		fmt.Sprintf("%s/topologies/%s/isvalid", a4CRestAPIPrefix, a4cTopologyID),
+		nil,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot create a request in order to validate topology '%s'", a4cTopologyID)
+	}
+
+	response, err := t.client.Do(request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot send a request in order to validate topology '%s'", a4cTopologyID)
+	}
+
+	var res struct {
+		Data TopologyValidationResult `json:"data"`
+	}
+	err = ReadA4CResponse(response, &res)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot validate topology '%s'", a4cTopologyID)
+	}
+	return &res.Data, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_topologyService_ValidateTopology(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, "GET")
+		switch r.URL.Path {
+		case "/rest/latest/topologies/valid/isvalid":
+			_, _ = w.Write([]byte(`{"data":{"valid":true}}`))
+		case "/rest/latest/topologies/invalid/isvalid":
+			_, _ = w.Write([]byte(`{"data":{"valid":false,"taskList":[{"code":"PROPERTIES","nodeTemplateName":"Compute"},{"code":"SATISFY_LOWER_BOUND","nodeTemplateName":"DB"}],"warningList":[{"code":"ABSTRACT","nodeTemplateName":"Web"}]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":504,"message":"topology not found"}}`))
+		}
+	}))
+	defer ts.Close()
+
+	tServ := &topologyService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	ctx := context.Background()
+
+	res, err := tServ.ValidateTopology(ctx, "valid")
+	assert.NilError(t, err)
+	assert.Assert(t, res.Valid)
+	assert.Equal(t, len(res.TaskList), 0)
+
+	res, err = tServ.ValidateTopology(ctx, "invalid")
+	assert.NilError(t, err)
+	assert.Assert(t, !res.Valid)
+	assert.DeepEqual(t, res.TaskList, []TopologyTask{{Code: "PROPERTIES", NodeTemplateName: "Compute"}, {Code: "SATISFY_LOWER_BOUND", NodeTemplateName: "DB"}})
+	assert.DeepEqual(t, res.WarningList, []TopologyTask{{Code: "ABSTRACT", NodeTemplateName: "Web"}})
+
+	_, err = tServ.ValidateTopology(ctx, "unknown")
+	assert.ErrorContains(t, err, "Cannot validate topology 'unknown'")
+}
@@ -3,25 +3,26 @@ package alien4cloud
 import (
 	"context"
 
+	"github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/types"
 	"github.com/pkg/errors"
 )
 
 const (
 	// CallOperationWorkflowActivityType is the type of a call operation activity
-	CallOperationWorkflowActivityType = "org.alien4cloud.tosca.model.workflow.activities.CallOperationWorkflowActivity"
+	CallOperationWorkflowActivityType = types.CallOperationWorkflowActivityType
 	// InlineWorkflowActivityType is the type of an inline workflow activity
-	InlineWorkflowActivityType = "org.alien4cloud.tosca.model.workflow.activities.InlineWorkflowActivity"
+	InlineWorkflowActivityType = types.InlineWorkflowActivityType
 	// SetStateWorkflowActivityType is the type of an activity setting the state of a component
-	SetStateWorkflowActivityType = "org.alien4cloud.tosca.model.workflow.activities.SetStateWorkflowActivity"
+	SetStateWorkflowActivityType = types.SetStateWorkflowActivityType
 	// DelegateWorkflowActivity is the type of an activity delegated to an orchestrator
-	DelegateWorkflowActivity = "org.alien4cloud.tosca.model.workflow.activities.DelegateWorkflowActivity"
+	DelegateWorkflowActivity = types.DelegateWorkflowActivity
 
 	// StepStarted is the status of a workflow step that is started (currently running, not yet completed)
-	StepStarted = "STARTED"
+	StepStarted = types.StepStarted
 	// StepCompletedSuccessfull is the status of a workflow step that has completed successfully
-	StepCompletedSuccessfull = "COMPLETED_SUCCESSFULL"
+	StepCompletedSuccessfull = types.StepCompletedSuccessfull
 	// StepCompletedSuccessfull is the status of a workflow step that has failed
-	StepCompletedWithError = "COMPLETED_WITH_ERROR"
+	StepCompletedWithError = types.StepCompletedWithError
 )
 
 // WorkflowActivity is a workflow activity payload.
@@ -30,6 +31,8 @@ const (
 // - Inline workflows activities (Inline should be set)
 // - Operation Call activities (InterfaceName and OperationName should be set)
 // - Set state activities (StateName should be set)
+//
+// Operation Call activities may also define inputs of the operation.
 type WorkflowActivity struct {
 	activitytype       string
 	target             string
@@ -42,6 +45,7 @@ type WorkflowActivity struct {
 	// For Operation Call activity
 	interfaceName string
 	operationName string
+	inputs        map[string]PropertyValue
 	// For Set State activity
 	stateName string
 }
@@ -70,6 +74,27 @@ func (wa *WorkflowActivity) OperationCall(target, targetRelationship, interfaceN
 	return wa
 }
 
+// WithInput allows to assign a scalar value to an input of the operation called by an operation call activity
+func (wa *WorkflowActivity) WithInput(inputName string, value interface{}) *WorkflowActivity {
+	return wa.setInput(inputName, PropertyValue{Value: value})
+}
+
+// WithInputFunction allows to assign the result of a function such as FunctionGetInput or FunctionGetAttribute
+// to an input of the operation called by an operation call activity.
+//
+// For instance WithInputFunction("port", FunctionGetAttribute, "SELF", "port") assigns the port attribute of the target node.
+func (wa *WorkflowActivity) WithInputFunction(inputName, function string, parameters ...interface{}) *WorkflowActivity {
+	return wa.setInput(inputName, PropertyValue{Function: function, Parameters: parameters})
+}
+
+func (wa *WorkflowActivity) setInput(inputName string, value PropertyValue) *WorkflowActivity {
+	if wa.inputs == nil {
+		wa.inputs = make(map[string]PropertyValue)
+	}
+	wa.inputs[inputName] = value
+	return wa
+}
+
 // InlineWorkflow allows to configure the workflow activity to be an inline workflow activity
 func (wa *WorkflowActivity) InlineWorkflow(inlineWorkflow string) *WorkflowActivity {
 	wa.activitytype = InlineWorkflowActivityType
@@ -96,8 +121,9 @@ type workflowActivityReq struct {
 	// For Inline Workflow activity
 	Inline string `json:"inline,omitempty"`
 	// For Operation Call activity
-	InterfaceName string `json:"interfaceName,omitempty"`
-	OperationName string `json:"operationName,omitempty"`
+	InterfaceName string                   `json:"interfaceName,omitempty"`
+	OperationName string                   `json:"operationName,omitempty"`
+	Inputs        map[string]PropertyValue `json:"inputs,omitempty"`
 	// For Set State activity
 	StateName string `json:"stateName,omitempty"`
 }
@@ -144,6 +170,10 @@ func (t *topologyService) AddWorkflowActivity(ctx context.Context, a4cCtx *Topol
 		req.Before = &activity.before
 	}
 
+	if len(activity.inputs) > 0 && activity.activitytype != CallOperationWorkflowActivityType {
+		return errors.Errorf("Inputs are only supported on operation call activities, not on %s", activity.activitytype)
+	}
+
 	switch activity.activitytype {
 	case SetStateWorkflowActivityType:
 		req.Activity.StateName = activity.stateName
@@ -152,6 +182,7 @@ func (t *topologyService) AddWorkflowActivity(ctx context.Context, a4cCtx *Topol
 	case CallOperationWorkflowActivityType:
 		req.Activity.InterfaceName = activity.interfaceName
 		req.Activity.OperationName = activity.operationName
+		req.Activity.Inputs = activity.inputs
 	default:
 		return errors.Errorf("Unenexpected activity type %s", activity.activitytype)
 	}
@@ -199,3 +230,80 @@ func (t *topologyService) createOrDeleteWorkflow(ctx context.Context, a4cCtx *To
 	return nil
 
 }
+
+// RenameWorkflow renames a workflow of the given topology
+func (t *topologyService) RenameWorkflow(ctx context.Context, a4cCtx *TopologyEditorContext, workflowName, newName string) error {
+	err := t.editWorkflowName(ctx, a4cCtx, "org.alien4cloud.tosca.editor.operations.workflow.RenameWorkflowOperation", workflowName, newName)
+	return errors.Wrapf(err, "Unable to rename workflow %q to %q", workflowName, newName)
+}
+
+// DuplicateWorkflow creates a copy of a workflow of the given topology under a new name.
+//
+// All steps of the original workflow are copied, the copy may then be edited independently.
+func (t *topologyService) DuplicateWorkflow(ctx context.Context, a4cCtx *TopologyEditorContext, workflowName, newName string) error {
+	err := t.editWorkflowName(ctx, a4cCtx, "org.alien4cloud.tosca.editor.operations.workflow.CopyWorkflowOperation", workflowName, newName)
+	return errors.Wrapf(err, "Unable to duplicate workflow %q as %q", workflowName, newName)
+}
+
+func (t *topologyService) editWorkflowName(ctx context.Context, a4cCtx *TopologyEditorContext, operationName, workflowName, newName string) error {
+	if a4cCtx == nil {
+		return errors.New("Context object must be defined")
+	}
+	req := topologyEditorWorkflowName{
+		topologyEditorExecuteRequest: topologyEditorExecuteRequest{
+			OperationType: operationName,
+		},
+		WorkflowName: workflowName,
+		NewName:      newName,
+	}
+	if a4cCtx.PreviousOperationID != "" {
+		req.topologyEditorExecuteRequest.PreviousOperationID = &a4cCtx.PreviousOperationID
+	}
+	err := t.editTopology(ctx, a4cCtx, req)
+	return errors.Wrapf(err, "Unable to edit the topology of application %q and environment %q", a4cCtx.AppID, a4cCtx.EnvID)
+}
+
+const a4cWorkflowOperationsPackage = "org.alien4cloud.tosca.editor.operations.workflow."
+
+// AddWorkflowEdge connects two steps of a workflow, the step toStepID is executed after the step fromStepID
+func (t *topologyService) AddWorkflowEdge(ctx context.Context, a4cCtx *TopologyEditorContext, workflowName, fromStepID, toStepID string) error {
+	err := t.editWorkflowStep(ctx, a4cCtx, "ConnectStepToOperation",
+		topologyEditorWorkflowStep{WorkflowName: workflowName, FromStepID: fromStepID, ToStepIDs: []string{toStepID}})
+	return errors.Wrapf(err, "Unable to connect step %q to step %q in workflow %q", fromStepID, toStepID, workflowName)
+}
+
+// RemoveWorkflowEdge removes the connection between two steps of a workflow
+func (t *topologyService) RemoveWorkflowEdge(ctx context.Context, a4cCtx *TopologyEditorContext, workflowName, fromStepID, toStepID string) error {
+	err := t.editWorkflowStep(ctx, a4cCtx, "RemoveEdgeOperation",
+		topologyEditorWorkflowStep{WorkflowName: workflowName, FromStepID: fromStepID, ToStepID: toStepID})
+	return errors.Wrapf(err, "Unable to remove connection from step %q to step %q in workflow %q", fromStepID, toStepID, workflowName)
+}
+
+// RemoveWorkflowStep removes a step of a workflow, preceding and following steps are connected together
+func (t *topologyService) RemoveWorkflowStep(ctx context.Context, a4cCtx *TopologyEditorContext, workflowName, stepID string) error {
+	err := t.editWorkflowStep(ctx, a4cCtx, "RemoveStepOperation", topologyEditorWorkflowStep{WorkflowName: workflowName, StepID: stepID})
+	return errors.Wrapf(err, "Unable to remove step %q from workflow %q", stepID, workflowName)
+}
+
+// RenameWorkflowStep renames a step of a workflow
+func (t *topologyService) RenameWorkflowStep(ctx context.Context, a4cCtx *TopologyEditorContext, workflowName, stepID, newName string) error {
+	err := t.editWorkflowStep(ctx, a4cCtx, "RenameStepOperation",
+		topologyEditorWorkflowStep{WorkflowName: workflowName, StepID: stepID, NewName: newName})
+	return errors.Wrapf(err, "Unable to rename step %q to %q in workflow %q", stepID, newName, workflowName)
+}
+
+// SwapWorkflowSteps swaps the positions of two steps of a workflow
+func (t *topologyService) SwapWorkflowSteps(ctx context.Context, a4cCtx *TopologyEditorContext, workflowName, stepID, targetStepID string) error {
+	err := t.editWorkflowStep(ctx, a4cCtx, "SwapStepOperation",
+		topologyEditorWorkflowStep{WorkflowName: workflowName, StepID: stepID, TargetID: targetStepID})
+	return errors.Wrapf(err, "Unable to swap steps %q and %q in workflow %q", stepID, targetStepID, workflowName)
+}
+
+// editWorkflowStep executes the given workflow steps editor operation
+func (t *topologyService) editWorkflowStep(ctx context.Context, a4cCtx *TopologyEditorContext, operation string, req topologyEditorWorkflowStep) error {
+	req.OperationType = a4cWorkflowOperationsPackage + operation
+	if a4cCtx != nil && a4cCtx.PreviousOperationID != "" {
+		req.topologyEditorExecuteRequest.PreviousOperationID = &a4cCtx.PreviousOperationID
+	}
+	return t.editTopology(ctx, a4cCtx, req)
+}
@@ -8,6 +8,8 @@ import (
 	"net/http/httptest"
 	"regexp"
 	"testing"
+
+	"gotest.tools/v3/assert"
 )
 
 func Test_topologyService_AddWorkflowActivity(t *testing.T) {
@@ -47,6 +49,11 @@ func Test_topologyService_AddWorkflowActivity(t *testing.T) {
 				if awaReq.Activity.OperationName == "" {
 					t.Error("Missing inline operation name")
 				}
+				for name, input := range awaReq.Activity.Inputs {
+					if input.Value == nil && (input.Function == "" || len(input.Parameters) == 0) {
+						t.Errorf("Missing value of input %q", name)
+					}
+				}
 				if awaReq.Target == "" {
 					t.Error("Missing target name")
 				}
@@ -122,6 +129,15 @@ func Test_topologyService_AddWorkflowActivity(t *testing.T) {
 		{"AddCallOp", args{context.Background(),
 			&TopologyEditorContext{AppID: "test", EnvID: "test", TopologyID: "tid"}, "wf",
 			newWfActivity().OperationCall("mynode", "rel", "ifce", "opName").InsertBefore("myotherStep")}, false},
+		{"AddCallOpWithInputs", args{context.Background(),
+			&TopologyEditorContext{AppID: "test", EnvID: "test", TopologyID: "tid"}, "wf",
+			newWfActivity().OperationCall("mynode", "", "ifce", "opName").
+				WithInput("count", 3).
+				WithInputFunction("user", FunctionGetInput, "user").
+				WithInputFunction("port", FunctionGetAttribute, "SELF", "port")}, false},
+		{"AddSetStateWithInputs", args{context.Background(),
+			&TopologyEditorContext{AppID: "test", EnvID: "test", TopologyID: "tid"}, "wf",
+			newWfActivity().SetState("mynode", "myState").WithInput("count", 3)}, true},
 		{"AddWrongActivity", args{context.Background(),
 			&TopologyEditorContext{AppID: "test", EnvID: "test", TopologyID: "tid"}, "wf",
 			wrongActivity.InsertBefore("myotherStep")}, true},
@@ -233,3 +249,88 @@ func Test_topologyService_CreateAndDeleteWorkflow(t *testing.T) {
 		})
 	}
 }
+
+func Test_topologyService_RenameAndDuplicateWorkflow(t *testing.T) {
+	var requests []topologyEditorWorkflowName
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !regexp.MustCompile(`.*/editor/tid/execute`).Match([]byte(r.URL.Path)) {
+			t.Errorf("Unexpected call for request %+v", r)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var req topologyEditorWorkflowName
+		err := json.NewDecoder(r.Body).Decode(&req)
+		assert.NilError(t, err)
+		requests = append(requests, req)
+		_, _ = w.Write([]byte(`{"data":{"lastOperationIndex":0,"operations":[{"id":"op1"}]}}`))
+	}))
+	defer ts.Close()
+
+	tSrv := &topologyService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	a4cCtx := &TopologyEditorContext{AppID: "app", EnvID: "env", TopologyID: "tid"}
+	ctx := context.Background()
+
+	err := tSrv.DuplicateWorkflow(ctx, a4cCtx, "run_tests", "run_tests_staging")
+	assert.NilError(t, err)
+	err = tSrv.RenameWorkflow(ctx, a4cCtx, "run_tests", "run_tests_prod")
+	assert.NilError(t, err)
+
+	assert.Equal(t, len(requests), 2)
+	assert.Equal(t, requests[0].OperationType, "org.alien4cloud.tosca.editor.operations.workflow.CopyWorkflowOperation")
+	assert.Equal(t, requests[0].WorkflowName, "run_tests")
+	assert.Equal(t, requests[0].NewName, "run_tests_staging")
+	assert.Equal(t, requests[1].OperationType, "org.alien4cloud.tosca.editor.operations.workflow.RenameWorkflowOperation")
+	assert.Equal(t, requests[1].NewName, "run_tests_prod")
+	assert.Equal(t, requests[1].getPreviousOperationID(), "op1")
+
+	err = tSrv.RenameWorkflow(ctx, nil, "run_tests", "other")
+	assert.ErrorContains(t, err, "Context object must be defined")
+}
+
+func Test_workflowActivityReqInputs(t *testing.T) {
+	activity := (&WorkflowActivity{}).OperationCall("mynode", "", "ifce", "opName").
+		WithInput("count", 3).
+		WithInputFunction("port", FunctionGetAttribute, "SELF", "port")
+	b, err := json.Marshal(workflowActivityReq{
+		Type:          activity.activitytype,
+		InterfaceName: activity.interfaceName,
+		OperationName: activity.operationName,
+		Inputs:        activity.inputs,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, string(b), `{"type":"`+CallOperationWorkflowActivityType+`","interfaceName":"ifce","operationName":"opName",`+
+		`"inputs":{"count":{"value":3},"port":{"function":"get_attribute","parameters":["SELF","port"]}}}`)
+}
+
+func Test_topologyService_WorkflowSteps(t *testing.T) {
+	var editorRequests []map[string]interface{}
+	ts := newHTTPServerTestEditor(t, &editorRequests)
+	defer ts.Close()
+
+	tSrv := &topologyService{client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL}}
+	a4cCtx := &TopologyEditorContext{AppID: "app", EnvID: "env"}
+	ctx := context.Background()
+
+	assert.NilError(t, tSrv.AddWorkflowEdge(ctx, a4cCtx, "run", "configure", "start"))
+	assert.NilError(t, tSrv.RemoveWorkflowEdge(ctx, a4cCtx, "run", "create", "configure"))
+	assert.NilError(t, tSrv.RenameWorkflowStep(ctx, a4cCtx, "run", "start", "start_web"))
+	assert.NilError(t, tSrv.SwapWorkflowSteps(ctx, a4cCtx, "run", "configure", "start_web"))
+	assert.NilError(t, tSrv.RemoveWorkflowStep(ctx, a4cCtx, "run", "create"))
+	assert.Equal(t, a4cCtx.PreviousOperationID, "opID")
+
+	assert.DeepEqual(t, editorRequests, []map[string]interface{}{
+		{"type": a4cWorkflowOperationsPackage + "ConnectStepToOperation", "previousOperationId": nil, "workflowName": "run",
+			"fromStepId": "configure", "toStepIds": []interface{}{"start"}},
+		{"type": a4cWorkflowOperationsPackage + "RemoveEdgeOperation", "previousOperationId": "opID", "workflowName": "run",
+			"fromStepId": "create", "toStepId": "configure"},
+		{"type": a4cWorkflowOperationsPackage + "RenameStepOperation", "previousOperationId": "opID", "workflowName": "run",
+			"stepId": "start", "newName": "start_web"},
+		{"type": a4cWorkflowOperationsPackage + "SwapStepOperation", "previousOperationId": "opID", "workflowName": "run",
+			"stepId": "configure", "targetId": "start_web"},
+		{"type": a4cWorkflowOperationsPackage + "RemoveStepOperation", "previousOperationId": "opID", "workflowName": "run",
+			"stepId": "create"},
+	})
+
+	err := tSrv.RemoveWorkflowStep(ctx, &TopologyEditorContext{AppID: "notfound", EnvID: "env"}, "run", "create")
+	assert.ErrorContains(t, err, "not found")
+}
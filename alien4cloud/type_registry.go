@@ -0,0 +1,243 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// TypeValidationError is returned by TypeRegistry.Validate() when a value does not match its TOSCA type.
+//
+// It may be retrieved from returned errors using errors.As.
+type TypeValidationError struct {
+	// Path is the path of the invalid element within the validated value, such as "ports[0].number"
+	Path string
+	// Message describes the validation failure
+	Message string
+}
+
+func (e *TypeValidationError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// TypeRegistry caches descriptions of TOSCA types retrieved through CatalogService.GetComplexTOSCAType().
+//
+// Descriptions are cached per set of dependencies, so that forms rendering and
+// pre-deployment validation do not require a server round-trip for types already resolved.
+// A TypeRegistry is safe for concurrent use.
+type TypeRegistry struct {
+	catalog CatalogService
+
+	lock  sync.Mutex
+	types map[string]ToscaTypeDescriptor
+}
+
+// NewTypeRegistry returns a TypeRegistry resolving types using the given catalog service
+func NewTypeRegistry(catalog CatalogService) *TypeRegistry {
+	return &TypeRegistry{
+		catalog: catalog,
+		types:   make(map[string]ToscaTypeDescriptor),
+	}
+}
+
+// typeRegistryKey returns a cache key which does not depend on the order of dependencies
+func typeRegistryKey(dependencies []CSARDependency, typeName string) string {
+	deps := make([]string, len(dependencies))
+	for i, dep := range dependencies {
+		deps[i] = csarID(dep.Name, dep.Version)
+	}
+	sort.Strings(deps)
+	return strings.Join(deps, ",") + "|" + typeName
+}
+
+// Resolve returns the description of a TOSCA type resolved against the given dependencies,
+// using the cached description when available
+func (r *TypeRegistry) Resolve(ctx context.Context, dependencies []CSARDependency, typeName string) (ToscaTypeDescriptor, error) {
+	key := typeRegistryKey(dependencies, typeName)
+	r.lock.Lock()
+	desc, ok := r.types[key]
+	r.lock.Unlock()
+	if ok {
+		return desc, nil
+	}
+
+	desc, err := r.catalog.GetComplexTOSCAType(ctx, dependencies, typeName)
+	if err != nil {
+		return desc, err
+	}
+	r.lock.Lock()
+	r.types[key] = desc
+	r.lock.Unlock()
+	return desc, nil
+}
+
+// Invalidate drops all cached descriptions
+func (r *TypeRegistry) Invalidate() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.types = make(map[string]ToscaTypeDescriptor)
+}
+
+// Validate checks that value, as decoded from JSON, matches the given TOSCA type resolved
+// against the given dependencies.
+//
+// A *TypeValidationError is returned if the value does not match its type.
+// Primitive types are validated without calling the server.
+func (r *TypeRegistry) Validate(ctx context.Context, dependencies []CSARDependency, value interface{}, typeName string) error {
+	desc := ToscaTypeDescriptor{Type: typeName}
+	if !isPrimitiveTOSCAType(typeName) {
+		var err error
+		desc, err = r.Resolve(ctx, dependencies, typeName)
+		if err != nil {
+			return errors.Wrapf(err, "Unable to validate value of type %q", typeName)
+		}
+	}
+	return validateTOSCAValue(desc, value, "")
+}
+
+func isPrimitiveTOSCAType(typeName string) bool {
+	switch typeName {
+	case "string", "integer", "float", "boolean", "timestamp", "version", "list", "map":
+		return true
+	}
+	return strings.HasPrefix(typeName, "scalar-unit.")
+}
+
+func validationErrorf(path, format string, args ...interface{}) error {
+	return errors.WithStack(&TypeValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func validateTOSCAValue(desc ToscaTypeDescriptor, value interface{}, path string) error {
+	if value == nil {
+		return nil
+	}
+	switch desc.Type {
+	case "tosca":
+		// Primitive types are described by their property definition
+		if desc.Definition == nil {
+			return nil
+		}
+		return validateTOSCAValue(ToscaTypeDescriptor{Type: desc.Definition.Type}, value, path)
+	case "complex":
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return validationErrorf(path, "expecting a complex value, got %T", value)
+		}
+		for _, name := range sortedKeys(m) {
+			propDesc, ok := desc.PropertyTypes[name]
+			if !ok {
+				return validationErrorf(joinValidationPath(path, name), "unknown property")
+			}
+			if err := validateTOSCAValue(propDesc, m[name], joinValidationPath(path, name)); err != nil {
+				return err
+			}
+		}
+		for name, propDesc := range desc.PropertyTypes {
+			if _, ok := m[name]; propDesc.NotNull && (!ok || m[name] == nil) {
+				return validationErrorf(joinValidationPath(path, name), "missing required property")
+			}
+		}
+	case "array", "list":
+		l, ok := value.([]interface{})
+		if !ok {
+			return validationErrorf(path, "expecting a list, got %T", value)
+		}
+		if desc.ContentType == nil {
+			return nil
+		}
+		for i, v := range l {
+			if err := validateTOSCAValue(*desc.ContentType, v, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case "map":
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return validationErrorf(path, "expecting a map, got %T", value)
+		}
+		if desc.ContentType == nil {
+			return nil
+		}
+		for _, k := range sortedKeys(m) {
+			if err := validateTOSCAValue(*desc.ContentType, m[k], joinValidationPath(path, k)); err != nil {
+				return err
+			}
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return validationErrorf(path, "expecting a boolean, got %T", value)
+		}
+	case "integer":
+		f, ok := toFloat(value)
+		if !ok || f != math.Trunc(f) {
+			return validationErrorf(path, "expecting an integer, got %v", value)
+		}
+	case "float":
+		if _, ok := toFloat(value); !ok {
+			return validationErrorf(path, "expecting a number, got %v", value)
+		}
+	default:
+		// string, timestamp, version and scalar units are represented as strings
+		if _, ok := value.(string); !ok {
+			return validationErrorf(path, "expecting a %s, got %T", desc.Type, value)
+		}
+	}
+	return nil
+}
+
+func joinValidationPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	if n, ok := value.(json.Number); ok {
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
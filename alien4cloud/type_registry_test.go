@@ -0,0 +1,98 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alien4cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"gotest.tools/v3/assert"
+)
+
+func TestTypeRegistry(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/rest/latest/formdescriptor/complex-tosca-type")
+		calls++
+		var req complexTOSCATypeRequest
+		assert.NilError(t, json.NewDecoder(r.Body).Decode(&req))
+		if req.PropertyDefinition.Type != "org.test.Endpoint" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":504,"message":"type not found"}}`))
+			return
+		}
+		assert.Equal(t, len(req.Dependencies), 2)
+		_, _ = w.Write([]byte(`{"data":{"_type":"complex","_order":["host","ports"],"_propertyType":{
+			"host":{"_type":"tosca","_definition":{"type":"string","required":true},"_notNull":true},
+			"ports":{"_type":"array","_contentType":{"_type":"complex","_propertyType":{
+				"number":{"_type":"tosca","_definition":{"type":"integer","required":false}},
+				"secure":{"_type":"tosca","_definition":{"type":"boolean","required":false}},
+				"weight":{"_type":"tosca","_definition":{"type":"float","required":false}}}}},
+			"labels":{"_type":"map","_contentType":{"_type":"string"}}}}}`))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(ts.URL, "", "", "", false)
+	assert.NilError(t, err)
+	registry := NewTypeRegistry(client.CatalogService())
+	ctx := context.Background()
+	deps := []CSARDependency{{Name: "tosca-normative-types", Version: "1.0.0"}, {Name: "org.test", Version: "1.0.0"}}
+	reversedDeps := []CSARDependency{deps[1], deps[0]}
+
+	desc, err := registry.Resolve(ctx, deps, "org.test.Endpoint")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, desc.Order, []string{"host", "ports"})
+	assert.Equal(t, desc.PropertyTypes["ports"].ContentType.PropertyTypes["number"].Definition.Type, "integer")
+
+	var value interface{}
+	assert.NilError(t, json.Unmarshal([]byte(`{"host":"localhost","ports":[{"number":443,"secure":true,"weight":0.5}],"labels":{"a":"b"}}`), &value))
+	assert.NilError(t, registry.Validate(ctx, reversedDeps, value, "org.test.Endpoint"))
+	assert.Equal(t, calls, 1, "descriptions should be cached per dependencies set")
+
+	invalidValues := map[string]string{
+		`{"ports":[]}`:                            "host: missing required property",
+		`{"host":"h","ports":[{"number":4.5}]}`:   "ports[0].number: expecting an integer, got 4.5",
+		`{"host":"h","ports":[{"secure":"yes"}]}`: "ports[0].secure: expecting a boolean, got string",
+		`{"host":"h","ports":[{"weight":"1"}]}`:   "ports[0].weight: expecting a number, got 1",
+		`{"host":1}`:                              "host: expecting a string, got float64",
+		`{"host":"h","other":1}`:                  "other: unknown property",
+		`{"host":"h","labels":{"a":1}}`:           "labels.a: expecting a string, got float64",
+		`["h"]`:                                   "expecting a complex value, got []interface {}",
+	}
+	for v, msg := range invalidValues {
+		assert.NilError(t, json.Unmarshal([]byte(v), &value))
+		err = registry.Validate(ctx, deps, value, "org.test.Endpoint")
+		var validationErr *TypeValidationError
+		assert.Assert(t, errors.As(err, &validationErr), "value %s", v)
+		assert.Equal(t, validationErr.Error(), msg)
+	}
+	assert.Equal(t, calls, 1)
+
+	assert.NilError(t, registry.Validate(ctx, nil, 3, "integer"))
+	assert.ErrorContains(t, registry.Validate(ctx, nil, "3", "float"), "expecting a number")
+	assert.Equal(t, calls, 1, "primitive types should be validated locally")
+
+	err = registry.Validate(ctx, deps, value, "org.test.Unknown")
+	assert.ErrorContains(t, err, `Cannot get description of TOSCA type "org.test.Unknown"`)
+
+	registry.Invalidate()
+	_, err = registry.Resolve(ctx, deps, "org.test.Endpoint")
+	assert.NilError(t, err)
+	assert.Equal(t, calls, 3)
+}
@@ -0,0 +1,152 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+const (
+	// DefaultEnvironmentName is the default name of the environment created by
+	// Alien4Cloud for an application
+	DefaultEnvironmentName = "Environment"
+	// ApplicationDeploymentInProgress a4c status
+	ApplicationDeploymentInProgress = "DEPLOYMENT_IN_PROGRESS"
+	// ApplicationDeployed a4c status
+	ApplicationDeployed = "DEPLOYED"
+	// ApplicationUndeploymentInProgress a4c status
+	ApplicationUndeploymentInProgress = "UNDEPLOYMENT_IN_PROGRESS"
+	// ApplicationUndeployed a4c status
+	ApplicationUndeployed = "UNDEPLOYED"
+	// ApplicationError a4c status
+	ApplicationError = "FAILURE"
+	// ApplicationUpdateError a4c status
+	ApplicationUpdateError = "UPDATE_FAILURE"
+	// ApplicationUpdated a4c status
+	ApplicationUpdated = "UPDATED"
+	// ApplicationUpdateInProgress a4c status
+	ApplicationUpdateInProgress = "UPDATE_IN_PROGRESS"
+
+	// WorkflowSucceeded workflow a4c status
+	WorkflowSucceeded = "SUCCEEDED"
+	// WorkflowRunning workflow a4c status
+	WorkflowRunning = "RUNNING"
+	// WorkflowFailed workflow a4c status
+	WorkflowFailed = "FAILED"
+
+	// NodeStart node a4c status
+	NodeStart = "initial"
+	// NodeSubmitting node a4c status
+	NodeSubmitting = "submitting"
+	// NodeSubmitted node  a4c status
+	NodeSubmitted = "submitted"
+	// NodePending node  a4c status
+	NodePending = "pending"
+	// NodeRunning node  a4c status
+	NodeRunning = "running"
+	// NodeExecuting node  a4c status
+	NodeExecuting = "executing"
+	// NodeExecuted node  a4c status
+	NodeExecuted = "executed"
+	// NodeEnd node  a4c status
+	NodeEnd = "end"
+	// NodeError node  a4c status
+	NodeError = "error"
+	// NodeFailed node  a4c status
+	NodeFailed = "failed"
+	// NodeStart node  a4c status
+
+	// OrchestratorConnected is the state of an enabled orchestrator connected to Alien4Cloud
+	OrchestratorConnected = "CONNECTED"
+	// OrchestratorConnecting is the state of an orchestrator being enabled
+	OrchestratorConnecting = "CONNECTING"
+	// OrchestratorDisconnected is the state of an enabled orchestrator that lost its connection to Alien4Cloud
+	OrchestratorDisconnected = "DISCONNECTED"
+	// OrchestratorDisabled is the state of a disabled orchestrator
+	OrchestratorDisabled = "DISABLED"
+
+	// InputAdded is the kind of change of an input value that is not yet set
+	InputAdded = "added"
+	// InputUpdated is the kind of change of an input value that is already set
+	InputUpdated = "updated"
+
+	// LogLevelDebug is the debug level of a log entry
+	LogLevelDebug = "debug"
+	// LogLevelInfo is the info level of a log entry
+	LogLevelInfo = "info"
+	// LogLevelWarn is the warning level of a log entry
+	LogLevelWarn = "warn"
+	// LogLevelError is the error level of a log entry
+	LogLevelError = "error"
+
+	// FunctionConcat is a function used in attribute/property values to concatenate strings
+	FunctionConcat = "concat"
+	// FunctionGetInput is a function used in attribute/property values to reference an input property
+	FunctionGetInput = "get_input"
+	// FunctionGetProperty is a function used in attribute/property values to reference a property of an entity
+	FunctionGetProperty = "get_property"
+	// FunctionGetAttribute is a function used in attribute/property values to reference an attribute of an entity
+	FunctionGetAttribute = "get_attribute"
+	// FunctionGetSecret is a function used in property values to reference a secret resolved by the orchestrator
+	FunctionGetSecret = "get_secret"
+
+	// SecretMask is the value replacing secret property values masked by Topology.MaskSecrets()
+	SecretMask = "********"
+
+	// ROLE_ADMIN is the adminstrator role
+	ROLE_ADMIN = "ADMIN"
+	// ROLE_COMPONENTS_MANAGER allows to define packages on how to install, configure, start and connect components (mapped as node types)
+	ROLE_COMPONENTS_MANAGER = "COMPONENTS_MANAGER"
+	// ROLE_ARCHITECT allows to define application templates (topologies) by reusing building blocks (node types defined by components managers)
+	ROLE_ARCHITECT = "ARCHITECT"
+	// ROLE_APPLICATIONS_MANAGER allows to define applications with it’s own topologies that can be linked to a global topology from architects and that can reuse components defined by the components managers
+	ROLE_APPLICATIONS_MANAGER = "APPLICATIONS_MANAGER"
+
+	// ApplicationRoleManager is the application role allowing to manage an application, its versions and environments
+	ApplicationRoleManager = "APPLICATION_MANAGER"
+	// ApplicationRoleDevOps is the application role allowing to edit the topologies of an application
+	ApplicationRoleDevOps = "APPLICATION_DEVOPS"
+
+	// PromotionStatusInit is the status of a workspace promotion request waiting for approval
+	PromotionStatusInit = "INIT"
+	// PromotionStatusAccepted is the status of an accepted workspace promotion request
+	PromotionStatusAccepted = "ACCEPTED"
+	// PromotionStatusRefused is the status of a refused workspace promotion request
+	PromotionStatusRefused = "REFUSED"
+
+	// CallOperationWorkflowActivityType is the type of a call operation activity
+	CallOperationWorkflowActivityType = "org.alien4cloud.tosca.model.workflow.activities.CallOperationWorkflowActivity"
+	// InlineWorkflowActivityType is the type of an inline workflow activity
+	InlineWorkflowActivityType = "org.alien4cloud.tosca.model.workflow.activities.InlineWorkflowActivity"
+	// SetStateWorkflowActivityType is the type of an activity setting the state of a component
+	SetStateWorkflowActivityType = "org.alien4cloud.tosca.model.workflow.activities.SetStateWorkflowActivity"
+	// DelegateWorkflowActivity is the type of an activity delegated to an orchestrator
+	DelegateWorkflowActivity = "org.alien4cloud.tosca.model.workflow.activities.DelegateWorkflowActivity"
+
+	// ComponentTypeNode is the component type of node types in catalog searches
+	ComponentTypeNode = "NODE_TYPE"
+	// ComponentTypeRelationship is the component type of relationship types in catalog searches
+	ComponentTypeRelationship = "RELATIONSHIP_TYPE"
+	// ComponentTypePolicy is the component type of policy types in catalog searches
+	ComponentTypePolicy = "POLICY_TYPE"
+
+	// StepStarted is the status of a workflow step that is started (currently running, not yet completed)
+	StepStarted = "STARTED"
+	// StepCompletedSuccessfull is the status of a workflow step that has completed successfully
+	StepCompletedSuccessfull = "COMPLETED_SUCCESSFULL"
+	// StepCompletedSuccessfull is the status of a workflow step that has failed
+	StepCompletedWithError = "COMPLETED_WITH_ERROR"
+
+	// StandardNodeInterface is the name of the TOSCA standard node lifecycle interface
+	StandardNodeInterface = "tosca.interfaces.node.lifecycle.Standard"
+	// ConfigureRelationshipInterface is the name of the TOSCA standard relationship configure interface
+	ConfigureRelationshipInterface = "tosca.interfaces.relationship.Configure"
+)
@@ -0,0 +1,26 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package types holds the Alien4Cloud REST API models.
+
+This package has no dependency on the HTTP client, it allows to reuse Alien4Cloud models
+in other tools without pulling the whole client:
+
+	import "github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/types"
+
+Types and constants defined in this package are also exposed as aliases in the alien4cloud
+package for backward compatibility.
+*/
+package types
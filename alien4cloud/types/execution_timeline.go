@@ -0,0 +1,172 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"sort"
+	"time"
+)
+
+// Timeline computes the timing of the steps of this workflow execution.
+//
+// Alien4Cloud does not date workflow step instances, so steps are dated using the schedule and last update
+// dates of the given tasks of the execution (see DeploymentService.GetExecutionTasks()). Steps without tasks
+// have no start or end date.
+//
+// The critical path is computed from the steps dependencies of the given workflow definition if any,
+// otherwise a step is assumed to depend on the latest step which ended before it started.
+func (we WorkflowExecution) Timeline(tasks []Task, workflow *Workflow) ExecutionTimeline {
+	timeline := ExecutionTimeline{
+		ExecutionID:  we.Execution.ID,
+		WorkflowName: we.Execution.WorkflowName,
+		Start:        we.Execution.StartDate.Time,
+		End:          we.Execution.EndDate.Time,
+	}
+	if !timeline.Start.IsZero() && !timeline.End.IsZero() {
+		timeline.Duration = timeline.End.Sub(timeline.Start)
+	}
+
+	steps := make(map[string]*StepTimeline)
+	step := func(stepID string) *StepTimeline {
+		s, ok := steps[stepID]
+		if !ok {
+			s = &StepTimeline{StepID: stepID}
+			steps[stepID] = s
+		}
+		return s
+	}
+	stepOfInstance := make(map[string]string)
+	for stepID, instances := range we.StepInstances {
+		step(stepID)
+		for _, instance := range instances {
+			stepOfInstance[instance.ID] = stepID
+		}
+	}
+	for stepID, status := range we.StepStatus {
+		step(stepID).Status = status
+	}
+
+	running := make(map[string]bool)
+	for _, task := range tasks {
+		if task.ExecutionID != "" && we.Execution.ID != "" && task.ExecutionID != we.Execution.ID {
+			continue
+		}
+		stepID, ok := stepOfInstance[task.WorkflowStepInstanceID]
+		if !ok {
+			continue
+		}
+		s := step(stepID)
+		if start := task.ScheduleDate.Time; !start.IsZero() && (s.Start.IsZero() || start.Before(s.Start)) {
+			s.Start = start
+		}
+		switch task.Status {
+		case "SCHEDULED", "STARTED":
+			running[stepID] = true
+		default:
+			if end := task.LastUpdatedDate.Time; end.After(s.End) {
+				s.End = end
+			}
+		}
+	}
+
+	for stepID, s := range steps {
+		if s.Status == StepStarted || s.Status == "" && running[stepID] {
+			s.End = time.Time{}
+		}
+		if !s.Start.IsZero() && !s.End.IsZero() {
+			s.Duration = s.End.Sub(s.Start)
+		}
+	}
+
+	timeline.CriticalPath = criticalPath(steps, workflow)
+	for _, stepID := range timeline.CriticalPath {
+		steps[stepID].Critical = true
+	}
+
+	timeline.Steps = make([]StepTimeline, 0, len(steps))
+	for _, s := range steps {
+		timeline.Steps = append(timeline.Steps, *s)
+	}
+	sort.Slice(timeline.Steps, func(i, j int) bool {
+		si, sj := timeline.Steps[i], timeline.Steps[j]
+		if si.Start.Equal(sj.Start) {
+			return si.StepID < sj.StepID
+		}
+		if si.Start.IsZero() || sj.Start.IsZero() {
+			return sj.Start.IsZero()
+		}
+		return si.Start.Before(sj.Start)
+	})
+	return timeline
+}
+
+// criticalPath returns the chain of ended steps leading to the last ended step, each step being preceded
+// by the latest ended step it depends on
+func criticalPath(steps map[string]*StepTimeline, workflow *Workflow) []string {
+	var predecessors map[string][]string
+	if workflow != nil {
+		predecessors = make(map[string][]string)
+		for stepID, s := range workflow.Steps {
+			predecessors[stepID] = append(predecessors[stepID], s.PrecedingSteps...)
+			for _, next := range s.OnSuccess {
+				predecessors[next] = append(predecessors[next], stepID)
+			}
+		}
+	}
+
+	// latest returns the ID of the ended step with the latest end date among candidates
+	latest := func(candidates []string, accept func(s *StepTimeline) bool) string {
+		sort.Strings(candidates)
+		var last *StepTimeline
+		for _, stepID := range candidates {
+			s, ok := steps[stepID]
+			if !ok || s.End.IsZero() || !accept(s) {
+				continue
+			}
+			if last == nil || s.End.After(last.End) {
+				last = s
+			}
+		}
+		if last == nil {
+			return ""
+		}
+		return last.StepID
+	}
+
+	all := make([]string, 0, len(steps))
+	for stepID := range steps {
+		all = append(all, stepID)
+	}
+	var path []string
+	visited := make(map[string]bool)
+	current := latest(all, func(s *StepTimeline) bool { return true })
+	for current != "" && !visited[current] {
+		visited[current] = true
+		path = append(path, current)
+		start := steps[current].Start
+		if predecessors != nil {
+			current = latest(predecessors[current], func(s *StepTimeline) bool { return !visited[s.StepID] })
+		} else if start.IsZero() {
+			current = ""
+		} else {
+			current = latest(all, func(s *StepTimeline) bool { return !visited[s.StepID] && !s.End.After(start) })
+		}
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
@@ -0,0 +1,77 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestWorkflowExecution_Timeline(t *testing.T) {
+	t0 := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	at := func(seconds int) Time {
+		return Time{t0.Add(time.Duration(seconds) * time.Second)}
+	}
+	we := WorkflowExecution{
+		Execution: Execution{ID: "exec", WorkflowName: "install", StartDate: at(0)},
+		StepStatus: map[string]string{
+			"create_db":  StepCompletedSuccessfull,
+			"create_web": StepCompletedSuccessfull,
+			"start_db":   StepCompletedSuccessfull,
+			"start_web":  StepStarted,
+		},
+		StepInstances: map[string][]WorkflowStepInstance{
+			"create_db":  {{ID: "i1", StepId: "create_db"}},
+			"create_web": {{ID: "i2", StepId: "create_web"}},
+			"start_db":   {{ID: "i3", StepId: "start_db"}},
+			"start_web":  {{ID: "i4", StepId: "start_web"}},
+		},
+	}
+	tasks := []Task{
+		{ID: "t1", ExecutionID: "exec", WorkflowStepInstanceID: "i1", Status: "SUCCEEDED", ScheduleDate: at(0), LastUpdatedDate: at(10)},
+		{ID: "t2", ExecutionID: "exec", WorkflowStepInstanceID: "i2", Status: "SUCCEEDED", ScheduleDate: at(0), LastUpdatedDate: at(3)},
+		{ID: "t3", ExecutionID: "exec", WorkflowStepInstanceID: "i3", Status: "SUCCEEDED", ScheduleDate: at(10), LastUpdatedDate: at(15)},
+		{ID: "t4", ExecutionID: "exec", WorkflowStepInstanceID: "i4", Status: "STARTED", ScheduleDate: at(15), LastUpdatedDate: at(16)},
+		{ID: "other", ExecutionID: "previous", WorkflowStepInstanceID: "i1", Status: "SUCCEEDED", ScheduleDate: at(-60), LastUpdatedDate: at(-50)},
+	}
+	workflow := &Workflow{Steps: map[string]WorkflowStep{
+		"create_db":  {Name: "create_db", OnSuccess: []string{"start_db"}},
+		"create_web": {Name: "create_web", OnSuccess: []string{"start_web"}},
+		"start_db":   {Name: "start_db", PrecedingSteps: []string{"create_db"}, OnSuccess: []string{"start_web"}},
+		"start_web":  {Name: "start_web", PrecedingSteps: []string{"create_web", "start_db"}},
+	}}
+
+	timeline := we.Timeline(tasks, workflow)
+	assert.Equal(t, timeline.ExecutionID, "exec")
+	assert.Equal(t, timeline.Duration, time.Duration(0), "execution is still running")
+	assert.DeepEqual(t, timeline.CriticalPath, []string{"create_db", "start_db"})
+	assert.DeepEqual(t, timeline.Steps, []StepTimeline{
+		{StepID: "create_db", Status: StepCompletedSuccessfull, Start: at(0).Time, End: at(10).Time, Duration: 10 * time.Second, Critical: true},
+		{StepID: "create_web", Status: StepCompletedSuccessfull, Start: at(0).Time, End: at(3).Time, Duration: 3 * time.Second},
+		{StepID: "start_db", Status: StepCompletedSuccessfull, Start: at(10).Time, End: at(15).Time, Duration: 5 * time.Second, Critical: true},
+		{StepID: "start_web", Status: StepStarted, Start: at(15).Time},
+	})
+
+	// Without workflow definition dependencies are inferred from dates
+	tasks[3].Status = "SUCCEEDED"
+	we.StepStatus["start_web"] = StepCompletedSuccessfull
+	we.Execution.EndDate = at(16)
+	timeline = we.Timeline(tasks, nil)
+	assert.Equal(t, timeline.Duration, 16*time.Second)
+	assert.DeepEqual(t, timeline.CriticalPath, []string{"create_db", "start_db", "start_web"})
+	assert.Equal(t, timeline.Steps[3].Duration, time.Second)
+}
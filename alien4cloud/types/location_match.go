@@ -0,0 +1,129 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// LocationMatchReasons explains why a location matching a topology is not ready for a deployment
+type LocationMatchReasons struct {
+	// OrchestratorState is the state of the orchestrator managing the location when it is not connected,
+	// one of OrchestratorDisabled, OrchestratorConnecting or OrchestratorDisconnected
+	OrchestratorState string `json:"orchestratorState,omitempty"`
+	// MissingResourceTypes are types of nodes of the topology that the location can not provide
+	MissingResourceTypes []string `json:"missingResourceTypes,omitempty"`
+	// PermissionDenied is true when the current user is not allowed to deploy on the location
+	PermissionDenied bool `json:"permissionDenied,omitempty"`
+	// Messages are the reasons returned by Alien4Cloud that could not be decoded, as is
+	Messages []string `json:"messages,omitempty"`
+}
+
+// IsEmpty returns true if no reason is known
+func (r LocationMatchReasons) IsEmpty() bool {
+	return r.OrchestratorState == "" && len(r.MissingResourceTypes) == 0 && !r.PermissionDenied && len(r.Messages) == 0
+}
+
+func (r LocationMatchReasons) String() string {
+	var reasons []string
+	if r.OrchestratorState != "" {
+		reasons = append(reasons, fmt.Sprintf("orchestrator is %s", strings.ToLower(r.OrchestratorState)))
+	}
+	if r.PermissionDenied {
+		reasons = append(reasons, "permission denied")
+	}
+	if len(r.MissingResourceTypes) > 0 {
+		reasons = append(reasons, fmt.Sprintf("missing resource types %s", strings.Join(r.MissingResourceTypes, ", ")))
+	}
+	reasons = append(reasons, r.Messages...)
+	return strings.Join(reasons, "; ")
+}
+
+// NotReadyReasons returns the reasons why this location match is not ready.
+//
+// The orchestrator state is taken from its status. Reasons returned by Alien4Cloud are decoded
+// when they have a known shape:
+//   - types the location can not provide, listed under a key such as missingTypes, unsupportedTypes or noMatchingTypes,
+//   - permission errors, either under a key such as permission or forbidden, or as a permission or authorization message.
+//
+// Other reasons are reported as is in Messages.
+func (m LocationMatch) NotReadyReasons() LocationMatchReasons {
+	var r LocationMatchReasons
+	if m.Orchestrator.State != "" && m.Orchestrator.State != OrchestratorConnected {
+		r.OrchestratorState = m.Orchestrator.State
+	}
+	r.addReasons(m.Reasons)
+	return r
+}
+
+func (r *LocationMatchReasons) addReasons(reasons interface{}) {
+	switch v := reasons.(type) {
+	case nil:
+	case string:
+		if isPermissionReason(v) {
+			r.PermissionDenied = true
+		} else if v != "" {
+			r.Messages = append(r.Messages, v)
+		}
+	case []interface{}:
+		for _, reason := range v {
+			r.addReasons(reason)
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			switch {
+			case isMissingTypesKey(k):
+				r.addMissingTypes(v[k])
+			case isPermissionReason(k):
+				if v[k] != false {
+					r.PermissionDenied = true
+				}
+			default:
+				r.Messages = append(r.Messages, fmt.Sprintf("%s: %v", k, v[k]))
+			}
+		}
+	default:
+		r.Messages = append(r.Messages, fmt.Sprint(v))
+	}
+}
+
+func (r *LocationMatchReasons) addMissingTypes(missingTypes interface{}) {
+	switch v := missingTypes.(type) {
+	case nil:
+	case []interface{}:
+		for _, t := range v {
+			r.addMissingTypes(t)
+		}
+	default:
+		r.MissingResourceTypes = append(r.MissingResourceTypes, fmt.Sprint(v))
+	}
+}
+
+func isMissingTypesKey(key string) bool {
+	lower := strings.ToLower(key)
+	return strings.Contains(lower, "missing") || strings.Contains(lower, "unsupported") || strings.Contains(lower, "nomatch")
+}
+
+func isPermissionReason(reason string) bool {
+	lower := strings.ToLower(reason)
+	return strings.Contains(lower, "permission") || strings.Contains(lower, "authoriz") || strings.Contains(lower, "forbidden")
+}
@@ -0,0 +1,66 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestLocationMatch_NotReadyReasons(t *testing.T) {
+	tests := []struct {
+		name  string
+		match string
+		want  LocationMatchReasons
+	}{
+		{"Ready", `{"ready":true,"orchestrator":{"state":"CONNECTED"}}`, LocationMatchReasons{}},
+		{"OrchestratorDisabled", `{"ready":false,"orchestrator":{"state":"DISABLED"}}`, LocationMatchReasons{OrchestratorState: OrchestratorDisabled}},
+		{"OrchestratorConnecting", `{"ready":false,"orchestrator":{"state":"CONNECTING"},"reasons":"Orchestrator is disabled"}`,
+			LocationMatchReasons{OrchestratorState: OrchestratorConnecting, Messages: []string{"Orchestrator is disabled"}}},
+		{"Message", `{"ready":false,"orchestrator":{"state":"CONNECTED"},"reasons":"User has no permission to deploy on this location"}`,
+			LocationMatchReasons{PermissionDenied: true}},
+		{"PermissionKey", `{"ready":false,"reasons":{"forbidden":true,"quota":"exceeded"}}`,
+			LocationMatchReasons{PermissionDenied: true, Messages: []string{"quota: exceeded"}}},
+		{"MissingTypes", `{"ready":false,"reasons":{"missingTypes":["tosca.nodes.Compute","org.custom.Database"]}}`,
+			LocationMatchReasons{MissingResourceTypes: []string{"tosca.nodes.Compute", "org.custom.Database"}}},
+		{"NoMatchingType", `{"ready":false,"reasons":[{"noMatchingType":"org.custom.Database"},"not authorized"]}`,
+			LocationMatchReasons{MissingResourceTypes: []string{"org.custom.Database"}, PermissionDenied: true}},
+		{"MessagesList", `{"ready":false,"reasons":["quota exceeded","not supported"]}`,
+			LocationMatchReasons{Messages: []string{"quota exceeded", "not supported"}}},
+		{"KeyedMessages", `{"ready":false,"reasons":{"policy":"not supported"}}`,
+			LocationMatchReasons{Messages: []string{"policy: not supported"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var match LocationMatch
+			assert.NilError(t, json.Unmarshal([]byte(tt.match), &match))
+			got := match.NotReadyReasons()
+			assert.DeepEqual(t, got, tt.want)
+			assert.Equal(t, got.IsEmpty(), tt.name == "Ready")
+		})
+	}
+}
+
+func TestLocationMatchReasons_String(t *testing.T) {
+	r := LocationMatchReasons{
+		OrchestratorState:    OrchestratorDisconnected,
+		MissingResourceTypes: []string{"tosca.nodes.Compute", "org.custom.Database"},
+		PermissionDenied:     true,
+		Messages:             []string{"quota exceeded", "other"},
+	}
+	assert.Equal(t, r.String(), "orchestrator is disconnected; permission denied; missing resource types tosca.nodes.Compute, org.custom.Database; quota exceeded; other")
+}
@@ -0,0 +1,74 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "sort"
+
+// NodeOperations returns the operations available on the given node template sorted by interface and operation names.
+//
+// Operations are collected along the node type hierarchy, from the most generic type to the node template itself,
+// a definition overriding the one of its parents. Only node types part of the topology are considered which is the
+// case for topologies returned by TopologyService.GetTopology().
+// The second returned value is false if the node template does not exist.
+func (t *Topology) NodeOperations(nodeName string) ([]NodeOperationDefinition, bool) {
+	node, ok := t.Data.Topology.NodeTemplates[nodeName]
+	if !ok {
+		return nil, false
+	}
+
+	operations := make(map[[2]string]NodeOperationDefinition)
+	addInterface := func(definedBy, interfaceName string, nodeInterface NodeInterface) {
+		for operationName, operation := range nodeInterface.Operations {
+			operations[[2]string{interfaceName, operationName}] = NodeOperationDefinition{
+				InterfaceName:          interfaceName,
+				OperationName:          operationName,
+				Description:            operation.Description,
+				InputParameters:        operation.InputParameters,
+				ImplementationArtifact: operation.ImplementationArtifact,
+				DefinedBy:              definedBy,
+			}
+		}
+	}
+
+	// DerivedFrom lists parent types from the direct parent to the root type
+	hierarchy := []string{node.Type}
+	if nodeType, ok := t.Data.NodeTypes[node.Type]; ok {
+		hierarchy = append(hierarchy, nodeType.DerivedFrom...)
+	}
+	for i := len(hierarchy) - 1; i >= 0; i-- {
+		nodeType, ok := t.Data.NodeTypes[hierarchy[i]]
+		if !ok {
+			continue
+		}
+		for interfaceName, nodeInterface := range nodeType.Interfaces {
+			addInterface(hierarchy[i], interfaceName, nodeInterface)
+		}
+	}
+	for _, nodeInterface := range node.Interfaces {
+		addInterface(nodeName, nodeInterface.Key, nodeInterface.Value)
+	}
+
+	result := make([]NodeOperationDefinition, 0, len(operations))
+	for _, operation := range operations {
+		result = append(result, operation)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].InterfaceName != result[j].InterfaceName {
+			return result[i].InterfaceName < result[j].InterfaceName
+		}
+		return result[i].OperationName < result[j].OperationName
+	})
+	return result, true
+}
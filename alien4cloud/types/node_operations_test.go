@@ -0,0 +1,56 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+const testTopologyWithOperations = `{"data":{
+	"nodeTypes":{
+		"tosca.nodes.Root":{"elementId":"tosca.nodes.Root","interfaces":{"tosca.interfaces.node.lifecycle.Standard":{"operations":{"create":{},"start":{}}}}},
+		"org.WebServer":{"elementId":"org.WebServer","derivedFrom":["tosca.nodes.Root"],"interfaces":{
+			"tosca.interfaces.node.lifecycle.Standard":{"operations":{"start":{"description":"Start the server","implementationArtifact":{"artifactRef":"scripts/start.sh"}}}},
+			"custom":{"operations":{"reload":{"inputParameters":{"graceful":{"value":true}}}}}
+		}}
+	},
+	"topology":{"nodeTemplates":{
+		"Web":{"name":"Web","type":"org.WebServer","interfaces":[{"key":"tosca.interfaces.node.lifecycle.Standard","value":{"operations":{"create":{"implementationArtifact":{"artifactRef":"scripts/create.sh"}}}}}]},
+		"Other":{"name":"Other","type":"org.Unknown"}
+	}}
+}}`
+
+func TestTopology_NodeOperations(t *testing.T) {
+	var topology Topology
+	assert.NilError(t, json.Unmarshal([]byte(testTopologyWithOperations), &topology))
+
+	operations, ok := topology.NodeOperations("Web")
+	assert.Assert(t, ok)
+	assert.DeepEqual(t, operations, []NodeOperationDefinition{
+		{InterfaceName: "custom", OperationName: "reload", InputParameters: map[string]interface{}{"graceful": map[string]interface{}{"value": true}}, DefinedBy: "org.WebServer"},
+		{InterfaceName: StandardNodeInterface, OperationName: "create", ImplementationArtifact: &ImplementationArtifact{ArtifactRef: "scripts/create.sh"}, DefinedBy: "Web"},
+		{InterfaceName: StandardNodeInterface, OperationName: "start", Description: "Start the server", ImplementationArtifact: &ImplementationArtifact{ArtifactRef: "scripts/start.sh"}, DefinedBy: "org.WebServer"},
+	})
+
+	operations, ok = topology.NodeOperations("Other")
+	assert.Assert(t, ok)
+	assert.Equal(t, len(operations), 0)
+
+	_, ok = topology.NodeOperations("unknown")
+	assert.Assert(t, !ok)
+}
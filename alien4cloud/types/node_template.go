@@ -0,0 +1,105 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "strconv"
+
+// Tags holding the position of a node template in the Alien4Cloud topology editor
+const (
+	nodePositionXTag = "a4c_edit_x"
+	nodePositionYTag = "a4c_edit_y"
+)
+
+// Metadata returns the metadata of the node template, Alien4Cloud stores them as tags
+func (n NodeTemplate) Metadata() map[string]string {
+	metadata := make(map[string]string, len(n.Tags))
+	for _, tag := range n.Tags {
+		metadata[tag.Key] = tag.Value
+	}
+	return metadata
+}
+
+// Property returns the value of the given property of the node template
+func (n NodeTemplate) Property(name string) (PropertyValue, bool) {
+	for _, prop := range n.Properties {
+		if prop.Key == name {
+			return prop.Value, true
+		}
+	}
+	return PropertyValue{}, false
+}
+
+// Capability returns the given capability of the node template
+func (n NodeTemplate) Capability(name string) (Capability, bool) {
+	for _, capability := range n.Capabilities {
+		if capability.Key == name {
+			return capability.Value, true
+		}
+	}
+	return Capability{}, false
+}
+
+// Requirement returns the given requirement of the node template
+func (n NodeTemplate) Requirement(name string) (Requirement, bool) {
+	for _, requirement := range n.Requirements {
+		if requirement.Key == name {
+			return requirement.Value, true
+		}
+	}
+	return Requirement{}, false
+}
+
+// RelationshipsTo returns the relationships of the node template targeting the given node template
+func (n NodeTemplate) RelationshipsTo(target string) []RelationshipTemplate {
+	var relationships []RelationshipTemplate
+	for _, relationship := range n.Relationships {
+		if relationship.Value.Target == target {
+			relationships = append(relationships, relationship.Value)
+		}
+	}
+	return relationships
+}
+
+// Artifact returns the given artifact of the node template
+func (n NodeTemplate) Artifact(name string) (DeploymentArtifact, bool) {
+	for _, artifact := range n.Artifacts {
+		if artifact.Key == name {
+			return artifact.Value, true
+		}
+	}
+	return DeploymentArtifact{}, false
+}
+
+// Interface returns the given interface of the node template, it holds operations overridden by the template
+func (n NodeTemplate) Interface(name string) (NodeInterface, bool) {
+	for _, i := range n.Interfaces {
+		if i.Key == name {
+			return i.Value, true
+		}
+	}
+	return NodeInterface{}, false
+}
+
+// Position returns the coordinates of the node template in the Alien4Cloud topology editor,
+// the returned boolean is false if the node was never positioned
+func (n NodeTemplate) Position() (x, y int, ok bool) {
+	metadata := n.Metadata()
+	x, errX := strconv.Atoi(metadata[nodePositionXTag])
+	y, errY := strconv.Atoi(metadata[nodePositionYTag])
+	if errX != nil || errY != nil {
+		return 0, 0, false
+	}
+	return x, y, true
+}
@@ -0,0 +1,87 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestNodeTemplate_Decode(t *testing.T) {
+	var topology Topology
+	err := json.Unmarshal([]byte(`{"data":{"topology":{"nodeTemplates":{"Web":{
+		"name":"Web","type":"org.WebServer",
+		"tags":[{"name":"owner","value":"team-a"}],
+		"properties":[{"key":"port","value":{"value":"8080"}}],
+		"attributes":{"url":{"function":"concat"}},
+		"capabilities":[{"key":"endpoint","value":{"type":"tosca.capabilities.Endpoint","properties":[{"key":"protocol","value":{"value":"http"}}]}}],
+		"requirements":[{"key":"host","value":{"type":"tosca.capabilities.Container"}},{"key":"database","value":{"type":"org.capabilities.DB"}}],
+		"relationships":[
+			{"key":"hostedOnCompute","value":{"type":"tosca.relationships.HostedOn","target":"Compute","requirementName":"host","targetedCapabilityName":"host"}},
+			{"key":"connectsToDB","value":{"type":"org.relationships.ConnectsTo","target":"DB","requirementName":"database","targetedCapabilityName":"database",
+				"properties":[{"key":"timeout","value":{"value":"30"}}]}}
+		],
+		"artifacts":[{"key":"site","value":{"artifactType":"tosca.artifacts.File","artifactRef":"site.zip"}}],
+		"interfaces":[{"key":"tosca.interfaces.node.lifecycle.Standard","value":{"operations":{"create":{"implementationArtifact":{"artifactType":"tosca.artifacts.Implementation.Bash","artifactRef":"scripts/create.sh"}}}}}],
+		"groups":["frontend"]
+	}}}}}`), &topology)
+	assert.NilError(t, err)
+
+	web := topology.Data.Topology.NodeTemplates["Web"]
+	assert.DeepEqual(t, web.Metadata(), map[string]string{"owner": "team-a"})
+	port, ok := web.Property("port")
+	assert.Assert(t, ok)
+	assert.Equal(t, port.Value, "8080")
+
+	endpoint, ok := web.Capability("endpoint")
+	assert.Assert(t, ok)
+	assert.Equal(t, endpoint.Type, "tosca.capabilities.Endpoint")
+	assert.Equal(t, endpoint.Properties[0].Value.Value, "http")
+
+	requirement, ok := web.Requirement("database")
+	assert.Assert(t, ok)
+	assert.Equal(t, requirement.Type, "org.capabilities.DB")
+
+	relationships := web.RelationshipsTo("DB")
+	assert.Equal(t, len(relationships), 1)
+	assert.Equal(t, relationships[0].Type, "org.relationships.ConnectsTo")
+	assert.Equal(t, relationships[0].RequirementName, "database")
+	assert.Equal(t, relationships[0].Properties[0].Value.Value, "30")
+
+	artifact, ok := web.Artifact("site")
+	assert.Assert(t, ok)
+	assert.Equal(t, artifact.ArtifactRef, "site.zip")
+
+	standard, ok := web.Interface(StandardNodeInterface)
+	assert.Assert(t, ok)
+	assert.Equal(t, standard.Operations["create"].ImplementationArtifact.ArtifactRef, "scripts/create.sh")
+
+	_, ok = web.Capability("unknown")
+	assert.Assert(t, !ok)
+	assert.DeepEqual(t, web.Groups, []string{"frontend"})
+}
+
+func TestNodeTemplate_Position(t *testing.T) {
+	n := NodeTemplate{Tags: []Tag{{Key: "owner", Value: "team-a"}, {Key: "a4c_edit_x", Value: "120"}, {Key: "a4c_edit_y", Value: "-40"}}}
+	x, y, ok := n.Position()
+	assert.Assert(t, ok)
+	assert.Equal(t, x, 120)
+	assert.Equal(t, y, -40)
+
+	_, _, ok = NodeTemplate{Tags: []Tag{{Key: "a4c_edit_x", Value: "120"}}}.Position()
+	assert.Assert(t, !ok)
+}
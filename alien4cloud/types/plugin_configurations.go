@@ -0,0 +1,307 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// YorcOrchestratorConfiguration is the configuration of an orchestrator managed by the Yorc plugin,
+// as returned by OrchestratorService.GetOrchestratorConfiguration() once decoded using DecodeConfiguration.
+//
+// Extra holds configuration properties not modeled by this struct, they are kept when encoding the configuration.
+type YorcOrchestratorConfiguration struct {
+	// URLYorc is the URL of the Yorc REST API, for instance "https://yorc.example.com:8800"
+	URLYorc     string `json:"urlYorc,omitempty"`
+	InsecureTLS bool   `json:"insecureTLS,omitempty"`
+	// CACertificate, ClientCertificate and ClientKey are PEM encoded contents used to secure connections to Yorc
+	CACertificate     string `json:"caCertificate,omitempty"`
+	ClientCertificate string `json:"clientCertificate,omitempty"`
+	ClientKey         string `json:"clientKey,omitempty"`
+	// ConnectionTimeout is the timeout of connections to Yorc in seconds
+	ConnectionTimeout      int `json:"connectionTimeout,omitempty"`
+	ExecutorThreadPoolSize int `json:"executorThreadPoolSize,omitempty"`
+	IOThreadCount          int `json:"IOThreadCount,omitempty"`
+
+	Extra map[string]interface{} `json:"-"`
+}
+
+// MarshalJSON marshals the configuration along with its Extra properties
+func (c YorcOrchestratorConfiguration) MarshalJSON() ([]byte, error) {
+	type plain YorcOrchestratorConfiguration
+	return marshalWithExtra(plain(c), c.Extra)
+}
+
+// UnmarshalJSON unmarshals the configuration, unknown properties are kept in Extra
+func (c *YorcOrchestratorConfiguration) UnmarshalJSON(b []byte) error {
+	type plain YorcOrchestratorConfiguration
+	var p plain
+	extra, err := unmarshalWithExtra(b, &p)
+	if err != nil {
+		return err
+	}
+	*c = YorcOrchestratorConfiguration(p)
+	c.Extra = extra
+	return nil
+}
+
+// VaultSecretProviderConfiguration is the configuration of the HashiCorp Vault secret provider plugin,
+// held by the Configuration of a SecretProviderConfiguration with the "alien4cloud-vault-plugin" plugin name.
+//
+// Extra holds configuration properties not modeled by this struct, they are kept when encoding the configuration.
+type VaultSecretProviderConfiguration struct {
+	// URL is the URL of the Vault server
+	URL string `json:"url,omitempty"`
+	// Certificate is the PEM encoded certificate of the Vault server
+	Certificate string `json:"certificate,omitempty"`
+	// AuthenticationMethod is the method used by deployments to authenticate against Vault, for instance "ldap" or "token"
+	AuthenticationMethod string `json:"authenticationMethod,omitempty"`
+
+	Extra map[string]interface{} `json:"-"`
+}
+
+// MarshalJSON marshals the configuration along with its Extra properties
+func (c VaultSecretProviderConfiguration) MarshalJSON() ([]byte, error) {
+	type plain VaultSecretProviderConfiguration
+	return marshalWithExtra(plain(c), c.Extra)
+}
+
+// UnmarshalJSON unmarshals the configuration, unknown properties are kept in Extra
+func (c *VaultSecretProviderConfiguration) UnmarshalJSON(b []byte) error {
+	type plain VaultSecretProviderConfiguration
+	var p plain
+	extra, err := unmarshalWithExtra(b, &p)
+	if err != nil {
+		return err
+	}
+	*c = VaultSecretProviderConfiguration(p)
+	c.Extra = extra
+	return nil
+}
+
+// KubernetesLocationConfiguration holds the properties of a Kubernetes location of Yorc, as defined in Yorc locations
+// configuration for locations of type "kubernetes", used by Alien4Cloud locations of the Yorc orchestrator plugin
+// with the Kubernetes infrastructure type.
+//
+// Extra holds configuration properties not modeled by this struct, they are kept when encoding the configuration.
+type KubernetesLocationConfiguration struct {
+	// Kubeconfig is the path of a kubeconfig file on the Yorc host
+	Kubeconfig string `json:"kubeconfig,omitempty"`
+	// ApplicationCredentials is the path of a Google Cloud service account file, used for GKE clusters
+	ApplicationCredentials string `json:"application_credentials,omitempty"`
+	// MasterURL is the URL of the Kubernetes API server, it overrides the one defined in the kubeconfig file
+	MasterURL string `json:"master_url,omitempty"`
+	// CAFile, CertFile and KeyFile are paths of PEM encoded files on the Yorc host used to secure connections
+	// to the Kubernetes API server
+	CAFile   string `json:"ca_file,omitempty"`
+	CertFile string `json:"cert_file,omitempty"`
+	KeyFile  string `json:"key_file,omitempty"`
+	// Insecure allows to skip the verification of the API server certificate
+	Insecure bool `json:"insecure,omitempty"`
+	// JobMonitoringTimeInterval is the period at which Kubernetes jobs are monitored, as a Go duration like "5s"
+	JobMonitoringTimeInterval string `json:"job_monitoring_time_interval,omitempty"`
+
+	Extra map[string]interface{} `json:"-"`
+}
+
+// MarshalJSON marshals the configuration along with its Extra properties
+func (c KubernetesLocationConfiguration) MarshalJSON() ([]byte, error) {
+	type plain KubernetesLocationConfiguration
+	return marshalWithExtra(plain(c), c.Extra)
+}
+
+// UnmarshalJSON unmarshals the configuration, unknown properties are kept in Extra
+func (c *KubernetesLocationConfiguration) UnmarshalJSON(b []byte) error {
+	type plain KubernetesLocationConfiguration
+	var p plain
+	extra, err := unmarshalWithExtra(b, &p)
+	if err != nil {
+		return err
+	}
+	*c = KubernetesLocationConfiguration(p)
+	c.Extra = extra
+	return nil
+}
+
+// MetaPropertyDefinition is the definition of a meta-property, as configured by Alien4Cloud administrators.
+//
+// Meta-properties values of a location are indexed by the ID of their definition.
+type MetaPropertyDefinition struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Target is the kind of element this meta-property applies to, "location" for locations meta-properties
+	Target string `json:"target,omitempty"`
+	// Type is the TOSCA type of the meta-property: string, integer, float, boolean, version...
+	Type     string `json:"type,omitempty"`
+	Required bool   `json:"required,omitempty"`
+	Default  string `json:"default,omitempty"`
+	Password bool   `json:"password,omitempty"`
+}
+
+// DecodeMetaProperties decodes the meta-properties of the location into a typed model, a pointer to a struct
+// which JSON field names are names of meta-properties.
+//
+// Meta-properties values are converted according to the type of their definition, integer, float and boolean
+// values being decoded as JSON numbers and booleans. Values of meta-properties without a definition are decoded
+// with the ID of the meta-property as name, so that they could be kept in an Extra field like the one
+// of KubernetesLocationConfiguration.
+func (l LocationConfiguration) DecodeMetaProperties(definitions []MetaPropertyDefinition, v interface{}) error {
+	byID := make(map[string]MetaPropertyDefinition, len(definitions))
+	for _, definition := range definitions {
+		byID[definition.ID] = definition
+	}
+	values := make(map[string]interface{}, len(l.MetaProperties))
+	for id, value := range l.MetaProperties {
+		definition, ok := byID[id]
+		if !ok {
+			values[id] = value
+			continue
+		}
+		if value == "" {
+			// Not set
+			continue
+		}
+		typed, err := metaPropertyValue(definition, value)
+		if err != nil {
+			return err
+		}
+		values[definition.Name] = typed
+	}
+	return DecodeConfiguration(values, v)
+}
+
+// EncodeMetaProperties encodes a typed model of meta-properties into meta-properties values indexed
+// by the ID of their definition, as expected by LocationService.UpdateLocationMetaProperties().
+//
+// An error is returned if a property of the model is neither the name nor the ID of a meta-property definition.
+func EncodeMetaProperties(definitions []MetaPropertyDefinition, v interface{}) (map[string]string, error) {
+	values, err := EncodeConfiguration(v)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]MetaPropertyDefinition, len(definitions))
+	for _, definition := range definitions {
+		byName[definition.Name] = definition
+		byName[definition.ID] = definition
+	}
+	metaProperties := make(map[string]string, len(values))
+	for name, value := range values {
+		definition, ok := byName[name]
+		if !ok {
+			return nil, errors.Errorf("no meta-property definition named %q", name)
+		}
+		if value == nil {
+			continue
+		}
+		metaProperties[definition.ID] = fmt.Sprint(value)
+	}
+	return metaProperties, nil
+}
+
+// metaPropertyValue converts the string value of a meta-property according to the type of its definition
+func metaPropertyValue(definition MetaPropertyDefinition, value string) (interface{}, error) {
+	var typed interface{}
+	var err error
+	switch definition.Type {
+	case "integer":
+		typed, err = strconv.ParseInt(value, 10, 64)
+	case "float":
+		typed, err = strconv.ParseFloat(value, 64)
+	case "boolean":
+		typed, err = strconv.ParseBool(value)
+	default:
+		return value, nil
+	}
+	return typed, errors.Wrapf(err, "invalid %s value %q of meta-property %q", definition.Type, value, definition.Name)
+}
+
+// DecodeConfiguration decodes a plugin configuration as returned by Alien4Cloud, typically a map[string]interface{},
+// into a typed configuration model like YorcOrchestratorConfiguration
+func DecodeConfiguration(configuration interface{}, v interface{}) error {
+	b, err := json.Marshal(configuration)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal configuration")
+	}
+	return errors.Wrap(json.Unmarshal(b, v), "failed to decode configuration")
+}
+
+// EncodeConfiguration encodes a typed configuration model into a map as expected by Alien4Cloud,
+// for instance by OrchestratorService.SetOrchestratorConfiguration()
+func EncodeConfiguration(v interface{}) (map[string]interface{}, error) {
+	var configuration map[string]interface{}
+	err := DecodeConfiguration(v, &configuration)
+	return configuration, err
+}
+
+// DecodeConfiguration decodes the configuration of the secret provider into a typed configuration model like VaultSecretProviderConfiguration
+func (c SecretProviderConfiguration) DecodeConfiguration(v interface{}) error {
+	return DecodeConfiguration(c.Configuration, v)
+}
+
+// marshalWithExtra marshals v, a struct, and adds extra properties not already set by v
+func marshalWithExtra(v interface{}, extra map[string]interface{}) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return b, err
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	for k, val := range extra {
+		if _, ok := m[k]; !ok {
+			m[k] = val
+		}
+	}
+	return json.Marshal(m)
+}
+
+// unmarshalWithExtra unmarshals b into v, a pointer to a struct, and returns properties not matching a field of v
+func unmarshalWithExtra(b []byte, v interface{}) (map[string]interface{}, error) {
+	if err := json.Unmarshal(b, v); err != nil {
+		return nil, err
+	}
+	var all map[string]interface{}
+	if err := json.Unmarshal(b, &all); err != nil {
+		return nil, err
+	}
+	// Properties known by v are the JSON names of its fields
+	knownKeys := make(map[string]bool)
+	t := reflect.TypeOf(v).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			knownKeys[name] = true
+		}
+	}
+	var extra map[string]interface{}
+	for k, val := range all {
+		if knownKeys[k] {
+			continue
+		}
+		if extra == nil {
+			extra = make(map[string]interface{})
+		}
+		extra[k] = val
+	}
+	return extra, nil
+}
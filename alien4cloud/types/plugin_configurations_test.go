@@ -0,0 +1,141 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestYorcOrchestratorConfiguration(t *testing.T) {
+	var configuration map[string]interface{}
+	err := json.Unmarshal([]byte(`{"urlYorc":"https://yorc:8800","insecureTLS":false,"connectionTimeout":30,"pollingDelay":5}`), &configuration)
+	assert.NilError(t, err)
+
+	var yorc YorcOrchestratorConfiguration
+	assert.NilError(t, DecodeConfiguration(configuration, &yorc))
+	assert.DeepEqual(t, yorc, YorcOrchestratorConfiguration{
+		URLYorc:           "https://yorc:8800",
+		ConnectionTimeout: 30,
+		Extra:             map[string]interface{}{"pollingDelay": float64(5)},
+	})
+
+	yorc.InsecureTLS = true
+	encoded, err := EncodeConfiguration(yorc)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, encoded, map[string]interface{}{
+		"urlYorc": "https://yorc:8800", "insecureTLS": true, "connectionTimeout": float64(30), "pollingDelay": float64(5),
+	})
+
+	err = DecodeConfiguration(map[string]interface{}{"connectionTimeout": "soon"}, &yorc)
+	assert.ErrorContains(t, err, "failed to decode configuration")
+}
+
+func TestSecretProviderConfiguration_DecodeConfiguration(t *testing.T) {
+	var location LocationConfiguration
+	err := json.Unmarshal([]byte(`{"id":"loc","secretProviderConfiguration":{"pluginName":"alien4cloud-vault-plugin",
+		"configuration":{"url":"https://vault:8200","authenticationMethod":"ldap","ldapPath":"auth/ldap"}}}`), &location)
+	assert.NilError(t, err)
+
+	var vault VaultSecretProviderConfiguration
+	assert.NilError(t, location.SecretProviderConfiguration.DecodeConfiguration(&vault))
+	assert.DeepEqual(t, vault, VaultSecretProviderConfiguration{
+		URL:                  "https://vault:8200",
+		AuthenticationMethod: "ldap",
+		Extra:                map[string]interface{}{"ldapPath": "auth/ldap"},
+	})
+}
+
+func TestKubernetesLocationConfiguration(t *testing.T) {
+	var location struct {
+		Name       string                          `json:"name"`
+		Type       string                          `json:"type"`
+		Properties KubernetesLocationConfiguration `json:"properties"`
+	}
+	err := json.Unmarshal([]byte(`{
+		"name": "k8s",
+		"type": "kubernetes",
+		"properties": {
+			"kubeconfig": "/etc/yorc/kubeconfig",
+			"master_url": "https://10.0.0.10:6443",
+			"ca_file": "/etc/yorc/ca.pem",
+			"cert_file": "/etc/yorc/client.pem",
+			"key_file": "/etc/yorc/client-key.pem",
+			"insecure": false,
+			"job_monitoring_time_interval": "10s",
+			"default_namespace": "apps"
+		}
+	}`), &location)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, location.Properties, KubernetesLocationConfiguration{
+		Kubeconfig:                "/etc/yorc/kubeconfig",
+		MasterURL:                 "https://10.0.0.10:6443",
+		CAFile:                    "/etc/yorc/ca.pem",
+		CertFile:                  "/etc/yorc/client.pem",
+		KeyFile:                   "/etc/yorc/client-key.pem",
+		JobMonitoringTimeInterval: "10s",
+		Extra:                     map[string]interface{}{"default_namespace": "apps"},
+	})
+
+	location.Properties.Insecure = true
+	encoded, err := EncodeConfiguration(location.Properties)
+	assert.NilError(t, err)
+	assert.Equal(t, encoded["insecure"], true)
+	assert.Equal(t, encoded["default_namespace"], "apps")
+	assert.Equal(t, encoded["master_url"], "https://10.0.0.10:6443")
+}
+
+func TestLocationConfiguration_DecodeMetaProperties(t *testing.T) {
+	var definitions []MetaPropertyDefinition
+	err := json.Unmarshal([]byte(`[
+		{"id":"6c5ba7a1-2d5b-4b3e-9a43-8f1e9b7d0a11","name":"datacenter","target":"location","type":"string","required":true},
+		{"id":"0f2a7b4e-98c3-4e0c-b1f6-5d3c2a9e8b72","name":"max_vms","target":"location","type":"integer","default":"10"},
+		{"id":"a8e41c57-3f1d-4c2b-8e6a-7b9d0c1f2e33","name":"gpu","target":"location","type":"boolean"}]`), &definitions)
+	assert.NilError(t, err)
+
+	var location LocationConfiguration
+	err = json.Unmarshal([]byte(`{"id":"loc","name":"openstack","metaProperties":{
+		"6c5ba7a1-2d5b-4b3e-9a43-8f1e9b7d0a11":"paris",
+		"0f2a7b4e-98c3-4e0c-b1f6-5d3c2a9e8b72":"25",
+		"a8e41c57-3f1d-4c2b-8e6a-7b9d0c1f2e33":"true"}}`), &location)
+	assert.NilError(t, err)
+
+	type locationMetaProperties struct {
+		Datacenter string `json:"datacenter"`
+		MaxVMs     int    `json:"max_vms"`
+		GPU        bool   `json:"gpu"`
+	}
+	var metaProperties locationMetaProperties
+	assert.NilError(t, location.DecodeMetaProperties(definitions, &metaProperties))
+	assert.DeepEqual(t, metaProperties, locationMetaProperties{Datacenter: "paris", MaxVMs: 25, GPU: true})
+
+	metaProperties.MaxVMs = 30
+	encoded, err := EncodeMetaProperties(definitions, metaProperties)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, encoded, map[string]string{
+		"6c5ba7a1-2d5b-4b3e-9a43-8f1e9b7d0a11": "paris",
+		"0f2a7b4e-98c3-4e0c-b1f6-5d3c2a9e8b72": "30",
+		"a8e41c57-3f1d-4c2b-8e6a-7b9d0c1f2e33": "true",
+	})
+
+	location.MetaProperties["0f2a7b4e-98c3-4e0c-b1f6-5d3c2a9e8b72"] = "many"
+	err = location.DecodeMetaProperties(definitions, &metaProperties)
+	assert.ErrorContains(t, err, `invalid integer value "many" of meta-property "max_vms"`)
+
+	_, err = EncodeMetaProperties(definitions[:1], metaProperties)
+	assert.ErrorContains(t, err, "no meta-property definition named")
+}
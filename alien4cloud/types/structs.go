@@ -0,0 +1,1216 @@
+// Copyright 2019 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CSAR holds properties defining a Cloud Service ARchive
+type CSAR struct {
+	DefinitionHash          string           `json:"definitionHash,omitempty"`
+	DelegateID              string           `json:"delegateId,omitempty"`
+	DelegateType            string           `json:"delegateType,omitempty"`
+	Dependencies            []CSARDependency `json:"dependencies,omitempty"`
+	Description             string           `json:"description,omitempty"`
+	HasTopology             bool             `json:"hasTopology,omitempty"`
+	Hash                    string           `json:"hash,omitempty"`
+	ID                      string           `json:"id,omitempty"`
+	ImportDate              Time             `json:"importDate,omitempty"`
+	ImportSource            string           `json:"importSource,omitempty"`
+	License                 string           `json:"license,omitempty"`
+	Name                    string           `json:"name,omitempty"`
+	NestedVersion           Version          `json:"nestedVersion,omitempty"`
+	NodeTypesCount          int              `json:"nodeTypesCount,omitempty"`
+	Tags                    []Tag            `json:"tags,omitempty"`
+	TemplateAuthor          string           `json:"templateAuthor,omitempty"`
+	ToscaDefaultNamespace   string           `json:"toscaDefaultNamespace,omitempty"`
+	ToscaDefinitionsVersion string           `json:"toscaDefinitionsVersion,omitempty"`
+	Version                 string           `json:"version,omitempty"`
+	Workspace               string           `json:"workspace,omitempty"`
+	YamlFilePath            string           `json:"yamlFilePath,omitempty"`
+}
+
+// Version represents a version with its decomposed fields
+type Version struct {
+	MajorVersion       int    `json:"majorVersion,omitempty"`
+	MinorVersion       int    `json:"minorVersion,omitempty"`
+	IncrementalVersion int    `json:"incrementalVersion,omitempty"`
+	BuildNumber        int    `json:"buildNumber,omitempty"`
+	Qualifier          string `json:"qualifier,omitempty"`
+}
+
+// CSARDependency holds properties defining a dependency on an archive
+type CSARDependency struct {
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+	Hash    string `json:"hash,omitempty"`
+}
+
+// LocationModifierReference holds a reference to a location modifier
+type LocationModifierReference struct {
+	PluginID string `json:"pluginId"`
+	BeanName string `json:"beanName"`
+	Phase    string `json:"phase,omitempty"`
+}
+
+// SecretProviderConfiguration holds the configuraiton of a secret provider
+type SecretProviderConfiguration struct {
+	PluginName    string      `json:"pluginName,omitempty"`
+	Configuration interface{} `json:"configuration,omitempty"`
+}
+
+// LocationConfiguration holds a location configuration properties
+type LocationConfiguration struct {
+	ID                          string                      `json:"id"`
+	CreationDate                Time                        `json:"creationDate,omitempty"`
+	LastUpdateDate              Time                        `json:"lastUpdateDate,omitempty"`
+	Dependencies                []CSARDependency            `json:"dependencies,omitempty"`
+	EnvironmentType             string                      `json:"environmentType,omitempty"`
+	InfrastructureType          string                      `json:"infrastructureType,omitempty"`
+	MetaProperties              map[string]string           `json:"metaProperties,omitempty"`
+	Modifiers                   []LocationModifierReference `json:"modifiers,omitempty"`
+	Name                        string                      `json:"name,omitempty"`
+	OrchestratorID              string                      `json:"orchestratorId,omitempty"`
+	SecretProviderConfiguration SecretProviderConfiguration `json:"secretProviderConfiguration,omitempty"`
+	ApplicationPermissions      map[string][]string         `json:"applicationPermissions,omitempty"`
+	EnvironmentPermissions      map[string][]string         `json:"environmentPermissions,omitempty"`
+	EnvironmentTypePermissions  map[string][]string         `json:"environmentTypePermissions,omitempty"`
+	GroupPermissions            map[string][]string         `json:"groupPermissions,omitempty"`
+	UserPermissions             map[string][]string         `json:"userPermissions,omitempty"`
+}
+
+// Orchestrator holds properties of an orchestrator
+type Orchestrator struct {
+	ID                    string `json:"id"`
+	Name                  string `json:"name"`
+	PluginID              string `json:"pluginId,omitempty"`
+	PluginBean            string `json:"pluginBean,omitempty"`
+	DeploymentNamePattern string `json:"deploymentNamePattern,omitempty"`
+	State                 string `json:"state,omitempty"`
+}
+
+// LocationMatch holds details on a Location where an application can be deployed
+type LocationMatch struct {
+	Location     LocationConfiguration `json:"location"`
+	Orchestrator Orchestrator          `json:"orchestrator"`
+	Ready        bool                  `json:"ready"`
+	Reasons      interface{}           `json:"reasons,omitempty"`
+}
+
+// LocationResourceTemplate holds properties of a location resource, for instance
+// an on-demand resource or a service that could substitute a node of a topology at deployment time
+type LocationResourceTemplate struct {
+	ID         string                   `json:"id"`
+	Name       string                   `json:"name"`
+	Enabled    bool                     `json:"enabled"`
+	Service    bool                     `json:"service"`
+	LocationID string                   `json:"locationId,omitempty"`
+	Template   LocationResourceNodeType `json:"template,omitempty"`
+}
+
+// LocationResourceNodeType holds the type and properties of the node template of a location resource
+type LocationResourceNodeType struct {
+	Name       string                      `json:"name,omitempty"`
+	Type       string                      `json:"type"`
+	Properties []NodeTemplatePropertyValue `json:"properties,omitempty"`
+}
+
+// Error is the representation of an A4C error
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// ParsingError is the representation of an A4C parsing error (typically used in CSAR parsing)
+type ParsingError struct {
+	ErrorLevel string     `json:"errorLevel,omitempty"`
+	ErrorCode  string     `json:"errorCode,omitempty"`
+	Problem    string     `json:"problem,omitempty"`
+	Context    string     `json:"context,omitempty"`
+	Note       string     `json:"note,omitempty"`
+	StartMark  SimpleMark `json:"startMark,omitempty"`
+	EndMark    SimpleMark `json:"endMark,omitempty"`
+}
+
+func (pe *ParsingError) String() string {
+	var b strings.Builder
+	b.WriteString(pe.ErrorLevel)
+	b.WriteString(": ")
+	b.WriteString(pe.ErrorCode)
+	b.WriteString(" ")
+	b.WriteString(pe.Problem)
+	if pe.Context != "" {
+		b.WriteString(". ")
+		b.WriteString(pe.Context)
+	}
+	if pe.Note != "" {
+		b.WriteString(" (")
+		b.WriteString(pe.Note)
+		b.WriteString(")")
+	}
+	if pe.StartMark.Line != 0 || pe.StartMark.Column != 0 {
+		b.WriteString(" StartMark[")
+		b.WriteString(strconv.Itoa(pe.StartMark.Line))
+		b.WriteString(", ")
+		b.WriteString(strconv.Itoa(pe.StartMark.Column))
+		b.WriteString("]")
+	}
+	if pe.EndMark.Line != 0 || pe.EndMark.Column != 0 {
+		b.WriteString(" EndMark[")
+		b.WriteString(strconv.Itoa(pe.EndMark.Line))
+		b.WriteString(", ")
+		b.WriteString(strconv.Itoa(pe.EndMark.Column))
+		b.WriteString("]")
+	}
+
+	return b.String()
+}
+
+// SimpleMark is a mark into a file (line+column)
+type SimpleMark struct {
+	Line   int `json:"line,omitempty"`
+	Column int `json:"column,omitempty"`
+}
+
+// SearchRequest is the representation of a request to search objects such as topologies, orchestrators in the A4C catalog
+type SearchRequest struct {
+	Query   string              `json:"query,omitempty"`
+	From    int                 `json:"from"`
+	Size    int                 `json:"size"`
+	Filters map[string][]string `json:"filters,omitempty"`
+}
+
+// DeploymentSearchRequest is the representation of a request to search deployments
+type DeploymentSearchRequest struct {
+	Query string
+	From  int
+	Size  int
+	// OrchestratorID allows to search deployments on a given orchestrator
+	OrchestratorID string
+	// SourceID allows to search deployments of a given application
+	SourceID string
+	// EnvironmentID allows to search deployments of a given application environment
+	EnvironmentID string
+}
+
+// NodeTemplatePropertyValue represents a node template property value
+type NodeTemplatePropertyValue struct {
+	Key   string        `json:"key,omitempty"`
+	Value PropertyValue `json:"value,omitempty"`
+}
+
+// NodeTemplate is the representation a node template
+type NodeTemplate struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	// Tags hold the metadata of the node template
+	Tags          []Tag                           `json:"tags,omitempty"`
+	Properties    []NodeTemplatePropertyValue     `json:"properties,omitempty"`
+	Attributes    map[string]interface{}          `json:"attributes,omitempty"`
+	Capabilities  []NodeTemplateCapabilityValue   `json:"capabilities,omitempty"`
+	Requirements  []NodeTemplateRequirementValue  `json:"requirements,omitempty"`
+	Relationships []NodeTemplateRelationshipValue `json:"relationships,omitempty"`
+	Artifacts     []NodeTemplateArtifactValue     `json:"artifacts,omitempty"`
+	Interfaces    []NodeTemplateInterfaceValue    `json:"interfaces,omitempty"`
+	Groups        []string                        `json:"groups,omitempty"`
+}
+
+// Capability is the representation of a capability of a node template
+type Capability struct {
+	Type       string                      `json:"type"`
+	Properties []NodeTemplatePropertyValue `json:"properties,omitempty"`
+}
+
+// NodeTemplateCapabilityValue is the representation of a named capability of a node template
+type NodeTemplateCapabilityValue struct {
+	Key   string     `json:"key"`
+	Value Capability `json:"value"`
+}
+
+// Requirement is the representation of a requirement of a node template
+type Requirement struct {
+	Type       string                      `json:"type"`
+	Properties []NodeTemplatePropertyValue `json:"properties,omitempty"`
+}
+
+// NodeTemplateRequirementValue is the representation of a named requirement of a node template
+type NodeTemplateRequirementValue struct {
+	Key   string      `json:"key"`
+	Value Requirement `json:"value"`
+}
+
+// RelationshipTemplate is the representation of a relationship from a node template requirement
+// to a capability of a target node template
+type RelationshipTemplate struct {
+	Name                   string                       `json:"name,omitempty"`
+	Type                   string                       `json:"type"`
+	Target                 string                       `json:"target"`
+	RequirementName        string                       `json:"requirementName,omitempty"`
+	RequirementType        string                       `json:"requirementType,omitempty"`
+	TargetedCapabilityName string                       `json:"targetedCapabilityName,omitempty"`
+	Properties             []NodeTemplatePropertyValue  `json:"properties,omitempty"`
+	Artifacts              []NodeTemplateArtifactValue  `json:"artifacts,omitempty"`
+	Interfaces             []NodeTemplateInterfaceValue `json:"interfaces,omitempty"`
+}
+
+// NodeTemplateRelationshipValue is the representation of a named relationship of a node template
+type NodeTemplateRelationshipValue struct {
+	Key   string               `json:"key"`
+	Value RelationshipTemplate `json:"value"`
+}
+
+// NodeTemplateArtifactValue is the representation of a named artifact of a node template
+type NodeTemplateArtifactValue struct {
+	Key   string             `json:"key"`
+	Value DeploymentArtifact `json:"value"`
+}
+
+// NodeTemplateInterfaceValue is the representation of an interface of a node template
+type NodeTemplateInterfaceValue struct {
+	Key   string        `json:"key"`
+	Value NodeInterface `json:"value"`
+}
+
+// NodeType is the representation a node type
+type NodeType struct {
+	ID             string                   `json:"id,omitempty"`
+	ArchiveName    string                   `json:"archiveName"`
+	ArchiveVersion string                   `json:"archiveVersion"`
+	ElementID      string                   `json:"elementId"`
+	DerivedFrom    []string                 `json:"derivedFrom,omitempty"`
+	Requirements   []ComponentRequirement   `json:"requirements"`
+	Capabilities   []ComponentCapability    `json:"capabilities"`
+	Properties     []ComponentProperty      `json:"properties"`
+	Interfaces     map[string]NodeInterface `json:"interfaces,omitempty"`
+}
+
+// NodeInterface is the representation of an interface of a node type
+type NodeInterface struct {
+	Type        string                   `json:"type,omitempty"`
+	Description string                   `json:"description,omitempty"`
+	Operations  map[string]NodeOperation `json:"operations,omitempty"`
+}
+
+// NodeOperation is the representation of an operation of a node type interface
+type NodeOperation struct {
+	Description            string                  `json:"description,omitempty"`
+	InputParameters        map[string]interface{}  `json:"inputParameters,omitempty"`
+	ImplementationArtifact *ImplementationArtifact `json:"implementationArtifact,omitempty"`
+}
+
+// ImplementationArtifact is the representation of the artifact implementing an operation
+type ImplementationArtifact struct {
+	ArtifactType   string `json:"artifactType,omitempty"`
+	ArtifactRef    string `json:"artifactRef,omitempty"`
+	ArchiveName    string `json:"archiveName,omitempty"`
+	ArchiveVersion string `json:"archiveVersion,omitempty"`
+}
+
+// RelationshipType is the representation a relationship type
+type RelationshipType struct {
+	ArchiveName    string              `json:"archiveName"`
+	ArchiveVersion string              `json:"archiveVersion"`
+	ElementID      string              `json:"elementId"`
+	DerivedFrom    []string            `json:"derivedFrom"`
+	ValidTargets   []string            `json:"validTargets"`
+	ID             string              `json:"id"`
+	Properties     []ComponentProperty `json:"properties,omitempty"`
+}
+
+// PolicyType is the representation a policy type
+type PolicyType struct {
+	ArchiveName    string              `json:"archiveName"`
+	ArchiveVersion string              `json:"archiveVersion"`
+	ElementID      string              `json:"elementId"`
+	DerivedFrom    []string            `json:"derivedFrom"`
+	ID             string              `json:"id"`
+	Properties     []ComponentProperty `json:"properties,omitempty"`
+	// Targets are the node types or groups the policy type applies to
+	Targets []string `json:"targets,omitempty"`
+}
+
+// ComponentRequirement is the representation a component relationship requirement
+type ComponentRequirement struct {
+	ID               string `json:"id"`
+	Type             string `json:"type"`
+	RelationshipType string `json:"RelationshipType"`
+}
+
+// CapabilityType is the representation a component capability type
+type CapabilityType struct {
+	ArchiveName    string   `json:"archiveName"`
+	ArchiveVersion string   `json:"archiveVersion"`
+	ElementID      string   `json:"elementId"`
+	DerivedFrom    []string `json:"deviredFrom"`
+	ID             string   `json:"id"`
+}
+
+// ComponentCapability is the representation a component capability
+type ComponentCapability struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// ComponentProperty is the representation a component property
+type ComponentProperty struct {
+	Key   string `json:"key"`
+	Value struct {
+		Type     string `json:"type"`
+		Required bool   `json:"required"`
+		Password bool   `json:"password,omitempty"`
+	} `json:"value"`
+}
+
+// Location is the representation a location
+type Location struct {
+	ID   string
+	Name string
+}
+
+// Deployment is the representation a deployment
+type Deployment struct {
+	DeploymentUsername       string            `json:"deploymentUsername"`
+	EndDate                  Time              `json:"endDate"`
+	EnvironmentID            string            `json:"environmentId"`
+	ID                       string            `json:"id"`
+	LocationIds              []string          `json:"locationIds"`
+	OrchestratorDeploymentID string            `json:"orchestratorDeploymentId"`
+	OrchestratorID           string            `json:"orchestratorId"`
+	SourceID                 string            `json:"sourceId"`
+	SourceName               string            `json:"sourceName"`
+	SourceType               string            `json:"sourceType"`
+	StartDate                Time              `json:"startDate"`
+	VersionID                string            `json:"versionId"`
+	WorkflowExecutions       map[string]string `json:"workflowExecutions"`
+}
+
+// PropertyValue holds the definition of a property value
+type PropertyValue struct {
+	Definition     bool          `json:"definition,omitempty"`
+	Value          interface{}   `json:"value,omitempty"`
+	FunctionConcat string        `json:"function_concat,omitempty"`
+	Function       string        `json:"function,omitempty"`
+	Parameters     []interface{} `json:"parameters,omitempty"`
+}
+
+// EntrySchema holds the definition of the type of an element in a list
+type EntrySchema struct {
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+}
+
+// PropertyDefinition holds the definition of a Topology input property
+type PropertyDefinition struct {
+	Type         string        `json:"type"`
+	EntrySchema  EntrySchema   `json:"entrySchema,omitempty"`
+	Required     bool          `json:"required,omitempty"`
+	DefaultValue PropertyValue `json:"default,omitempty"`
+	Description  string        `json:"description,omitempty"`
+	SuggestionID string        `json:"suggestionId,omitempty"`
+	Password     bool          `json:"password,omitempty"`
+}
+
+// DeploymentArtifact holds properties of an artifact (file) input definition in topology
+type DeploymentArtifact struct {
+	ArtifactType         string                 `json:"artifactType"`
+	ArtifactRef          string                 `json:"artifactRef,omitempty"`
+	ArtifactRepository   string                 `json:"artifactRepository,omitempty"`
+	ArchiveName          string                 `json:"archiveName,omitempty"`
+	ArchiveVersion       string                 `json:"archiveVersion,omitempty"`
+	RepositoryURL        string                 `json:"repositoryURL,omitempty"`
+	RepositoryCredential map[string]interface{} `json:"repositoryCredential,omitempty"`
+	RepositoryName       string                 `json:"repositoryName,omitempty"`
+	ArtifactName         string                 `json:"artifactName,omitempty"`
+	DeployPath           string                 `json:"deployPath,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+}
+
+// Activity holds a workflow activity properties
+type Activity struct {
+	Type          string `json:"type,omitempty"`
+	InterfaceName string `json:"interfaceName,omitempty"` // for activities of type org.alien4cloud.tosca.model.workflow.activities.CallOperationWorkflowActivity
+	OperationName string `json:"operationName,omitempty"` // for activities of type org.alien4cloud.tosca.model.workflow.activities.CallOperationWorkflowActivity
+	Delegate      string `json:"delegate,omitempty"`      // for activities of type org.alien4cloud.tosca.model.workflow.activities.DelegateWorkflowActivity
+	StateName     string `json:"stateName,omitempty"`     // for activities of type org.alien4cloud.tosca.model.workflow.activities.SetStateWorkflowActivity
+	Inline        string `json:"inline,omitempty"`        // for activities of type org.alien4cloud.tosca.model.workflow.activities.InlineWorkflowActivity
+}
+
+// WorkflowStep holds a workflow step properties
+type WorkflowStep struct {
+	Name           string     `json:"name,omitempty"`
+	Target         string     `json:"target,omitempty"`
+	OperationHost  string     `json:"operationHost,omitempty"`
+	Activities     []Activity `json:"activities,omitempty"`
+	OnSuccess      []string   `json:"onSuccess,omitempty"`
+	OnFailure      []string   `json:"onFailure,omitempty"`
+	PrecedingSteps []string   `json:"precedingSteps,omitempty"`
+}
+
+// Workflow holds a workflow properties
+type Workflow struct {
+	Name        string                        `json:"name,omitempty"`
+	Description string                        `json:"description,omitempty"`
+	Metadata    map[string]string             `json:"metadata,omitempty"`
+	Inputs      map[string]PropertyDefinition `json:"inputs,omitempty"`
+	Steps       map[string]WorkflowStep       `json:"steps,omitempty"`
+	// Standard is true for workflows generated from the TOSCA lifecycle (install, uninstall, start, stop...)
+	Standard bool `json:"standard,omitempty"`
+}
+
+// Topology is the representation a topology template
+type Topology struct {
+	Data struct {
+		NodeTypes         map[string]NodeType         `json:"nodeTypes"`
+		RelationshipTypes map[string]RelationshipType `json:"relationshipTypes"`
+		CapabilityTypes   map[string]CapabilityType   `json:"capabilityTypes"`
+		Topology          struct {
+			ArchiveName             string                        `json:"archiveName"`
+			ArchiveVersion          string                        `json:"archiveVersion"`
+			Description             string                        `json:"description,omitempty"`
+			NodeTemplates           map[string]NodeTemplate       `json:"nodeTemplates"`
+			Inputs                  map[string]PropertyDefinition `json:"inputs,omitempty"`
+			InputArtifacts          map[string]DeploymentArtifact `json:"inputArtifacts,omitempty"`
+			DeployerInputProperties map[string]PropertyValue      `json:"deployerInputProperties,omitempty"`
+			UploadedInputArtifacts  map[string]DeploymentArtifact `json:"uploadedinputArtifacts,omitempty"`
+			Workflows               map[string]Workflow           `json:"workflows,omitempty"`
+		} `json:"topology"`
+		// LastOperationIndex is the index in Operations of the last operation applied by the editor, -1 if none
+		LastOperationIndex int `json:"lastOperationIndex"`
+		// Operations are the editor operations applied to the topology since it was last saved
+		Operations []TopologyEditorOperation `json:"operations,omitempty"`
+	} `json:"data"`
+}
+
+// TopologyEditorOperation is the representation of an operation applied by the topology editor
+type TopologyEditorOperation struct {
+	ID     string `json:"id"`
+	Author string `json:"author,omitempty"`
+}
+
+// InputChange describes the change of a deployment topology input value
+type InputChange struct {
+	Name string
+	// Kind is either InputAdded or InputUpdated
+	Kind    string
+	Current interface{}
+	Desired interface{}
+}
+
+// UpdateDeploymentTopologyRequest holds a request to update inputs of a deployment
+// topology
+type UpdateDeploymentTopologyRequest struct {
+	InputProperties              map[string]interface{} `json:"inputProperties,omitempty"`
+	ProviderDeploymentProperties map[string]string      `json:"providerDeploymentProperties,omitempty"`
+}
+
+type BasicTopologyInfo struct {
+	ArchiveName string
+	Workspace   string
+	ID          string
+}
+
+// ApplicationCreateRequest is the representation of a request to create an application from a topology template
+type ApplicationCreateRequest struct {
+	Name                      string `json:"name"`
+	ArchiveName               string `json:"archiveName"`
+	TopologyTemplateVersionID string `json:"topologyTemplateVersionId"`
+}
+
+// Tag tag key/value json mapping
+type Tag struct {
+	Key   string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Application represent fields of an application returned by A4C
+type Application struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Tags           []Tag  `json:"tags,omitempty"`
+	CreationDate   Time   `json:"creationDate,omitempty"`
+	LastUpdateDate Time   `json:"lastUpdateDate,omitempty"`
+}
+
+// LocationPoliciesPostRequestIn is the representation of a request to set location policies of a topology
+type LocationPoliciesPostRequestIn struct {
+	GroupsToLocations struct {
+		A4CAll string `json:"_A4C_ALL"`
+	} `json:"groupsToLocations"`
+	OrchestratorID string `json:"orchestratorId"`
+}
+
+// CustomCommand is an operation of a custom interface (not a TOSCA standard interface)
+// of a node that could be run on a deployed application
+type CustomCommand struct {
+	NodeName      string
+	InterfaceName string
+	OperationName string
+	Description   string
+	// Names of the operation inputs
+	Inputs []string
+}
+
+// CustomCommandRequest is the representation of a request to run a custom command
+type CustomCommandRequest struct {
+	NodeName      string `json:"nodeTemplateName"`
+	InterfaceName string `json:"interfaceName"`
+	OperationName string `json:"operationName"`
+	// InstanceID allows to run the command on a single instance of the node, if empty the command runs on all instances
+	InstanceID string                 `json:"instanceId,omitempty"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// ApplicationDeployRequest is the representation of a request to deploy an application in the A4C
+type ApplicationDeployRequest struct {
+	ApplicationEnvironmentID string `json:"applicationEnvironmentId"`
+	ApplicationID            string `json:"applicationId"`
+}
+
+// Informations represents information returned from a4c rest api
+type Informations struct {
+	Data map[string]map[string]struct {
+		State      string            `json:"state"`
+		Attributes map[string]string `json:"attributes"`
+	} `json:"data"`
+	Error Error `json:"error"`
+}
+
+// RuntimeTopology represents runtime topology from a4c rest api
+type RuntimeTopology struct {
+	Data struct {
+		Topology struct {
+			OutputAttributes map[string][]string
+		} `json:"topology"`
+	} `json:"data"`
+	Error Error `json:"error"`
+}
+
+// Event represents an event entry returned by the A4C REST API
+type Event struct {
+	DeploymentID         string                 `json:"deploymentId,omitempty"`
+	Date                 Time                   `json:"date,omitempty"`
+	DeploymentStatus     string                 `json:"deploymentStatus,omitempty"`
+	NodeTemplateId       string                 `json:"nodeTemplateId,omitempty"`
+	InstanceId           string                 `json:"instanceId,omitempty"`
+	InstanceState        string                 `json:"instanceState,omitempty"`
+	InstanceStatus       string                 `json:"instanceStatus,omitempty"`
+	Attributes           map[string]string      `json:"attributes,omitempty"`
+	RuntimeProperties    map[string]string      `json:"runtimeProperties,omitempty"`
+	PersistentProperties map[string]interface{} `json:"persistentProperties,omitempty"`
+	Message              string                 `json:"message,omitempty"`
+}
+
+// Log represents the log entry return by the a4c rest api
+type Log struct {
+	ID               string `json:"id"`
+	DeploymentID     string `json:"deploymentId"`
+	DeploymentPaaSID string `json:"deploymentPaaSId"`
+	Level            string `json:"level"`
+	Timestamp        Time   `json:"timestamp"`
+	WorkflowID       string `json:"workflowId"`
+	ExecutionID      string `json:"executionId"`
+	NodeID           string `json:"nodeId"`
+	InstanceID       string `json:"instanceId"`
+	InterfaceName    string `json:"interfaceName"`
+	OperationName    string `json:"operationName"`
+	Content          string `json:"content"`
+}
+
+// Logs a list of a4c logs
+type Logs []Log
+
+// UnmarshalJSON unmarshals the a4c logs
+func (l *Logs) UnmarshalJSON(b []byte) (err error) {
+
+	logs := []Log{}
+
+	if err := json.Unmarshal(b, &logs); err != nil {
+		return err
+	}
+
+	sort.Slice(logs, func(i, j int) bool {
+		return logs[i].ID < logs[j].ID
+	})
+
+	a4cLogs := Logs(logs)
+
+	*l = a4cLogs
+	return
+}
+
+// LogsSummary is a digest of a set of logs
+type LogsSummary struct {
+	// Total number of logs
+	Total int
+	// Number of logs per level (levels are lower-cased)
+	CountByLevel map[string]int
+	// First log entry with an error level, nil if there is no error
+	FirstError *Log
+	// Last log entry with an error level, nil if there is no error
+	LastError *Log
+	// Node, interface and operation of the last error
+	FailingNode      string
+	FailingInterface string
+	FailingOperation string
+}
+
+// LogFilter represents rest api A4C logs
+type LogFilter struct {
+	Level       []string `json:"level,omitempty"`
+	WorkflowID  []string `json:"workflowId,omitempty"`
+	ExecutionID []string `json:"executionId,omitempty"`
+}
+
+// WorkflowStepInstance holds properties of a workflow step instance
+type WorkflowStepInstance struct {
+	ID               string `json:"id,omitempty"`
+	StepId           string `json:"stepId,omitempty"`
+	DeploymentId     string `json:"deploymentId,omitempty"`
+	ExecutionId      string `json:"executionId,omitempty"`
+	NodeId           string `json:"nodeId,omitempty"`
+	InstanceId       string `json:"instanceId,omitempty"`
+	TargetNodeId     string `json:"targetNodeId,omitempty"`
+	TargetInstanceId string `json:"targetInstanceId,omitempty"`
+	OperationName    string `json:"operationName,omitempty"`
+	HasFailedTasks   bool   `json:"hasFailedTasks,omitempty"`
+	Status           string `json:"status,omitempty"`
+}
+
+// WorkflowExecution represents rest api workflow execution
+//
+// Step statuses and instances are normalized whatever the Alien4Cloud version: StepStatus maps step names
+// to their status and StepInstances maps step names to their instances.
+type WorkflowExecution struct {
+	Execution     Execution                         `json:"execution,omitempty"`
+	StepStatus    map[string]string                 `json:"stepStatus,omitempty"`
+	StepInstances map[string][]WorkflowStepInstance `json:"stepInstances,omitempty"`
+}
+
+// Execution hold properties of the execution of a workflow
+type Execution struct {
+	ID                  string `json:"id"`
+	DeploymentID        string `json:"deploymentId"`
+	WorkflowID          string `json:"workflowId"`
+	WorkflowName        string `json:"workflowName"`
+	DisplayWorkflowName string `json:"displayWorkflowName"`
+	Status              string `json:"status"`
+	HasFailedTasks      bool   `json:"hasFailedTasks"`
+	StartDate           Time   `json:"startDate,omitempty"`
+	EndDate             Time   `json:"endDate,omitempty"`
+}
+
+// Time represents the timestamp field from A4C
+type Time struct {
+	time.Time
+}
+
+// MarshalJSON marshals a4c json time data and return the result
+//
+// Time is marshalled as a number of milliseconds since epoch, a zero Time is marshalled as null.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	// 1 ms = 1 000 000 ns
+	return json.Marshal(t.UnixNano() / int64(1000000))
+}
+
+// UnmarshalJSON unmarshal a4c json time data and sets the Time
+//
+// Alien4Cloud dates are numbers of milliseconds since epoch, but decoding is tolerant: null values
+// lead to a zero Time, and numbers of milliseconds given as strings as well as RFC 3339 strings are accepted.
+func (t *Time) UnmarshalJSON(b []byte) (err error) {
+	if string(b) == "null" {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	var parsedTime int64
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		if s == "" {
+			t.Time = time.Time{}
+			return nil
+		}
+		if parsedTime, err = strconv.ParseInt(s, 10, 64); err != nil {
+			t.Time, err = time.Parse(time.RFC3339Nano, s)
+			return err
+		}
+	} else if err := json.Unmarshal(b, &parsedTime); err != nil {
+		var f float64
+		if json.Unmarshal(b, &f) != nil {
+			return err
+		}
+		parsedTime = int64(f)
+	}
+
+	// We try to Unmarshal data with nanoseconds precision.
+	// Because timestamp from Alien4Cloud is Millisecond, we need to initialize the time
+	// object with the number of seconds and the number of nano seconds
+	t.Time = time.Unix(parsedTime/int64(1000), (parsedTime%int64(1000))*int64(1000000))
+	return nil
+}
+
+// FacetedSearchResult allows to retrieve pagination information
+type FacetedSearchResult struct {
+	TotalResults int `json:"totalResults"`
+	From         int `json:"from"`
+	To           int `json:"to"`
+}
+
+// cancelExecRequest is the representation of a request to cancel an execution.
+type CancelExecRequest struct {
+	EnvironmentID string `json:"environmentId"`
+	ExecutionID   string `json:"executionId"`
+}
+
+// User hosts an Alien4Cloud user properties
+type User struct {
+	UserName string `json:"username"`
+	//Password  string   `json:"password,omitempty"`
+	FirstName string   `json:"firstName,omitempty"`
+	LastName  string   `json:"lastName,omitempty"`
+	Email     string   `json:"email,omitempty"`
+	Roles     []string `json:"roles,omitempty"`
+}
+
+// CreateUserRequest holds parameters of a requets to create or update a user
+type CreateUpdateUserRequest struct {
+	UserName  string   `json:"username"`
+	FirstName string   `json:"firstName,omitempty"`
+	LastName  string   `json:"lastName,omitempty"`
+	Email     string   `json:"email,omitempty"`
+	Roles     []string `json:"roles,omitempty"`
+	Password  string   `json:"password,omitempty"`
+}
+
+// Group hosts an Alien4Cloud user properties
+type Group struct {
+	ID          string   `json:"id,omitempty"`
+	Name        string   `json:"name"`
+	Email       string   `json:"email,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Users       []string `json:"users,omitempty"`
+	Roles       []string `json:"roles,omitempty"`
+}
+
+// SecurityConfiguration holds users, groups and their roles.
+// It allows to replicate a RBAC setup across Alien4Cloud instances.
+// Users passwords are never exported, a password could be set on users before
+// importing them to create them with a password.
+type SecurityConfiguration struct {
+	Users  []CreateUpdateUserRequest `json:"users,omitempty"`
+	Groups []Group                   `json:"groups,omitempty"`
+}
+
+// Environment holds properties of an Alien4Cloud environment
+type Environment struct {
+	ID                 string              `json:"id"`
+	Name               string              `json:"name"`
+	Status             string              `json:"status,omitempty"`
+	ApplicationID      string              `json:"applicationId,omitempty"`
+	CurrentVersionName string              `json:"currentVersionName,omitempty"`
+	DeployedVersion    string              `json:"deployedVersion,omitempty"`
+	Description        string              `json:"description,omitempty"`
+	EnvironmentType    string              `json:"environmentType,omitempty"`
+	UserRoles          map[string][]string `json:"userRoles,omitempty"`
+	GroupRoles         map[string][]string `json:"GroupRoles,omitempty"`
+}
+
+// GitLocation holds properties of the Git repository where the topology of an application version is stored
+type GitLocation struct {
+	ID      string `json:"id"`
+	GitType string `json:"gitType,omitempty"`
+	URL     string `json:"url"`
+	Branch  string `json:"branch,omitempty"`
+	Path    string `json:"path,omitempty"`
+	// Local is true when the topology is stored in the local Git repository of Alien4Cloud
+	Local bool `json:"local"`
+}
+
+// GitLocationUpdateRequest is the representation of a request to store the topology of an application version
+// in a remote Git repository
+type GitLocationUpdateRequest struct {
+	URL      string `json:"url"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Path     string `json:"path,omitempty"`
+	Branch   string `json:"branch,omitempty"`
+}
+
+// GitCredentials holds credentials used to pull or push a topology from or to a remote Git repository
+type GitCredentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// OrchestratorSummary holds an orchestrator and a summary of its locations
+type OrchestratorSummary struct {
+	Orchestrator
+	Locations []LocationSummary
+}
+
+// LocationSummary holds a summary of a location of an orchestrator
+type LocationSummary struct {
+	ID                 string
+	Name               string
+	InfrastructureType string
+	EnvironmentType    string
+	// OnDemandResourcesCount is the number of on-demand resources (e.g. compute flavors) defined on the location
+	OnDemandResourcesCount int
+	// ServicesCount is the number of services available on the location
+	ServicesCount int
+}
+
+// LocationResourceCreateRequest is the representation of a request to create an on-demand resource on a location
+type LocationResourceCreateRequest struct {
+	ResourceType   string `json:"resourceType"`
+	ResourceName   string `json:"resourceName"`
+	ArchiveName    string `json:"archiveName"`
+	ArchiveVersion string `json:"archiveVersion"`
+}
+
+// LocationResourceUpdateRequest is the representation of a request to update an on-demand resource of a location
+type LocationResourceUpdateRequest struct {
+	Name    string `json:"name,omitempty"`
+	Enabled *bool  `json:"enabled,omitempty"`
+}
+
+// EnvironmentCreateRequest is the representation of a request to create an application environment
+type EnvironmentCreateRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// EnvironmentType is one of OTHER, DEVELOPMENT, INTEGRATION_TESTS, USER_ACCEPTANCE_TESTS, PRE_PRODUCTION or PRODUCTION
+	EnvironmentType string `json:"environmentType"`
+	// VersionID is the ID of the topology version of the application to use, for instance "myapp:0.1.0-SNAPSHOT"
+	VersionID string `json:"versionId"`
+	// InputCandidate is the ID of an existing environment of the application to copy inputs from
+	InputCandidate string `json:"inputCandidate,omitempty"`
+}
+
+// EnvironmentUpdateRequest is the representation of a request to update an application environment,
+// empty fields are not updated
+type EnvironmentUpdateRequest struct {
+	Name            string `json:"name,omitempty"`
+	Description     string `json:"description,omitempty"`
+	EnvironmentType string `json:"environmentType,omitempty"`
+	// CurrentVersionID is the ID of the topology version of the application to use
+	CurrentVersionID string `json:"currentVersionId,omitempty"`
+}
+
+// ApplicationVersion holds properties of a version of an application
+type ApplicationVersion struct {
+	ID            string  `json:"id"`
+	ApplicationID string  `json:"applicationId"`
+	Version       string  `json:"version"`
+	NestedVersion Version `json:"nestedVersion,omitempty"`
+	Released      bool    `json:"released,omitempty"`
+	Description   string  `json:"description,omitempty"`
+	// TopologyVersions are topology versions of this application version indexed by topology version
+	TopologyVersions map[string]ApplicationTopologyVersion `json:"topologyVersions,omitempty"`
+}
+
+// ApplicationTopologyVersion holds properties of a topology version of an application version
+type ApplicationTopologyVersion struct {
+	// ArchiveID is the ID of the topology, for instance "myapp:0.1.0-blue-SNAPSHOT"
+	ArchiveID   string `json:"archiveId"`
+	Qualifier   string `json:"qualifier,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// ComponentUsage holds a resource, for instance a topology or an application, using a catalog component
+type ComponentUsage struct {
+	ResourceName string `json:"resourceName"`
+	// ResourceType is the type of the resource, for instance "topologytemplate", "application" or "csar"
+	ResourceType string `json:"resourceType"`
+	ResourceID   string `json:"resourceId"`
+	Workspace    string `json:"workspace,omitempty"`
+}
+
+// TaskFailure describes a failed task of a workflow execution
+type TaskFailure struct {
+	// StepID is the ID of the failed workflow step, it is empty when the failure is only known from logs
+	StepID     string
+	NodeID     string
+	InstanceID string
+	// TargetNodeID is the target node of a failed relationship operation
+	TargetNodeID  string
+	InterfaceName string
+	OperationName string
+	// Logs are the error logs of the failed task
+	Logs []Log
+}
+
+// FailureReport attributes failures of a workflow execution to node templates and operations
+type FailureReport struct {
+	ExecutionID  string
+	WorkflowName string
+	Failures     []TaskFailure
+}
+
+// Task holds properties of a task of a workflow execution
+type Task struct {
+	ID           string `json:"id"`
+	ExecutionID  string `json:"executionId,omitempty"`
+	DeploymentID string `json:"deploymentId,omitempty"`
+	// WorkflowStepInstanceID is the ID of the workflow step instance running the task
+	WorkflowStepInstanceID string `json:"workflowStepInstanceId,omitempty"`
+	NodeID                 string `json:"nodeId,omitempty"`
+	InstanceID             string `json:"instanceId,omitempty"`
+	TargetNodeID           string `json:"targetNodeId,omitempty"`
+	TargetInstanceID       string `json:"targetInstanceId,omitempty"`
+	OperationName          string `json:"operationName,omitempty"`
+	// Status is one of SCHEDULED, STARTED, SUCCEEDED, FAILED or CANCELLED
+	Status string `json:"status,omitempty"`
+	// ErrorMessage is the error reported by the orchestrator for a failed task, if any
+	ErrorMessage    string `json:"errorMessage,omitempty"`
+	ScheduleDate    Time   `json:"scheduleDate,omitempty"`
+	LastUpdatedDate Time   `json:"lastUpdatedDate,omitempty"`
+}
+
+// ToscaTypeDescriptor describes the structure of a TOSCA type as returned by the Alien4Cloud form descriptor API
+type ToscaTypeDescriptor struct {
+	// Type is either "complex" for data types, "array" for lists, "map" for maps, "tosca" for primitive
+	// types described by Definition or the name of a primitive type
+	Type string `json:"_type"`
+	// Definition is the property definition of a "tosca" primitive type
+	Definition *PropertyDefinition `json:"_definition,omitempty"`
+	// NotNull is true for required properties
+	NotNull bool `json:"_notNull,omitempty"`
+	// Order lists the properties of a complex type in their definition order
+	Order []string `json:"_order,omitempty"`
+	// PropertyTypes describes the properties of a complex type
+	PropertyTypes map[string]ToscaTypeDescriptor `json:"_propertyType,omitempty"`
+	// ContentType describes the entries of lists and maps
+	ContentType *ToscaTypeDescriptor `json:"_contentType,omitempty"`
+}
+
+// OrchestratorDeploymentInfo holds identifiers of a deployment on the orchestrator side
+type OrchestratorDeploymentInfo struct {
+	// DeploymentID is the ID of the deployment in Alien4Cloud
+	DeploymentID string
+	// OrchestratorDeploymentID is the ID of the deployment on the orchestrator side (also known as paasId)
+	OrchestratorDeploymentID string
+	OrchestratorID           string
+	LocationIDs              []string
+}
+
+// InputArtifactBinding holds the artifact currently bound to an input artifact of a deployment topology
+type InputArtifactBinding struct {
+	// Artifact is the uploaded artifact if any or the default artifact defined in the topology otherwise
+	Artifact DeploymentArtifact
+	// Uploaded is true if the artifact was uploaded for the deployment topology
+	Uploaded bool
+}
+
+// ComponentSearchResult holds components returned by a catalog search,
+// only the slice matching the searched component type is filled
+type ComponentSearchResult struct {
+	NodeTypes         []NodeType
+	RelationshipTypes []RelationshipType
+	PolicyTypes       []PolicyType
+	// TotalResults is the number of components matching the search request
+	TotalResults int
+}
+
+// CSARGitImportLocation is a branch of a Git repository, and optionally a sub-path of
+// this branch, from which archives are imported into the catalog
+type CSARGitImportLocation struct {
+	BranchID         string `json:"branchId"`
+	SubPath          string `json:"subPath,omitempty"`
+	LastImportedHash string `json:"lastImportedHash,omitempty"`
+}
+
+// CSARGitRepository is the representation of a Git repository from which archives are imported into the catalog
+type CSARGitRepository struct {
+	ID              string                  `json:"id"`
+	RepositoryURL   string                  `json:"repositoryUrl"`
+	Username        string                  `json:"username,omitempty"`
+	StoredLocally   bool                    `json:"storedLocally"`
+	ImportLocations []CSARGitImportLocation `json:"importLocations,omitempty"`
+}
+
+// CSARGitRepositoryCreateRequest is the representation of a request to register a Git repository
+// from which archives are imported into the catalog
+type CSARGitRepositoryCreateRequest struct {
+	RepositoryURL   string                  `json:"repositoryUrl"`
+	Username        string                  `json:"username,omitempty"`
+	Password        string                  `json:"password,omitempty"`
+	StoredLocally   bool                    `json:"storedLocally"`
+	ImportLocations []CSARGitImportLocation `json:"importLocations"`
+}
+
+// StepTimeline holds the timing of a workflow step in an execution
+type StepTimeline struct {
+	StepID string
+	// Status is the status of the step, one of StepStarted, StepCompletedSuccessfull or StepCompletedWithError
+	Status string
+	// Start is the schedule date of the first task of the step, zero if unknown
+	Start time.Time
+	// End is the last update date of the last task of the step, zero if unknown or if the step is still running
+	End time.Time
+	// Duration is the duration of the step, zero if its start or end is unknown
+	Duration time.Duration
+	// Critical is true if the step is part of the critical path of the execution
+	Critical bool
+}
+
+// ExecutionTimeline holds the timing of the steps of a workflow execution,
+// suitable for rendering Gantt-style views
+type ExecutionTimeline struct {
+	ExecutionID  string
+	WorkflowName string
+	Start        time.Time
+	End          time.Time
+	// Duration is the duration of the execution, zero if it is still running
+	Duration time.Duration
+	// Steps are sorted by start date, steps with an unknown start date come last
+	Steps []StepTimeline
+	// CriticalPath lists the IDs of the chain of steps which determined the execution duration, in execution order
+	CriticalPath []string
+}
+
+// RuntimeWorkflow describes a workflow available on a deployed topology
+type RuntimeWorkflow struct {
+	Name        string
+	Description string
+	Metadata    map[string]string
+	Inputs      map[string]PropertyDefinition
+	// Standard is true for workflows generated from the TOSCA lifecycle (install, uninstall, start, stop...)
+	Standard bool
+	// Launchable is true if the workflow could be run right now
+	Launchable bool
+	// NotLaunchableReason explains why the workflow could not be run right now
+	NotLaunchableReason string
+}
+
+// DeploymentSubstitutions holds location resources that could substitute nodes of a deployment topology
+type DeploymentSubstitutions struct {
+	// AvailableSubstitutions maps node names to the IDs of location resources that could substitute them
+	AvailableSubstitutions map[string][]string `json:"availableSubstitutions,omitempty"`
+	// SubstitutionsTemplates maps location resources IDs to location resources
+	SubstitutionsTemplates map[string]LocationResourceTemplate `json:"substitutionsTemplates,omitempty"`
+}
+
+// LocationPlacementPolicy is the representation of a policy placing a group of nodes on a location
+type LocationPlacementPolicy struct {
+	Name       string `json:"name,omitempty"`
+	Type       string `json:"type,omitempty"`
+	LocationID string `json:"locationId,omitempty"`
+}
+
+// LocationGroup is the representation of a group of nodes of a deployment topology placed on a location
+type LocationGroup struct {
+	Name     string                    `json:"name"`
+	Members  []string                  `json:"members,omitempty"`
+	Policies []LocationPlacementPolicy `json:"policies,omitempty"`
+}
+
+// TopologyTask is the representation of a task to complete, or a warning or information, reported by the validation of a topology
+type TopologyTask struct {
+	// Code identifies the kind of task, for instance INPUT_PROPERTY, NODE_FILTER_INVALID or NO_NODE_MATCHES
+	Code             string `json:"code"`
+	NodeTemplateName string `json:"nodeTemplateName,omitempty"`
+	GroupName        string `json:"groupName,omitempty"`
+}
+
+// TopologyValidationResult is the representation of the result of the validation of a topology
+type TopologyValidationResult struct {
+	// Valid is true if the topology could be deployed
+	Valid bool `json:"valid"`
+	// TaskList lists tasks to complete before the topology could be deployed
+	TaskList    []TopologyTask `json:"taskList,omitempty"`
+	WarningList []TopologyTask `json:"warningList,omitempty"`
+	InfoList    []TopologyTask `json:"infoList,omitempty"`
+}
+
+// DeploymentTopologyDTO is the representation of the deployment topology of an application environment with
+// the deployment sections computed by Alien4Cloud
+type DeploymentTopologyDTO struct {
+	Topology struct {
+		ID                      string                        `json:"id"`
+		ArchiveName             string                        `json:"archiveName"`
+		ArchiveVersion          string                        `json:"archiveVersion"`
+		Description             string                        `json:"description,omitempty"`
+		EnvironmentID           string                        `json:"environmentId,omitempty"`
+		VersionID               string                        `json:"versionId,omitempty"`
+		InitialTopologyID       string                        `json:"initialTopologyId,omitempty"`
+		OrchestratorID          string                        `json:"orchestratorId,omitempty"`
+		NodeTemplates           map[string]NodeTemplate       `json:"nodeTemplates"`
+		Inputs                  map[string]PropertyDefinition `json:"inputs,omitempty"`
+		InputArtifacts          map[string]DeploymentArtifact `json:"inputArtifacts,omitempty"`
+		DeployerInputProperties map[string]PropertyValue      `json:"deployerInputProperties,omitempty"`
+		UploadedInputArtifacts  map[string]DeploymentArtifact `json:"uploadedinputArtifacts,omitempty"`
+		Workflows               map[string]Workflow           `json:"workflows,omitempty"`
+		// LocationGroups are the groups of nodes placed on a location
+		LocationGroups map[string]LocationGroup `json:"locationGroups,omitempty"`
+		// SubstitutedNodes maps names of substituted nodes to the ID of the location resource substituting them
+		SubstitutedNodes map[string]string `json:"substitutedNodes,omitempty"`
+		// OriginalNodes are the node templates of the topology before their substitution
+		OriginalNodes map[string]NodeTemplate `json:"originalNodes,omitempty"`
+	} `json:"topology"`
+	NodeTypes         map[string]NodeType         `json:"nodeTypes,omitempty"`
+	RelationshipTypes map[string]RelationshipType `json:"relationshipTypes,omitempty"`
+	CapabilityTypes   map[string]CapabilityType   `json:"capabilityTypes,omitempty"`
+	// LocationPolicies maps location groups names to the ID of the location they are placed on
+	LocationPolicies map[string]string `json:"locationPolicies,omitempty"`
+	// Validation is the result of the validation of the deployment topology
+	Validation TopologyValidationResult `json:"validation"`
+	// AvailableSubstitutions are location resources that could substitute nodes of the deployment topology
+	AvailableSubstitutions DeploymentSubstitutions `json:"availableSubstitutions"`
+}
+
+// Workspace holds properties of a workspace in which archives are stored (premium feature)
+type Workspace struct {
+	ID    string `json:"id"`
+	Name  string `json:"name,omitempty"`
+	Scope string `json:"scope,omitempty"`
+	// Roles are the workspace roles of the logged in user
+	Roles []string `json:"roles,omitempty"`
+}
+
+// PromotionRequest holds a request to promote an archive from a workspace to another one (premium feature)
+type PromotionRequest struct {
+	ID               string `json:"id,omitempty"`
+	CSARName         string `json:"csarName"`
+	CSARVersion      string `json:"csarVersion"`
+	CurrentWorkspace string `json:"currentWorkspace,omitempty"`
+	TargetWorkspace  string `json:"targetWorkspace"`
+	RequestUser      string `json:"requestUser,omitempty"`
+	RequestDate      Time   `json:"requestDate,omitempty"`
+	ProcessUser      string `json:"processUser,omitempty"`
+	ProcessDate      Time   `json:"processDate,omitempty"`
+	// Status is one of PromotionStatusInit, PromotionStatusAccepted or PromotionStatusRefused
+	Status string `json:"status,omitempty"`
+}
+
+// NodeOperationDefinition describes an operation available on a node template
+type NodeOperationDefinition struct {
+	InterfaceName          string                  `json:"interfaceName"`
+	OperationName          string                  `json:"operationName"`
+	Description            string                  `json:"description,omitempty"`
+	InputParameters        map[string]interface{}  `json:"inputParameters,omitempty"`
+	ImplementationArtifact *ImplementationArtifact `json:"implementationArtifact,omitempty"`
+	// DefinedBy is the name of the node type, or node template, providing this definition of the operation
+	DefinedBy string `json:"definedBy"`
+}
@@ -0,0 +1,69 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestTime_UnmarshalJSON(t *testing.T) {
+	expected := time.Unix(1620659921, 608000000)
+	tests := []struct {
+		name    string
+		data    string
+		want    time.Time
+		wantErr bool
+	}{
+		{"Milliseconds", `1620659921608`, expected, false},
+		{"FloatMilliseconds", `1.620659921608e+12`, expected, false},
+		{"StringMilliseconds", `"1620659921608"`, expected, false},
+		{"RFC3339", `"2021-05-10T15:18:41.608Z"`, expected, false},
+		{"Null", `null`, time.Time{}, false},
+		{"EmptyString", `""`, time.Time{}, false},
+		{"InvalidString", `"yesterday"`, time.Time{}, true},
+		{"InvalidType", `true`, time.Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Time
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if tt.wantErr {
+				assert.Assert(t, err != nil)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Assert(t, got.Equal(tt.want), "got %v, want %v", got, tt.want)
+		})
+	}
+}
+
+func TestTime_MarshalJSON(t *testing.T) {
+	b, err := json.Marshal(Time{Time: time.Unix(1620659921, 608000000)})
+	assert.NilError(t, err)
+	assert.Equal(t, string(b), "1620659921608")
+
+	b, err = json.Marshal(Time{})
+	assert.NilError(t, err)
+	assert.Equal(t, string(b), "null")
+
+	var location LocationConfiguration
+	assert.NilError(t, json.Unmarshal([]byte(`{"id":"loc","creationDate":1620659921608,"lastUpdateDate":null}`), &location))
+	assert.Assert(t, location.CreationDate.Equal(time.Unix(1620659921, 608000000)))
+	assert.Assert(t, location.LastUpdateDate.IsZero())
+}
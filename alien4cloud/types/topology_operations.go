@@ -0,0 +1,28 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// LastOperationID returns the ID of the last operation applied by the topology editor
+// on this topology, or an empty string if the topology has no pending operation.
+//
+// It is the version token to use as TopologyEditorContext PreviousOperationID to
+// edit the topology from this state.
+func (t *Topology) LastOperationID() string {
+	index := t.Data.LastOperationIndex
+	if index < 0 || index >= len(t.Data.Operations) {
+		return ""
+	}
+	return t.Data.Operations[index].ID
+}
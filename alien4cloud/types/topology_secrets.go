@@ -0,0 +1,107 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "sort"
+
+// SecretProperties returns a map of node template names to the names of their properties
+// defined as passwords by the node type.
+//
+// Node types should be part of the topology which is the case for topologies returned by
+// TopologyService.GetTopology() and ApplicationService.GetDeploymentTopology().
+func (t *Topology) SecretProperties() map[string][]string {
+	secrets := make(map[string][]string)
+	for nodeName, node := range t.Data.Topology.NodeTemplates {
+		nodeType, ok := t.Data.NodeTypes[node.Type]
+		if !ok {
+			continue
+		}
+		for _, prop := range nodeType.Properties {
+			if prop.Value.Password {
+				secrets[nodeName] = append(secrets[nodeName], prop.Key)
+			}
+		}
+		sort.Strings(secrets[nodeName])
+	}
+	return secrets
+}
+
+// SecretInputs returns the sorted names of topology inputs defined as passwords
+func (t *Topology) SecretInputs() []string {
+	var secrets []string
+	for name, input := range t.Data.Topology.Inputs {
+		if input.Password {
+			secrets = append(secrets, name)
+		}
+	}
+	sort.Strings(secrets)
+	return secrets
+}
+
+// MaskSecrets replaces values of secret node template properties and secret deployer input properties by SecretMask.
+// Values that are functions (for instance a get_input) are kept as they do not disclose secrets.
+func (t *Topology) MaskSecrets() {
+	t.filterSecrets(func(pv *PropertyValue) bool {
+		if pv.Value != nil {
+			pv.Value = SecretMask
+		}
+		return true
+	})
+}
+
+// ExcludeSecrets removes secret node template properties and secret deployer input properties from the topology.
+// Values that are functions (for instance a get_input) are kept as they do not disclose secrets.
+func (t *Topology) ExcludeSecrets() {
+	t.filterSecrets(func(pv *PropertyValue) bool {
+		return pv.Value == nil
+	})
+}
+
+// filterSecrets calls keep on each secret property value, the property is removed if keep returns false
+func (t *Topology) filterSecrets(keep func(pv *PropertyValue) bool) {
+	for nodeName, secrets := range t.SecretProperties() {
+		node := t.Data.Topology.NodeTemplates[nodeName]
+		var props []NodeTemplatePropertyValue
+		for _, prop := range node.Properties {
+			if containsString(secrets, prop.Key) && !keep(&prop.Value) {
+				continue
+			}
+			props = append(props, prop)
+		}
+		node.Properties = props
+		t.Data.Topology.NodeTemplates[nodeName] = node
+	}
+
+	for _, name := range t.SecretInputs() {
+		pv, ok := t.Data.Topology.DeployerInputProperties[name]
+		if !ok {
+			continue
+		}
+		if keep(&pv) {
+			t.Data.Topology.DeployerInputProperties[name] = pv
+		} else {
+			delete(t.Data.Topology.DeployerInputProperties, name)
+		}
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+const topologyWithSecrets = `{"data":{
+	"nodeTypes":{"org.DB":{"elementId":"org.DB","properties":[
+		{"key":"user","value":{"type":"string"}},
+		{"key":"password","value":{"type":"string","password":true}},
+		{"key":"token","value":{"type":"string","password":true}}
+	]}},
+	"topology":{
+		"nodeTemplates":{"DB":{"name":"DB","type":"org.DB","properties":[
+			{"key":"user","value":{"value":"admin"}},
+			{"key":"password","value":{"value":"s3cr3t"}},
+			{"key":"token","value":{"function":"get_input","parameters":["token"]}}
+		]}},
+		"inputs":{"token":{"type":"string","password":true},"size":{"type":"integer"}},
+		"deployerInputProperties":{"token":{"value":"t0k3n"},"size":{"value":3}}
+	}
+}}`
+
+func newTopologyWithSecrets(t *testing.T) *Topology {
+	topology := new(Topology)
+	assert.NilError(t, json.Unmarshal([]byte(topologyWithSecrets), topology))
+	return topology
+}
+
+func TestTopology_SecretProperties(t *testing.T) {
+	topology := newTopologyWithSecrets(t)
+	assert.DeepEqual(t, topology.SecretProperties(), map[string][]string{"DB": {"password", "token"}})
+	assert.DeepEqual(t, topology.SecretInputs(), []string{"token"})
+}
+
+func TestTopology_MaskSecrets(t *testing.T) {
+	topology := newTopologyWithSecrets(t)
+	topology.MaskSecrets()
+
+	props := topology.Data.Topology.NodeTemplates["DB"].Properties
+	assert.Equal(t, len(props), 3)
+	assert.Equal(t, props[0].Value.Value, "admin")
+	assert.Equal(t, props[1].Value.Value, SecretMask)
+	assert.Equal(t, props[2].Value.Function, FunctionGetInput)
+	assert.Equal(t, topology.Data.Topology.DeployerInputProperties["token"].Value, SecretMask)
+	assert.Equal(t, topology.Data.Topology.DeployerInputProperties["size"].Value, float64(3))
+}
+
+func TestTopology_ExcludeSecrets(t *testing.T) {
+	topology := newTopologyWithSecrets(t)
+	topology.ExcludeSecrets()
+
+	props := topology.Data.Topology.NodeTemplates["DB"].Properties
+	assert.Equal(t, len(props), 2)
+	assert.Equal(t, props[0].Key, "user")
+	assert.Equal(t, props[1].Key, "token")
+	_, ok := topology.Data.Topology.DeployerInputProperties["token"]
+	assert.Assert(t, !ok)
+	assert.Equal(t, len(topology.Data.Topology.DeployerInputProperties), 1)
+}
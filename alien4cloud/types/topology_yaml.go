@@ -0,0 +1,314 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// toscaDefinitionsVersion is the TOSCA definitions version of YAML documents rendered by Topology.TOSCAYAML()
+const toscaDefinitionsVersion = "alien_dsl_2_0_0"
+
+// TOSCAYAML renders the topology as a TOSCA YAML document.
+//
+// Rendering is best-effort and aims at documentation and reviews of topology changes: imports are
+// computed from archives of node types used by the topology and only fields known by the Topology
+// struct are rendered. Map entries are sorted so that renderings of a topology are stable and could be diffed.
+func (t *Topology) TOSCAYAML() string {
+	topology := t.Data.Topology
+	doc := yamlMap{{"tosca_definitions_version", toscaDefinitionsVersion}}
+	doc = doc.add("metadata", yamlMap{
+		{"template_name", topology.ArchiveName},
+		{"template_version", topology.ArchiveVersion},
+	})
+	if topology.Description != "" {
+		doc = doc.add("description", topology.Description)
+	}
+	if imports := t.toscaImports(); len(imports) > 0 {
+		doc = doc.add("imports", imports)
+	}
+
+	var template yamlMap
+	if len(topology.Inputs) > 0 {
+		inputs := yamlMap{}
+		for _, name := range sortedKeys(topology.Inputs) {
+			inputs = inputs.add(name, toscaPropertyDefinition(topology.Inputs[name]))
+		}
+		template = template.add("inputs", inputs)
+	}
+	nodes := yamlMap{}
+	for _, name := range sortedKeys(topology.NodeTemplates) {
+		node := topology.NodeTemplates[name]
+		n := yamlMap{{"type", node.Type}}
+		if len(node.Properties) > 0 {
+			props := yamlMap{}
+			for _, prop := range node.Properties {
+				props = props.add(prop.Key, toscaPropertyValue(prop.Value))
+			}
+			n = n.add("properties", props)
+		}
+		nodes = nodes.add(name, n)
+	}
+	template = template.add("node_templates", nodes)
+	if len(topology.Workflows) > 0 {
+		workflows := yamlMap{}
+		for _, name := range sortedKeys(topology.Workflows) {
+			workflows = workflows.add(name, toscaWorkflow(topology.Workflows[name]))
+		}
+		template = template.add("workflows", workflows)
+	}
+	doc = doc.add("topology_template", template)
+
+	var b strings.Builder
+	writeYAML(&b, 0, doc)
+	return b.String()
+}
+
+// toscaImports returns sorted archives of node types used by node templates
+func (t *Topology) toscaImports() []interface{} {
+	archives := make(map[string]bool)
+	for _, node := range t.Data.Topology.NodeTemplates {
+		if nodeType, ok := t.Data.NodeTypes[node.Type]; ok && nodeType.ArchiveName != "" {
+			archives[nodeType.ArchiveName+":"+nodeType.ArchiveVersion] = true
+		}
+	}
+	imports := make([]interface{}, 0, len(archives))
+	for _, archive := range sortedKeys(archives) {
+		imports = append(imports, archive)
+	}
+	return imports
+}
+
+func toscaPropertyDefinition(def PropertyDefinition) yamlMap {
+	d := yamlMap{{"type", def.Type}}
+	if def.EntrySchema.Type != "" {
+		d = d.add("entry_schema", yamlMap{{"type", def.EntrySchema.Type}})
+	}
+	d = d.add("required", def.Required)
+	if v := toscaPropertyValue(def.DefaultValue); v != nil {
+		d = d.add("default", v)
+	}
+	if def.Description != "" {
+		d = d.add("description", def.Description)
+	}
+	return d
+}
+
+// toscaPropertyValue returns the TOSCA representation of a property value, functions are rendered
+// as maps of the function name to its parameters
+func toscaPropertyValue(pv PropertyValue) interface{} {
+	switch {
+	case pv.Function != "":
+		if len(pv.Parameters) == 1 {
+			return yamlMap{{pv.Function, pv.Parameters[0]}}
+		}
+		return yamlMap{{pv.Function, pv.Parameters}}
+	case pv.FunctionConcat != "":
+		return yamlMap{{pv.FunctionConcat, pv.Parameters}}
+	}
+	return pv.Value
+}
+
+func toscaWorkflow(wf Workflow) yamlMap {
+	w := yamlMap{}
+	if wf.Description != "" {
+		w = w.add("description", wf.Description)
+	}
+	if len(wf.Inputs) > 0 {
+		inputs := yamlMap{}
+		for _, name := range sortedKeys(wf.Inputs) {
+			inputs = inputs.add(name, toscaPropertyDefinition(wf.Inputs[name]))
+		}
+		w = w.add("inputs", inputs)
+	}
+	steps := yamlMap{}
+	for _, name := range sortedKeys(wf.Steps) {
+		step := wf.Steps[name]
+		s := yamlMap{}
+		if step.Target != "" {
+			s = s.add("target", step.Target)
+		}
+		if step.OperationHost != "" {
+			s = s.add("operation_host", step.OperationHost)
+		}
+		activities := make([]interface{}, 0, len(step.Activities))
+		for _, activity := range step.Activities {
+			activities = append(activities, toscaActivity(activity))
+		}
+		s = s.add("activities", activities)
+		if len(step.OnSuccess) > 0 {
+			s = s.add("on_success", stringsToInterfaces(step.OnSuccess))
+		}
+		if len(step.OnFailure) > 0 {
+			s = s.add("on_failure", stringsToInterfaces(step.OnFailure))
+		}
+		steps = steps.add(name, s)
+	}
+	return w.add("steps", steps)
+}
+
+func toscaActivity(activity Activity) yamlMap {
+	switch {
+	case activity.OperationName != "":
+		return yamlMap{{"call_operation", activity.InterfaceName + "." + activity.OperationName}}
+	case activity.StateName != "":
+		return yamlMap{{"set_state", activity.StateName}}
+	case activity.Delegate != "":
+		return yamlMap{{"delegate", activity.Delegate}}
+	case activity.Inline != "":
+		return yamlMap{{"inline", activity.Inline}}
+	}
+	return yamlMap{{"type", activity.Type}}
+}
+
+func stringsToInterfaces(s []string) []interface{} {
+	res := make([]interface{}, len(s))
+	for i := range s {
+		res[i] = s[i]
+	}
+	return res
+}
+
+// sortedKeys returns sorted keys of a map with string keys
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]PropertyDefinition:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]NodeTemplate:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]Workflow:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]WorkflowStep:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]bool:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]interface{}:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// yamlMap is a YAML mapping keeping entries order
+type yamlMap []yamlEntry
+
+type yamlEntry struct {
+	key   string
+	value interface{}
+}
+
+func (m yamlMap) add(key string, value interface{}) yamlMap {
+	return append(m, yamlEntry{key, value})
+}
+
+// writeYAML writes a value at the given indentation, mappings and sequences are written as blocks
+func writeYAML(b *strings.Builder, indent int, value interface{}) {
+	prefix := strings.Repeat(" ", indent)
+	switch v := value.(type) {
+	case yamlMap:
+		for _, entry := range v {
+			writeYAMLEntry(b, indent, entry.key, entry.value)
+		}
+	case map[string]interface{}:
+		for _, key := range sortedKeys(v) {
+			writeYAMLEntry(b, indent, key, v[key])
+		}
+	case []interface{}:
+		for _, item := range v {
+			if isYAMLScalar(item) {
+				fmt.Fprintf(b, "%s- %s\n", prefix, yamlScalar(item))
+				continue
+			}
+			// Render the item as a block and replace the indentation of its first line by the sequence indicator
+			var itemBuilder strings.Builder
+			writeYAML(&itemBuilder, indent+2, item)
+			b.WriteString(prefix + "- " + strings.TrimPrefix(itemBuilder.String(), prefix+"  "))
+		}
+	default:
+		fmt.Fprintf(b, "%s%s\n", prefix, yamlScalar(v))
+	}
+}
+
+func writeYAMLEntry(b *strings.Builder, indent int, key string, value interface{}) {
+	prefix := strings.Repeat(" ", indent)
+	if isYAMLScalar(value) {
+		fmt.Fprintf(b, "%s%s: %s\n", prefix, yamlScalar(key), yamlScalar(value))
+		return
+	}
+	fmt.Fprintf(b, "%s%s:\n", prefix, yamlScalar(key))
+	writeYAML(b, indent+2, value)
+}
+
+// isYAMLScalar returns true if a value is rendered inline, empty mappings and sequences are rendered inline using the flow style
+func isYAMLScalar(value interface{}) bool {
+	switch v := value.(type) {
+	case yamlMap:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return true
+}
+
+// yamlPlainString matches strings that could be written without quotes
+var yamlPlainString = regexp.MustCompile(`^[A-Za-z_/.][A-Za-z0-9_ /.:()=+-]*$`)
+
+// yamlReserved are plain strings that would not be read as strings
+var yamlReserved = regexp.MustCompile(`^(?i:y|yes|n|no|true|false|on|off|null|~)$`)
+
+func yamlScalar(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case yamlMap, map[string]interface{}:
+		return "{}"
+	case []interface{}:
+		return "[]"
+	case string:
+		if !yamlPlainString.MatchString(v) || yamlReserved.MatchString(v) || strings.Contains(v, ": ") ||
+			strings.HasSuffix(v, " ") || strings.HasSuffix(v, ":") {
+			return strconv.Quote(v)
+		}
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32)
+	case int, int32, int64, uint, uint32, uint64:
+		return fmt.Sprint(v)
+	}
+	return strconv.Quote(fmt.Sprint(value))
+}
@@ -0,0 +1,106 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestTopology_TOSCAYAML(t *testing.T) {
+	var topology Topology
+	err := json.Unmarshal([]byte(`{"data":{
+	"nodeTypes":{
+		"org.DB":{"elementId":"org.DB","archiveName":"org.db","archiveVersion":"1.0.0"},
+		"tosca.nodes.Compute":{"elementId":"tosca.nodes.Compute","archiveName":"tosca-normative-types","archiveVersion":"1.0.0-ALIEN20"}
+	},
+	"topology":{
+		"archiveName":"shop","archiveVersion":"0.1.0-SNAPSHOT","description":"Shop: a web shop",
+		"nodeTemplates":{
+			"DB":{"name":"DB","type":"org.DB","properties":[
+				{"key":"user","value":{"value":"admin"}},
+				{"key":"port","value":{"value":5432}},
+				{"key":"options","value":{"value":{"ssl":true,"modes":["a","b"]}}},
+				{"key":"empty","value":{"value":""}},
+				{"key":"password","value":{"function":"get_input","parameters":["password"]}},
+				{"key":"url","value":{"function_concat":"concat","parameters":["http://",{"function":"get_attribute"}]}}
+			]},
+			"Compute":{"name":"Compute","type":"tosca.nodes.Compute"}
+		},
+		"inputs":{"password":{"type":"string","required":true,"password":true},"size":{"type":"list","entrySchema":{"type":"integer"},"default":{"value":"yes"}}},
+		"workflows":{"install":{"name":"install","steps":{
+			"DB_start":{"name":"DB_start","target":"DB","activities":[{"interfaceName":"tosca.interfaces.node.lifecycle.Standard","operationName":"start"}],"onSuccess":["DB_started"]},
+			"DB_started":{"name":"DB_started","target":"DB","activities":[{"stateName":"started"}]}
+		}}}
+	}
+}}`), &topology)
+	assert.NilError(t, err)
+
+	assert.Equal(t, topology.TOSCAYAML(), `tosca_definitions_version: alien_dsl_2_0_0
+metadata:
+  template_name: shop
+  template_version: "0.1.0-SNAPSHOT"
+description: "Shop: a web shop"
+imports:
+  - org.db:1.0.0
+  - tosca-normative-types:1.0.0-ALIEN20
+topology_template:
+  inputs:
+    password:
+      type: string
+      required: true
+    size:
+      type: list
+      entry_schema:
+        type: integer
+      required: false
+      default: "yes"
+  node_templates:
+    Compute:
+      type: tosca.nodes.Compute
+    DB:
+      type: org.DB
+      properties:
+        user: admin
+        port: 5432
+        options:
+          modes:
+            - a
+            - b
+          ssl: true
+        empty: ""
+        password:
+          get_input: password
+        url:
+          concat:
+            - http://
+            - function: get_attribute
+  workflows:
+    install:
+      steps:
+        DB_start:
+          target: DB
+          activities:
+            - call_operation: tosca.interfaces.node.lifecycle.Standard.start
+          on_success:
+            - DB_started
+        DB_started:
+          target: DB
+          activities:
+            - set_state: started
+`)
+}
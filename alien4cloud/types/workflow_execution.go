@@ -0,0 +1,125 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// workflowStepStatus is a step status as reported by Alien4Cloud 3.x
+type workflowStepStatus struct {
+	StepID string `json:"stepId"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// UnmarshalJSON unmarshals a workflow execution returned by the workflow_execution endpoint.
+//
+// Alien4Cloud 2.x reports step statuses as a map of step names to statuses and step instances as a map
+// of step names to instances, while Alien4Cloud 3.x reports step statuses as objects (either in a map
+// or in a list) and may report step instances as a flat list. Both shapes are decoded into the same model.
+func (we *WorkflowExecution) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		Execution     Execution       `json:"execution,omitempty"`
+		StepStatus    json.RawMessage `json:"stepStatus,omitempty"`
+		StepInstances json.RawMessage `json:"stepInstances,omitempty"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	stepStatus, err := decodeStepStatus(raw.StepStatus)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode workflow execution steps statuses")
+	}
+	stepInstances, err := decodeStepInstances(raw.StepInstances)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode workflow execution steps instances")
+	}
+
+	*we = WorkflowExecution{
+		Execution:     raw.Execution,
+		StepStatus:    stepStatus,
+		StepInstances: stepInstances,
+	}
+	return nil
+}
+
+func isJSONNull(b json.RawMessage) bool {
+	return len(b) == 0 || string(b) == "null"
+}
+
+// decodeStepStatus decodes steps statuses either as a map of statuses, a map of status objects
+// or a list of status objects
+func decodeStepStatus(b json.RawMessage) (map[string]string, error) {
+	if isJSONNull(b) {
+		return nil, nil
+	}
+
+	var list []workflowStepStatus
+	if err := json.Unmarshal(b, &list); err == nil {
+		res := make(map[string]string, len(list))
+		for _, s := range list {
+			name := s.StepID
+			if name == "" {
+				name = s.Name
+			}
+			res[name] = s.Status
+		}
+		return res, nil
+	}
+
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(b, &values); err != nil {
+		return nil, err
+	}
+	res := make(map[string]string, len(values))
+	for name, v := range values {
+		var status string
+		if err := json.Unmarshal(v, &status); err == nil {
+			res[name] = status
+			continue
+		}
+		var s workflowStepStatus
+		if err := json.Unmarshal(v, &s); err != nil {
+			return nil, errors.Wrapf(err, "unexpected status for step %q", name)
+		}
+		res[name] = s.Status
+	}
+	return res, nil
+}
+
+// decodeStepInstances decodes steps instances either as a map of instances per step or as a list
+// of instances grouped by step
+func decodeStepInstances(b json.RawMessage) (map[string][]WorkflowStepInstance, error) {
+	if isJSONNull(b) {
+		return nil, nil
+	}
+
+	var list []WorkflowStepInstance
+	if err := json.Unmarshal(b, &list); err == nil {
+		res := make(map[string][]WorkflowStepInstance)
+		for _, instance := range list {
+			res[instance.StepId] = append(res[instance.StepId], instance)
+		}
+		return res, nil
+	}
+
+	var res map[string][]WorkflowStepInstance
+	err := json.Unmarshal(b, &res)
+	return res, err
+}
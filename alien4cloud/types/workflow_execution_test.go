@@ -0,0 +1,78 @@
+// Copyright 2020 Bull S.A.S. Atos Technologies - Bull, Rue Jean Jaures, B.P.68, 78340, Les Clayes-sous-Bois, France.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestWorkflowExecution_UnmarshalJSON(t *testing.T) {
+	expected := WorkflowExecution{
+		Execution:  Execution{ID: "exec", WorkflowName: "install", Status: "SUCCEEDED"},
+		StepStatus: map[string]string{"Compute_install": "COMPLETED", "Web_start": "STARTED"},
+		StepInstances: map[string][]WorkflowStepInstance{
+			"Compute_install": {{ID: "i1", StepId: "Compute_install", NodeId: "Compute", Status: "COMPLETED"}},
+			"Web_start": {
+				{ID: "i2", StepId: "Web_start", NodeId: "Web", InstanceId: "0", Status: "STARTED"},
+				{ID: "i3", StepId: "Web_start", NodeId: "Web", InstanceId: "1", Status: "STARTED"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		json string
+	}{
+		{"A4C2Shape", `{"execution":{"id":"exec","workflowName":"install","status":"SUCCEEDED"},
+			"stepStatus":{"Compute_install":"COMPLETED","Web_start":"STARTED"},
+			"stepInstances":{"Compute_install":[{"id":"i1","stepId":"Compute_install","nodeId":"Compute","status":"COMPLETED"}],
+			"Web_start":[{"id":"i2","stepId":"Web_start","nodeId":"Web","instanceId":"0","status":"STARTED"},
+			{"id":"i3","stepId":"Web_start","nodeId":"Web","instanceId":"1","status":"STARTED"}]}}`},
+		{"A4C3MapShape", `{"execution":{"id":"exec","workflowName":"install","status":"SUCCEEDED"},
+			"stepStatus":{"Compute_install":{"status":"COMPLETED"},"Web_start":{"status":"STARTED"}},
+			"stepInstances":[{"id":"i1","stepId":"Compute_install","nodeId":"Compute","status":"COMPLETED"},
+			{"id":"i2","stepId":"Web_start","nodeId":"Web","instanceId":"0","status":"STARTED"},
+			{"id":"i3","stepId":"Web_start","nodeId":"Web","instanceId":"1","status":"STARTED"}]}`},
+		{"A4C3ListShape", `{"execution":{"id":"exec","workflowName":"install","status":"SUCCEEDED"},
+			"stepStatus":[{"stepId":"Compute_install","status":"COMPLETED"},{"name":"Web_start","status":"STARTED"}],
+			"stepInstances":[{"id":"i1","stepId":"Compute_install","nodeId":"Compute","status":"COMPLETED"},
+			{"id":"i2","stepId":"Web_start","nodeId":"Web","instanceId":"0","status":"STARTED"},
+			{"id":"i3","stepId":"Web_start","nodeId":"Web","instanceId":"1","status":"STARTED"}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var we WorkflowExecution
+			assert.NilError(t, json.Unmarshal([]byte(tt.json), &we))
+			assert.DeepEqual(t, we, expected)
+
+			// Normalized model is encoded using the 2.x shape and decoded back as is
+			b, err := json.Marshal(we)
+			assert.NilError(t, err)
+			var decoded WorkflowExecution
+			assert.NilError(t, json.Unmarshal(b, &decoded))
+			assert.DeepEqual(t, decoded, expected)
+		})
+	}
+
+	var we WorkflowExecution
+	assert.NilError(t, json.Unmarshal([]byte(`{"execution":{"id":"exec"}}`), &we))
+	assert.Assert(t, we.StepStatus == nil && we.StepInstances == nil)
+
+	err := json.Unmarshal([]byte(`{"stepStatus":{"Compute_install":42}}`), &we)
+	assert.ErrorContains(t, err, "failed to decode workflow execution steps statuses")
+}
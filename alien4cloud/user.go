@@ -59,6 +59,11 @@ type UserService interface {
 	SearchGroups(ctx context.Context, searchRequest SearchRequest) ([]Group, int, error)
 	// DeleteGroup deletes a group
 	DeleteGroup(ctx context.Context, groupID string) error
+
+	// ExportSecurityConfiguration returns users (without passwords), groups, group memberships and roles
+	ExportSecurityConfiguration(ctx context.Context) (SecurityConfiguration, error)
+	// ImportSecurityConfiguration creates or updates users and groups defined in the given configuration
+	ImportSecurityConfiguration(ctx context.Context, cfg SecurityConfiguration) error
 }
 
 type userService struct {
@@ -68,6 +73,9 @@ type userService struct {
 const (
 	userEndpointFormat  = "%s/users/%s"
 	groupEndpointFormat = "%s/groups/%s"
+
+	// securitySearchPageSize is the number of users or groups retrieved per request on export
+	securitySearchPageSize = 100
 )
 
 // CreateUser creates a user
@@ -178,7 +186,7 @@ func (u *userService) GetUsers(ctx context.Context, userNames []string) ([]User,
 // SearchUsers searches for users and returns an array of users as well as the
 // total number of users matching the search request
 func (u *userService) SearchUsers(ctx context.Context, searchRequest SearchRequest) ([]User, int, error) {
-	req, err := json.Marshal(searchRequest)
+	req, err := json.Marshal(paginate(searchRequest))
 	if err != nil {
 		return nil, 0, errors.Wrap(err, "Unable to marshal search request")
 	}
@@ -206,7 +214,10 @@ func (u *userService) SearchUsers(ctx context.Context, searchRequest SearchReque
 		return nil, 0, errors.Wrapf(err, "Unable to send request to search users %v", searchRequest)
 	}
 	err = ReadA4CResponse(response, &res)
-	return res.Data.Data, res.Data.TotalResults, errors.Wrapf(err, "Unable to send request to search users %v", searchRequest)
+	if err != nil {
+		return res.Data.Data, res.Data.TotalResults, errors.Wrapf(err, "Unable to send request to search users %v", searchRequest)
+	}
+	return res.Data.Data, res.Data.TotalResults, checkTruncated(searchRequest, len(res.Data.Data), res.Data.TotalResults)
 }
 
 // DeleteUser deletes a user
@@ -382,7 +393,7 @@ func (u *userService) GetGroups(ctx context.Context, groupIDs []string) ([]Group
 // SearchGroups searches for groups and returns an array of groups as well as the
 // total number of groups matching the search request
 func (u *userService) SearchGroups(ctx context.Context, searchRequest SearchRequest) ([]Group, int, error) {
-	req, err := json.Marshal(searchRequest)
+	req, err := json.Marshal(paginate(searchRequest))
 	if err != nil {
 		return nil, 0, errors.Wrap(err, "Unable to marshal search request")
 	}
@@ -410,7 +421,10 @@ func (u *userService) SearchGroups(ctx context.Context, searchRequest SearchRequ
 		return nil, 0, errors.Wrapf(err, "Unable to send request to search groups %v", searchRequest)
 	}
 	err = ReadA4CResponse(response, &res)
-	return res.Data.Data, res.Data.TotalResults, errors.Wrapf(err, "Unable to search groups %v", searchRequest)
+	if err != nil {
+		return res.Data.Data, res.Data.TotalResults, errors.Wrapf(err, "Unable to search groups %v", searchRequest)
+	}
+	return res.Data.Data, res.Data.TotalResults, checkTruncated(searchRequest, len(res.Data.Data), res.Data.TotalResults)
 }
 
 // DeleteGroup deletes a group
@@ -431,3 +445,108 @@ func (u *userService) DeleteGroup(ctx context.Context, groupID string) error {
 	err = ReadA4CResponse(response, nil)
 	return errors.Wrapf(err, "Unable to delete group %s", groupID)
 }
+
+// ExportSecurityConfiguration returns users (without passwords), groups, group memberships and roles
+func (u *userService) ExportSecurityConfiguration(ctx context.Context) (SecurityConfiguration, error) {
+	var cfg SecurityConfiguration
+
+	for from := 0; ; from += securitySearchPageSize {
+		users, total, err := u.SearchUsers(ctx, SearchRequest{From: from, Size: securitySearchPageSize})
+		if err != nil {
+			return cfg, errors.Wrap(err, "Unable to export users")
+		}
+		for _, user := range users {
+			cfg.Users = append(cfg.Users, CreateUpdateUserRequest{
+				UserName:  user.UserName,
+				FirstName: user.FirstName,
+				LastName:  user.LastName,
+				Email:     user.Email,
+				Roles:     user.Roles,
+			})
+		}
+		if len(users) == 0 || from+len(users) >= total {
+			break
+		}
+	}
+
+	groups, err := u.searchAllGroups(ctx)
+	if err != nil {
+		return cfg, errors.Wrap(err, "Unable to export groups")
+	}
+	for _, group := range groups {
+		// Groups IDs are specific to an Alien4Cloud instance
+		group.ID = ""
+		cfg.Groups = append(cfg.Groups, group)
+	}
+	return cfg, nil
+}
+
+// ImportSecurityConfiguration creates or updates users and groups defined in the given configuration
+//
+// Users and groups are matched by name, existing ones are updated.
+// Users and groups existing in Alien4Cloud but not in the given configuration are left untouched.
+func (u *userService) ImportSecurityConfiguration(ctx context.Context, cfg SecurityConfiguration) error {
+	userNames := make([]string, 0, len(cfg.Users))
+	for _, user := range cfg.Users {
+		userNames = append(userNames, user.UserName)
+	}
+	existingUsers := make(map[string]bool)
+	if len(userNames) > 0 {
+		users, err := u.GetUsers(ctx, userNames)
+		if err != nil {
+			return errors.Wrap(err, "Unable to import users")
+		}
+		for _, user := range users {
+			existingUsers[user.UserName] = true
+		}
+	}
+	for _, user := range cfg.Users {
+		var err error
+		if existingUsers[user.UserName] {
+			err = u.UpdateUser(ctx, user.UserName, user)
+		} else {
+			err = u.CreateUser(ctx, user)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "Unable to import user %s", user.UserName)
+		}
+	}
+
+	if len(cfg.Groups) == 0 {
+		return nil
+	}
+	groups, err := u.searchAllGroups(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Unable to import groups")
+	}
+	groupIDs := make(map[string]string, len(groups))
+	for _, group := range groups {
+		groupIDs[group.Name] = group.ID
+	}
+	for _, group := range cfg.Groups {
+		group.ID = ""
+		if groupID, ok := groupIDs[group.Name]; ok {
+			err = u.UpdateGroup(ctx, groupID, group)
+		} else {
+			_, err = u.CreateGroup(ctx, group)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "Unable to import group %s", group.Name)
+		}
+	}
+	return nil
+}
+
+func (u *userService) searchAllGroups(ctx context.Context) ([]Group, error) {
+	var result []Group
+	for from := 0; ; from += securitySearchPageSize {
+		groups, total, err := u.SearchGroups(ctx, SearchRequest{From: from, Size: securitySearchPageSize})
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, groups...)
+		if len(groups) == 0 || from+len(groups) >= total {
+			return result, nil
+		}
+	}
+}
@@ -798,3 +798,64 @@ func Test_userService_TestDeleteGroup(t *testing.T) {
 		})
 	}
 }
+
+func Test_userService_TestExportImportSecurityConfiguration(t *testing.T) {
+	var createdUsers, updatedUsers, createdGroups, updatedGroups []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		rb, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("Failed to read request body %+v", r)
+		}
+		switch {
+		case r.URL.Path == "/rest/latest/users/search":
+			var req SearchRequest
+			assert.NilError(t, json.Unmarshal(rb, &req))
+			if req.From == 0 {
+				_, _ = w.Write([]byte(`{"data":{"data":[{"username":"user1","roles":["ADMIN"]}],"totalResults":2}}`))
+			} else {
+				_, _ = w.Write([]byte(`{"data":{"data":[{"username":"user2","email":"user2@example.com"}],"totalResults":2}}`))
+			}
+		case r.URL.Path == "/rest/latest/groups/search":
+			_, _ = w.Write([]byte(`{"data":{"data":[{"id":"g1","name":"group1","users":["user1"],"roles":["ARCHITECT"]}],"totalResults":1}}`))
+		case r.URL.Path == "/rest/latest/users/getUsers":
+			_, _ = w.Write([]byte(`{"data":[{"username":"user1"}]}`))
+		case r.URL.Path == "/rest/latest/users" && r.Method == http.MethodPost:
+			var req CreateUpdateUserRequest
+			assert.NilError(t, json.Unmarshal(rb, &req))
+			createdUsers = append(createdUsers, req.UserName)
+		case regexp.MustCompile(`.*/users/.*`).Match([]byte(r.URL.Path)) && r.Method == http.MethodPut:
+			updatedUsers = append(updatedUsers, regexp.MustCompile(`.*/users/`).ReplaceAllString(r.URL.Path, ""))
+		case r.URL.Path == "/rest/latest/groups" && r.Method == http.MethodPost:
+			var req Group
+			assert.NilError(t, json.Unmarshal(rb, &req))
+			createdGroups = append(createdGroups, req.Name)
+			_, _ = w.Write([]byte(`{"data":"newID"}`))
+		case regexp.MustCompile(`.*/groups/.*`).Match([]byte(r.URL.Path)) && r.Method == http.MethodPut:
+			updatedGroups = append(updatedGroups, regexp.MustCompile(`.*/groups/`).ReplaceAllString(r.URL.Path, ""))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer ts.Close()
+
+	uServ := &userService{
+		client: &a4cClient{client: http.DefaultClient, baseURL: ts.URL},
+	}
+
+	cfg, err := uServ.ExportSecurityConfiguration(context.Background())
+	assert.NilError(t, err)
+	assert.Equal(t, len(cfg.Users), 2)
+	assert.Equal(t, cfg.Users[1].Email, "user2@example.com")
+	assert.Equal(t, len(cfg.Groups), 1)
+	assert.Equal(t, cfg.Groups[0].ID, "")
+	assert.DeepEqual(t, cfg.Groups[0].Users, []string{"user1"})
+
+	cfg.Groups = append(cfg.Groups, Group{Name: "group2"})
+	err = uServ.ImportSecurityConfiguration(context.Background(), cfg)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, updatedUsers, []string{"user1"})
+	assert.DeepEqual(t, createdUsers, []string{"user2"})
+	assert.DeepEqual(t, updatedGroups, []string{"g1"})
+	assert.DeepEqual(t, createdGroups, []string{"group2"})
+}
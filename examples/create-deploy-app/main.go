@@ -19,10 +19,11 @@ import (
 	"flag"
 	"fmt"
 	"log"
-	"strings"
+	"os"
 	"time"
 
 	"github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud"
+	"github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/logutil"
 )
 
 // Command arguments
@@ -83,52 +84,20 @@ func main() {
 		log.Panic(err)
 	}
 
-	err = client.DeploymentService().DeployApplication(ctx, appID, envID, locationName)
-	if err != nil {
-		log.Panic(err)
-	}
-
-	// Wait for the end of deployment
-	done := false
+	// Deploy and wait for the end of deployment while printing logs
 	log.Printf("Waiting for the end of deployment...")
-	var filters alien4cloud.LogFilter
-	var deploymentStatus string
-	logIndex := 0
-	for !done {
-		time.Sleep(5 * time.Second)
-
-		a4cLogs, nbLogs, err := client.LogService().GetLogsOfApplication(ctx, appID, envID, filters, logIndex)
-		if err != nil {
-			log.Panic(err)
-		}
-		if nbLogs > 0 {
-			logIndex = logIndex + nbLogs
-			for idx := 0; idx < nbLogs; idx++ {
-				fmt.Printf("%s [%s][%s][%s][%s][%s][%s][%s] %s\n",
-					a4cLogs[idx].Timestamp.Format(time.RFC3339),
-					a4cLogs[idx].DeploymentPaaSID,
-					a4cLogs[idx].Level,
-					a4cLogs[idx].WorkflowID,
-					a4cLogs[idx].NodeID,
-					a4cLogs[idx].InstanceID,
-					a4cLogs[idx].InterfaceName,
-					a4cLogs[idx].OperationName,
-					a4cLogs[idx].Content)
+	logWriter := logutil.NewTailWriter(os.Stdout, logutil.LayoutFull, logutil.IsTerminal(os.Stdout))
+	deploymentStatus, err := client.DeploymentService().DeployApplicationAndWait(ctx, appID, envID, locationName, alien4cloud.DeployAndWaitOptions{
+		Logs: func(logs []alien4cloud.Log) {
+			if err := logWriter.WriteLogs(logs...); err != nil {
+				log.Print(err)
 			}
-		}
-
-		status, err := client.DeploymentService().GetDeploymentStatus(ctx, appID, envID)
-		if err != nil {
-			log.Panic(err)
-		}
-
-		deploymentStatus = strings.ToUpper(status)
-		done = (deploymentStatus == alien4cloud.ApplicationDeployed || deploymentStatus == alien4cloud.ApplicationError)
-		if done {
-			fmt.Printf("\nDeployment status: %s\n", status)
-			break
-		}
+		},
+	})
+	if err != nil && deploymentStatus != alien4cloud.ApplicationError {
+		log.Panic(err)
 	}
+	fmt.Printf("\nDeployment status: %s\n", deploymentStatus)
 
 	// On succesful deployment print output variable if any
 	if deploymentStatus == alien4cloud.ApplicationDeployed {
@@ -19,10 +19,12 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud"
+	"github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/logutil"
 )
 
 // Command arguments
@@ -77,6 +79,7 @@ func main() {
 	var filters alien4cloud.LogFilter
 	var deploymentStatus string
 	logIndex := 0
+	logWriter := logutil.NewTailWriter(os.Stdout, logutil.LayoutFull, logutil.IsTerminal(os.Stdout))
 	for !done {
 		time.Sleep(5 * time.Second)
 
@@ -84,20 +87,9 @@ func main() {
 		if err != nil {
 			log.Panic(err)
 		}
-		if nbLogs > 0 {
-			logIndex = logIndex + nbLogs
-			for idx := 0; idx < nbLogs; idx++ {
-				fmt.Printf("%s [%s][%s][%s][%s][%s][%s][%s] %s\n",
-					a4cLogs[idx].Timestamp.Format(time.RFC3339),
-					a4cLogs[idx].DeploymentPaaSID,
-					a4cLogs[idx].Level,
-					a4cLogs[idx].WorkflowID,
-					a4cLogs[idx].NodeID,
-					a4cLogs[idx].InstanceID,
-					a4cLogs[idx].InterfaceName,
-					a4cLogs[idx].OperationName,
-					a4cLogs[idx].Content)
-			}
+		logIndex = logIndex + nbLogs
+		if err = logWriter.WriteLogs(a4cLogs...); err != nil {
+			log.Panic(err)
 		}
 
 		status, err := client.DeploymentService().GetDeploymentStatus(ctx, appName, envID)
@@ -18,9 +18,11 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 	"time"
 
 	"github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud"
+	"github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud/logutil"
 )
 
 // Command arguments
@@ -79,14 +81,23 @@ func main() {
 		close(closeCh)
 	}
 
-	nbEvents := 0
 	if showEvents {
-		// get last number of events
-		_, nbEvents, err = client.EventService().GetEventsForApplicationEnvironment(ctx, envID, 0, 1)
+		err = client.EventService().SubscribeToEvents(ctx, envID, func(event *alien4cloud.Event, err error) {
+			if err != nil {
+				log.Printf("Failed to get events: %v", err)
+				return
+			}
+			if event.InstanceState != "" {
+				// Printing a message like:
+				// Event received: component Welcome instance 0 state stopping
+				// Event received: component Welcome instance 0 state stopped
+				log.Printf("Event received: component %s instance %s state %s",
+					event.NodeTemplateId, event.InstanceId, event.InstanceState)
+			}
+		})
 		if err != nil {
 			log.Panic(err)
 		}
-
 	}
 	execID, err := client.DeploymentService().RunWorkflowAsync(ctx, appName, envID, workflow, cb)
 	if err != nil {
@@ -99,6 +110,7 @@ func main() {
 		ExecutionID: []string{execID},
 	}
 	logIndex := 0
+	logWriter := logutil.NewTailWriter(os.Stdout, logutil.LayoutFull, logutil.IsTerminal(os.Stdout))
 ExitLoop:
 	for {
 		select {
@@ -107,24 +119,6 @@ ExitLoop:
 		case <-time.After(5 * time.Second):
 		}
 		if showEvents {
-
-			events, newNbEvents, err := client.EventService().GetEventsForApplicationEnvironment(ctx, envID, 0, nbEvents+100000)
-			if err != nil {
-				log.Panic(err)
-			}
-			// Results are sorted by date in descending order
-			for idx := newNbEvents - nbEvents - 1; idx >= 0; idx-- {
-
-				if events[idx].InstanceState != "" {
-					// Printing a message like:
-					// Event received: component Welcome instance 0 state stopping
-					// Event received: component Welcome instance 0 state stopped
-					log.Printf("Event received: component %s instance %s state %s",
-						events[idx].NodeTemplateId, events[idx].InstanceId, events[idx].InstanceState)
-
-				}
-			}
-			nbEvents = newNbEvents
 			continue
 		}
 
@@ -133,20 +127,9 @@ ExitLoop:
 		if err != nil {
 			log.Panic(err)
 		}
-		if nbLogs > 0 {
-			logIndex = logIndex + nbLogs
-			for idx := 0; idx < nbLogs; idx++ {
-				log.Printf("%s [%s][%s][%s][%s][%s][%s][%s] %s",
-					a4cLogs[idx].Timestamp.Format(time.RFC3339),
-					a4cLogs[idx].DeploymentPaaSID,
-					a4cLogs[idx].Level,
-					a4cLogs[idx].WorkflowID,
-					a4cLogs[idx].NodeID,
-					a4cLogs[idx].InstanceID,
-					a4cLogs[idx].InterfaceName,
-					a4cLogs[idx].OperationName,
-					a4cLogs[idx].Content)
-			}
+		logIndex = logIndex + nbLogs
+		if err = logWriter.WriteLogs(a4cLogs...); err != nil {
+			log.Panic(err)
 		}
 	}
 }
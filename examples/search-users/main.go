@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/alien4cloud/alien4cloud-go-client/v3/alien4cloud"
+	"github.com/pkg/errors"
 )
 
 // Command arguments
@@ -64,7 +65,10 @@ func main() {
 		Query: query,
 	}
 	users, totalNumber, err := client.UserService().SearchUsers(ctx, searchRequest)
-	if err != nil {
+	if errors.Is(err, alien4cloud.ErrTruncatedResults) {
+		// Requested size was larger than alien4cloud.MaxPageSize, next users should be requested using from
+		log.Print(err)
+	} else if err != nil {
 		log.Panic(err)
 	}
 
@@ -6,6 +6,7 @@ require (
 	github.com/fatih/color v1.11.0
 	github.com/golang/mock v1.5.0
 	github.com/goware/urlx v0.3.1
+	github.com/mattn/go-isatty v0.0.12
 	github.com/pkg/errors v0.9.1
 	gotest.tools/v3 v3.0.3
 )